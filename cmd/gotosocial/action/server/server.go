@@ -314,6 +314,26 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error scheduling poll expiries: %w", err)
 	}
 
+	// Schedule sweep of stale poll expiries.
+	if err := process.Polls().ScheduleExpirySweep(); err != nil {
+		return fmt.Errorf("error scheduling poll expiry sweep: %w", err)
+	}
+
+	// Schedule tasks for all existing interaction approval expiries.
+	if err := process.Workers().ScheduleApprovalExpiries(ctx); err != nil {
+		return fmt.Errorf("error scheduling approval expiries: %w", err)
+	}
+
+	// Schedule tasks for all existing status expiries.
+	if err := process.Workers().ScheduleStatusExpiries(ctx); err != nil {
+		return fmt.Errorf("error scheduling status expiries: %w", err)
+	}
+
+	// Schedule reminders of interactions pending approval.
+	if err := process.Workers().ScheduleApprovalReminders(); err != nil {
+		return fmt.Errorf("error scheduling approval reminders: %w", err)
+	}
+
 	// Initialize metrics.
 	if err := metrics.Initialize(state.DB); err != nil {
 		return fmt.Errorf("error initializing metrics: %w", err)
@@ -20,8 +20,10 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/interaction"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media/prune"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/status"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
@@ -268,5 +270,53 @@ func adminCommands() *cobra.Command {
 
 	adminCmd.AddCommand(adminMediaCmd)
 
+	/*
+		ADMIN STATUS COMMANDS
+	*/
+
+	adminStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "admin commands related to statuses",
+	}
+
+	adminStatusCheckRelationsCmd := &cobra.Command{
+		Use:   "check-relations",
+		Short: "log statuses with attachments/mentions mismatching the database, and optionally fix them",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), status.CheckRelations)
+		},
+	}
+	config.AddAdminStatusRelations(adminStatusCheckRelationsCmd)
+	adminStatusCmd.AddCommand(adminStatusCheckRelationsCmd)
+
+	adminCmd.AddCommand(adminStatusCmd)
+
+	/*
+		ADMIN INTERACTION COMMANDS
+	*/
+
+	adminInteractionCmd := &cobra.Command{
+		Use:   "interaction",
+		Short: "admin commands related to interactions",
+	}
+
+	adminInteractionMergeApprovalsCmd := &cobra.Command{
+		Use:   "merge-approvals",
+		Short: "log interactions with duplicate approvals, and optionally merge them into the earliest approval",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), interaction.MergeApprovals)
+		},
+	}
+	config.AddAdminInteractionApprovals(adminInteractionMergeApprovalsCmd)
+	adminInteractionCmd.AddCommand(adminInteractionMergeApprovalsCmd)
+
+	adminCmd.AddCommand(adminInteractionCmd)
+
 	return adminCmd
 }
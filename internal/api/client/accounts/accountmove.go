@@ -95,3 +95,116 @@ func (m *Module) AccountMovePOSTHandler(c *gin.Context) {
 		"message": "accepted",
 	})
 }
+
+// AccountMoveRetryPOSTHandler swagger:operation POST /api/v1/accounts/move/retry accountMoveRetry
+//
+// Retry migrating local followers of your account to the target of your completed account Move.
+//
+// Followers that were already migrated are not affected; only followers
+// that still follow your account (for example, because migrating them
+// failed during the Move) will be redirected to the Move target.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: Followers were redirected, returns the number newly migrated.
+//			schema:
+//				"$ref": "#/definitions/accountMoveRetryResponse"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: Unprocessable. Your account has not Moved, or the Move has not completed or is no longer valid.
+//		'500':
+//			description: internal server error
+func (m *Module) AccountMoveRetryPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	resp, errWithCode := m.processor.Account().MoveSelfRetry(c.Request.Context(), authed)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, resp)
+}
+
+// AccountMoveCancelPOSTHandler swagger:operation POST /api/v1/accounts/move/cancel accountMoveCancel
+//
+// Cancel your account Move, if it has not yet completed.
+//
+// Stops migration of your local followers to the Move target,
+// and clears the Move from your account. Followers that were
+// already migrated before cancelling will not be migrated back.
+//
+//	---
+//	tags:
+//	- accounts
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:accounts
+//
+//	responses:
+//		'200':
+//			description: The account Move has been cancelled.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: Unprocessable. Your account is not Moving, or the Move has already completed.
+//		'500':
+//			description: internal server error
+func (m *Module) AccountMoveCancelPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.Account().MoveSelfCancel(c.Request.Context(), authed); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiutil.JSON(c, http.StatusOK, map[string]string{
+		"message": "cancelled",
+	})
+}
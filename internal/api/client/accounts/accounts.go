@@ -56,6 +56,8 @@ const (
 	UpdatePath        = BasePath + "/update_credentials"
 	VerifyPath        = BasePath + "/verify_credentials"
 	MovePath          = BasePath + "/move"
+	MoveRetryPath     = MovePath + "/retry"
+	MoveCancelPath    = MovePath + "/cancel"
 	AliasPath         = BasePath + "/alias"
 	ThemesPath        = BasePath + "/themes"
 
@@ -130,6 +132,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// migration handlers
 	attachHandler(http.MethodPost, AliasPath, m.AccountAliasPOSTHandler)
 	attachHandler(http.MethodPost, MovePath, m.AccountMovePOSTHandler)
+	attachHandler(http.MethodPost, MoveRetryPath, m.AccountMoveRetryPOSTHandler)
+	attachHandler(http.MethodPost, MoveCancelPath, m.AccountMoveCancelPOSTHandler)
 
 	// account themes
 	attachHandler(http.MethodGet, ThemesPath, m.AccountThemesGETHandler)
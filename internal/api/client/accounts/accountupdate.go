@@ -120,6 +120,41 @@ import (
 //		description: Default content type to use for authored statuses (text/plain or text/markdown).
 //		type: string
 //	-
+//		name: source[interaction_min_account_age_days]
+//		in: formData
+//		description: >-
+//			Automatically reject interactions requiring approval from
+//			accounts younger than this many days. Set to 0 to disable.
+//		type: integer
+//	-
+//		name: source[interaction_trusted_list_id]
+//		in: formData
+//		description: >-
+//			ID of one of your lists. Interactions requiring approval from
+//			members of this list are approved automatically. Empty string to unset.
+//		type: string
+//	-
+//		name: source[interaction_approval_tag]
+//		in: formData
+//		description: >-
+//			Hashtag, with or without leading #. Replies requiring approval
+//			that use this hashtag are approved automatically. Empty string to unset.
+//		type: string
+//	-
+//		name: source[interaction_approval_expiry_days]
+//		in: formData
+//		description: >-
+//			Approvals of interactions with your statuses expire after
+//			this many days, hiding the interactions again. Set to 0 to disable.
+//		type: integer
+//	-
+//		name: source[interaction_pending_reminders]
+//		in: formData
+//		description: >-
+//			Periodically receive a notification reminding you
+//			of interactions awaiting your approval.
+//		type: boolean
+//	-
 //		name: theme
 //		in: formData
 //		description: >-
@@ -335,6 +370,11 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.Source.Sensitive == nil &&
 			form.Source.Language == nil &&
 			form.Source.StatusContentType == nil &&
+			form.Source.InteractionMinAccountAgeDays == nil &&
+			form.Source.InteractionTrustedListID == nil &&
+			form.Source.InteractionApprovalTag == nil &&
+			form.Source.InteractionApprovalExpiryDays == nil &&
+			form.Source.InteractionPendingReminders == nil &&
 			form.FieldsAttributes == nil &&
 			form.Theme == nil &&
 			form.CustomCSS == nil &&
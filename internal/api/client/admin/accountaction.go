@@ -53,7 +53,7 @@ import (
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: Type of action to be taken, currently supports `suspend`, `rebuild-conversations`, and `recalculate-stats`.
 //		type: string
 //		required: true
 //	-
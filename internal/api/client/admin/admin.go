@@ -47,8 +47,10 @@ const (
 	AccountsActionPath      = AccountsPathWithID + "/action"
 	AccountsApprovePath     = AccountsPathWithID + "/approve"
 	AccountsRejectPath      = AccountsPathWithID + "/reject"
+	AccountsMoveEventsPath  = AccountsPathWithID + "/move_events"
 	MediaCleanupPath        = BasePath + "/media_cleanup"
 	MediaRefetchPath        = BasePath + "/media_refetch"
+	MediaReclaimPath        = BasePath + "/media_reclaim"
 	ReportsPath             = BasePath + "/reports"
 	ReportsPathWithID       = ReportsPath + "/:" + apiutil.IDKey
 	ReportsResolvePath      = ReportsPathWithID + "/resolve"
@@ -119,10 +121,12 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
 	attachHandler(http.MethodPost, AccountsApprovePath, m.AccountApprovePOSTHandler)
 	attachHandler(http.MethodPost, AccountsRejectPath, m.AccountRejectPOSTHandler)
+	attachHandler(http.MethodGet, AccountsMoveEventsPath, m.AccountMoveEventsGETHandler)
 
 	// media stuff
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
 	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
+	attachHandler(http.MethodPost, MediaReclaimPath, m.MediaReclaimPOSTHandler)
 
 	// reports stuff
 	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
@@ -32,6 +32,8 @@ const (
 	ListsPath     = BasePath + "/lists.csv"
 	BlocksPath    = BasePath + "/blocks.csv"
 	MutesPath     = BasePath + "/mutes.csv"
+
+	InteractionApprovalsPath = BasePath + "/interaction_approvals.json"
 )
 
 type Module struct {
@@ -51,4 +53,5 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, ListsPath, m.ExportListsGETHandler)
 	attachHandler(http.MethodGet, BlocksPath, m.ExportBlocksGETHandler)
 	attachHandler(http.MethodGet, MutesPath, m.ExportMutesGETHandler)
+	attachHandler(http.MethodGet, InteractionApprovalsPath, m.ExportInteractionApprovalsGETHandler)
 }
@@ -19,11 +19,13 @@ package exports_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
@@ -34,6 +36,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -201,7 +204,7 @@ Cool Ass Posters From This Instance,1happyturtle@localhost:8080
 			token:       suite.testTokens["local_account_1"],
 			user:        suite.testUsers["local_account_1"],
 			account:     suite.testAccounts["local_account_1"],
-			expect: `Account address,Hide notifications
+			expect: `Account address,Hide notifications,Expires at
 `,
 		},
 		// Export Blocks.
@@ -270,6 +273,158 @@ Cool Ass Posters From This Instance,1happyturtle@localhost:8080
 	}
 }
 
+func (suite *ExportsTestSuite) TestExportMutesWithSettings() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	// Put mutes by local_account_1 with
+	// differing notification + expiry settings.
+	for _, mute := range []*gtsmodel.UserMute{
+		{
+			ID:              "01J5R0AD4N3NEZB5H8AJ4QE4YX",
+			AccountID:       account.ID,
+			TargetAccountID: suite.testAccounts["admin_account"].ID,
+			Notifications:   util.Ptr(true),
+		},
+		{
+			ID:              "01J5R0B4EVDCA8YXJ1GHFAC2QT",
+			AccountID:       account.ID,
+			TargetAccountID: suite.testAccounts["local_account_2"].ID,
+			Notifications:   util.Ptr(false),
+			ExpiresAt:       time.Date(2099, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			// Expired, shouldn't be exported.
+			ID:              "01J5R0BWMQ6G1JD0DE7T4Z8ZW9",
+			AccountID:       account.ID,
+			TargetAccountID: suite.testAccounts["remote_account_1"].ID,
+			Notifications:   util.Ptr(true),
+			ExpiresAt:       time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+	} {
+		if err := suite.state.DB.PutMute(ctx, mute); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	recorder := suite.TriggerHandler(
+		suite.exportsModule.ExportMutesGETHandler,
+		exports.MutesPath,
+		apiutil.TextCSV,
+		suite.testApplications["application_1"],
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		account,
+	)
+
+	// Check response code.
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	// Check response body.
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(`Account address,Hide notifications,Expires at
+1happyturtle@localhost:8080,false,2099-01-01T12:00:00Z
+admin@localhost:8080,true,
+`, string(b))
+}
+
+func (suite *ExportsTestSuite) TestExportInteractionApprovals() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+	)
+
+	// Put approvals sent by local_account_1
+	// of interactions by remote_account_1.
+	for _, approval := range []*gtsmodel.InteractionApproval{
+		{
+			ID:                   "01J5QVXCCEATJYSXM9H6MZT4JR",
+			CreatedAt:            time.Date(2024, 8, 20, 10, 0, 0, 0, time.UTC),
+			AccountID:            account.ID,
+			InteractingAccountID: interacter.ID,
+			InteractionURI:       "http://fossbros-anonymous.io/users/foss_satan/statuses/01J5QVB9VC76NPPRQ207GG4DRZ",
+			InteractionType:      gtsmodel.InteractionReply,
+			URI:                  "http://localhost:8080/users/the_mighty_zork/accepts/01J5QVXCCEATJYSXM9H6MZT4JR",
+		},
+		{
+			ID:                   "01J5QWC6GRPGTS1SN9P9ZZMJJS",
+			CreatedAt:            time.Date(2024, 8, 20, 11, 0, 0, 0, time.UTC),
+			AccountID:            account.ID,
+			InteractingAccountID: interacter.ID,
+			InteractionURI:       "http://fossbros-anonymous.io/users/foss_satan/liked/01J5QWAE8TC7W3FHN1GDN4YMWC",
+			InteractionType:      gtsmodel.InteractionLike,
+			URI:                  "http://localhost:8080/users/the_mighty_zork/accepts/01J5QWC6GRPGTS1SN9P9ZZMJJS",
+			ExpiresAt:            time.Date(2024, 9, 20, 11, 0, 0, 0, time.UTC),
+		},
+	} {
+		if err := suite.state.DB.PutInteractionApproval(ctx, approval); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	recorder := suite.TriggerHandler(
+		suite.exportsModule.ExportInteractionApprovalsGETHandler,
+		exports.InteractionApprovalsPath,
+		apiutil.AppJSON,
+		suite.testApplications["application_1"],
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		account,
+	)
+
+	// Check response code.
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	// Check response body.
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dst := &bytes.Buffer{}
+	if err := json.Indent(dst, b, "", "  "); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(`[
+  {
+    "uri": "http://localhost:8080/users/the_mighty_zork/accepts/01J5QVXCCEATJYSXM9H6MZT4JR",
+    "created_at": "2024-08-20T10:00:00.000Z",
+    "interaction_type": "reply",
+    "interaction_uri": "http://fossbros-anonymous.io/users/foss_satan/statuses/01J5QVB9VC76NPPRQ207GG4DRZ",
+    "interacting_account_uri": "http://fossbros-anonymous.io/users/foss_satan"
+  },
+  {
+    "uri": "http://localhost:8080/users/the_mighty_zork/accepts/01J5QWC6GRPGTS1SN9P9ZZMJJS",
+    "created_at": "2024-08-20T11:00:00.000Z",
+    "interaction_type": "like",
+    "interaction_uri": "http://fossbros-anonymous.io/users/foss_satan/liked/01J5QWAE8TC7W3FHN1GDN4YMWC",
+    "interacting_account_uri": "http://fossbros-anonymous.io/users/foss_satan",
+    "expires_at": "2024-09-20T11:00:00.000Z"
+  }
+]`, dst.String())
+
+	// Approvals sent by other accounts shouldn't be exported.
+	recorder = suite.TriggerHandler(
+		suite.exportsModule.ExportInteractionApprovalsGETHandler,
+		exports.InteractionApprovalsPath,
+		apiutil.AppJSON,
+		suite.testApplications["application_1"],
+		suite.testTokens["local_account_2"],
+		suite.testUsers["local_account_2"],
+		suite.testAccounts["local_account_2"],
+	)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+	suite.Equal("[]", recorder.Body.String())
+}
+
 func TestExportsTestSuite(t *testing.T) {
 	suite.Run(t, new(ExportsTestSuite))
 }
@@ -39,6 +39,7 @@ const (
 var types = []string{
 	"following",
 	"blocks",
+	"mutes",
 }
 
 var modes = []string{
@@ -94,6 +95,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 //
 //			- `following` - accounts to follow.
 //			- `blocks` - accounts to block.
+//			- `mutes` - accounts to mute, with optional expiry.
 //		type: string
 //		required: true
 //	-
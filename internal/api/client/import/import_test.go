@@ -20,13 +20,16 @@ package importdata_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	importdata "github.com/superseriousbusiness/gotosocial/internal/api/client/import"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -205,6 +208,53 @@ admin@localhost:8080,true
 	}
 }
 
+func (suite *ImportTestSuite) TestImportMutes() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		admin       = suite.testAccounts["admin_account"]
+		turtle      = suite.testAccounts["local_account_2"]
+	)
+
+	// Have zork mute admin forever, and
+	// turtle (not notifications) until 2099.
+	data := `Account address,Hide notifications,Expires at
+admin@localhost:8080,true,
+1happyturtle@localhost:8080,false,2099-01-01T12:00:00Z
+`
+
+	// Trigger the import handler.
+	suite.TriggerHandler(data, "mutes", "merge")
+
+	// Wait for zork to
+	// be muting turtle.
+	var turtleMute *gtsmodel.UserMute
+	if !testrig.WaitFor(func() bool {
+		var err error
+		turtleMute, err = suite.state.DB.GetMute(ctx, testAccount.ID, turtle.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+		return turtleMute != nil
+	}) {
+		suite.FailNow("timed out waiting for zork to mute turtle")
+	}
+	suite.False(*turtleMute.Notifications)
+	suite.WithinDuration(
+		time.Date(2099, 1, 1, 12, 0, 0, 0, time.UTC),
+		turtleMute.ExpiresAt,
+		time.Minute,
+	)
+
+	// Admin was muted first.
+	adminMute, err := suite.state.DB.GetMute(ctx, testAccount.ID, admin.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*adminMute.Notifications)
+	suite.Zero(adminMute.ExpiresAt)
+}
+
 func TestImportTestSuite(t *testing.T) {
 	suite.Run(t, new(ImportTestSuite))
 }
@@ -25,8 +25,10 @@ import (
 )
 
 const (
-	BasePath     = "/v1/interaction_policies"
-	DefaultsPath = BasePath + "/defaults"
+	BasePath          = "/v1/interaction_policies"
+	DefaultsPath      = BasePath + "/defaults"
+	RejectPendingPath = BasePath + "/reject_pending"
+	ReasonsPath       = BasePath + "/reasons"
 )
 
 type Module struct {
@@ -42,4 +44,6 @@ func New(processor *processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, DefaultsPath, m.PoliciesDefaultsGETHandler)
 	attachHandler(http.MethodPatch, DefaultsPath, m.PoliciesDefaultsPATCHHandler)
+	attachHandler(http.MethodPost, RejectPendingPath, m.RejectPendingPOSTHandler)
+	attachHandler(http.MethodGet, ReasonsPath, m.InteractionReasonsGETHandler)
 }
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -29,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
@@ -39,6 +41,9 @@ import (
 // The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
 // The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
 //
+// An `interaction_policy` for the new status can only be given when submitting the request as JSON.
+// If not set, the account's default interaction policy for the status visibility will be used.
+//
 //	---
 //	tags:
 //	- statuses
@@ -148,6 +153,14 @@ import (
 //		type: string
 //		in: formData
 //	-
+//		name: expires_at
+//		x-go-name: ExpiresAt
+//		description: |-
+//			ISO 8601 Datetime at which to automatically delete this status.
+//			Must be in the future.
+//		type: string
+//		in: formData
+//	-
 //		name: language
 //		x-go-name: Language
 //		description: ISO 639 language code for this status.
@@ -278,6 +291,21 @@ func validateNormalizeCreateStatus(form *apimodel.AdvancedStatusCreateForm) erro
 		}
 	}
 
+	if form.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, form.ExpiresAt)
+		if err != nil {
+			return fmt.Errorf("expires_at %s could not be parsed as an ISO 8601 datetime", form.ExpiresAt)
+		}
+
+		if !expiresAt.After(time.Now()) {
+			return errors.New("expires_at must be in the future")
+		}
+
+		// Normalize to our
+		// ISO 8601 format.
+		form.ExpiresAt = util.FormatISO8601(expiresAt)
+	}
+
 	if form.Language != "" {
 		language, err := validate.Language(form.Language)
 		if err != nil {
@@ -241,6 +241,16 @@ type UpdateSource struct {
 	Language *string `form:"language" json:"language"`
 	// Default format for authored statuses (text/plain or text/markdown).
 	StatusContentType *string `form:"status_content_type" json:"status_content_type"`
+	// Auto-reject interactions requiring approval from accounts younger than this many days. 0 to disable.
+	InteractionMinAccountAgeDays *int `form:"interaction_min_account_age_days" json:"interaction_min_account_age_days"`
+	// ID of a list whose members' interactions requiring approval are approved automatically. Empty string to unset.
+	InteractionTrustedListID *string `form:"interaction_trusted_list_id" json:"interaction_trusted_list_id"`
+	// Hashtag with which replies requiring approval are approved automatically. Empty string to unset.
+	InteractionApprovalTag *string `form:"interaction_approval_tag" json:"interaction_approval_tag"`
+	// Approvals of interactions expire after this many days, hiding the interactions again. 0 to disable.
+	InteractionApprovalExpiryDays *int `form:"interaction_approval_expiry_days" json:"interaction_approval_expiry_days"`
+	// Periodically receive a notification reminding of interactions awaiting approval.
+	InteractionPendingReminders *bool `form:"interaction_pending_reminders" json:"interaction_pending_reminders"`
 }
 
 // UpdateField is to be used specifically in an UpdateCredentialsRequest.
@@ -287,6 +297,18 @@ type AccountMoveRequest struct {
 	MovedToURI string `form:"moved_to_uri" json:"moved_to_uri" xml:"moved_to_uri"`
 }
 
+// AccountMoveRetryResponse models the response
+// to a request to retry an account Move.
+//
+// swagger:model accountMoveRetryResponse
+type AccountMoveRetryResponse struct {
+	// Number of local followers newly migrated to the Move target.
+	Migrated int `json:"migrated"`
+	// Number of local followers that could not be migrated
+	// to the Move target. Retrying again will retry these.
+	Failed int `json:"failed"`
+}
+
 // AccountAliasRequest models a request
 // to set an account's alsoKnownAs URIs.
 type AccountAliasRequest struct {
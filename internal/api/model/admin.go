@@ -124,6 +124,54 @@ type AdminReport struct {
 	// Will be null if not set / no action yet taken.
 	// example: Account was suspended.
 	ActionTakenComment *string `json:"action_taken_comment"`
+	// Copies of reported statuses that have since been deleted.
+	// Only set if the instance is configured to preserve them.
+	DeletedStatuses []*AdminReportDeletedStatus `json:"deleted_statuses,omitempty"`
+}
+
+// AdminReportDeletedStatus models a copy of a reported
+// status, taken just before the status was deleted.
+//
+// swagger:model adminReportDeletedStatus
+type AdminReportDeletedStatus struct {
+	// ID of the deleted status.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// ActivityPub URI of the deleted status.
+	// example: https://example.org/users/some_user/statuses/01FBVD42CQ3ZEEVMW180SBX03B
+	URI string `json:"uri"`
+	// When the deleted status was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// When the status was deleted (ISO 8601 Datetime).
+	// example: 2021-07-31T09:20:25+00:00
+	DeletedAt string `json:"deleted_at"`
+	// Content warning of the deleted status.
+	SpoilerText string `json:"spoiler_text"`
+	// HTML content of the deleted status.
+	Content string `json:"content"`
+	// Metadata of media attached to the deleted status.
+	// The media files themselves are not preserved.
+	MediaAttachments []*AdminReportDeletedAttachment `json:"media_attachments"`
+}
+
+// AdminReportDeletedAttachment models the metadata of
+// a media attachment of a deleted reported status.
+//
+// swagger:model adminReportDeletedAttachment
+type AdminReportDeletedAttachment struct {
+	// ID of the attachment.
+	// example: 01FC31DZT1AYWDZ8XTCRWRBYRK
+	ID string `json:"id"`
+	// Type of the attachment.
+	// example: image
+	Type string `json:"type"`
+	// URL at which the attachment was served by this instance.
+	URL string `json:"url,omitempty"`
+	// URL at which the attachment was served by a remote instance.
+	RemoteURL string `json:"remote_url,omitempty"`
+	// Description of the attachment.
+	Description string `json:"description,omitempty"`
 }
 
 // AdminReportResolveRequest can be submitted along with a POST to /api/v1/admin/reports/{id}/resolve
@@ -189,6 +237,32 @@ type AdminActionResponse struct {
 	ActionID string `json:"action_id"`
 }
 
+// AdminMoveEvent models one event in the
+// processing of an account Move by this instance.
+//
+// swagger:model adminMoveEvent
+type AdminMoveEvent struct {
+	// ID of the event.
+	// example: 01J4XQ3N5W2Y8PZVF3A6RC0M1T
+	ID string `json:"id"`
+	// ID of the Move this event belongs to.
+	// example: 01J4XQ3JV3T4E9M6Y8P0HFS2KD
+	MoveID string `json:"move_id"`
+	// When the event happened (ISO 8601 Datetime).
+	// example: 2024-08-08T12:03:11.000Z
+	CreatedAt string `json:"created_at"`
+	// Phase of Move processing recorded by this event. One of:
+	// validated, target_dereffed, redirect_started,
+	// follower_migrated, follower_failed, completed,
+	// failed, cancelled.
+	// example: follower_migrated
+	Phase string `json:"phase"`
+	// Details of the event, if any. Eg., URI
+	// of migrated follower, or reason for failure.
+	// example: http://localhost:8080/users/the_mighty_zork
+	Details string `json:"details,omitempty"`
+}
+
 // MediaCleanupRequest models admin media cleanup parameters
 //
 // swagger:parameters mediaCleanup
@@ -198,6 +272,24 @@ type MediaCleanupRequest struct {
 	RemoteCacheDays *int `form:"remote_cache_days" json:"remote_cache_days" xml:"remote_cache_days"`
 }
 
+// MediaReclaimRequest models admin media reclaim parameters.
+//
+// swagger:ignore
+type MediaReclaimRequest struct {
+	// ID of the status to reclaim media from.
+	StatusID string `form:"status_id" json:"status_id" xml:"status_id"`
+	// Remote attachments larger than this many bytes have their original file removed.
+	Size *int `form:"size" json:"size" xml:"size"`
+}
+
+// MediaReclaimResponse models the result of an admin media reclaim.
+//
+// swagger:model mediaReclaimResponse
+type MediaReclaimResponse struct {
+	// Number of attachments whose original file was removed.
+	Reclaimed int `json:"reclaimed"`
+}
+
 // AdminSendTestEmailRequest models a test email send request (woah).
 type AdminSendTestEmailRequest struct {
 	// Email address to send the test email to.
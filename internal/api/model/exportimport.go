@@ -61,6 +61,48 @@ type AccountExportStats struct {
 	MutesCount int `json:"mutes_count"`
 }
 
+// InteractionApprovalExport models one approval
+// sent by an account in response to an interaction
+// request, for the purpose of exporting the account's
+// interaction approval history.
+//
+// To respect the privacy of interacting accounts, only
+// URIs are included, never data of the interacting account.
+//
+// swagger:model interactionApprovalExport
+type InteractionApprovalExport struct {
+	// ActivityPub URI of the Accept that approved the interaction.
+	//
+	// example: https://example.org/users/some_user/accepts/01J5QVXCCEATJYSXM9H6MZT4JR
+	URI string `json:"uri"`
+
+	// Time at which the interaction was approved (ISO 8601 Datetime).
+	//
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+
+	// Type of the approved interaction, one of like, reply, or announce.
+	//
+	// example: reply
+	InteractionType string `json:"interaction_type"`
+
+	// ActivityPub URI of the approved like, reply, or announce.
+	//
+	// example: https://another.example.org/users/someone_else/statuses/01J5QVB9VC76NPPRQ207GG4DRZ
+	InteractionURI string `json:"interaction_uri"`
+
+	// ActivityPub URI of the account that performed the interaction.
+	//
+	// example: https://another.example.org/users/someone_else
+	InteractingAccountURI string `json:"interacting_account_uri"`
+
+	// Time at which the approval expires, if it
+	// is not a permanent approval (ISO 8601 Datetime).
+	//
+	// example: 2021-08-30T09:20:25+00:00
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
 // AttachmentRequest models media attachment creation parameters.
 //
 // swagger: ignore
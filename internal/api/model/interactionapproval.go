@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// InteractionApproval represents an approval, by the
+// requesting account, of an interaction (like, reply,
+// or boost) with one of the requesting account's statuses.
+//
+// swagger:model interactionApproval
+type InteractionApproval struct {
+	// The id of the approval in the database.
+	ID string `json:"id"`
+	// The type of interaction that was approved.
+	// 	favourite = Someone favourited one of your statuses. `status` will be set.
+	// 	reply = Someone replied to one of your statuses. `status` and `reply` will be set.
+	// 	reblog = Someone boosted one of your statuses. `status` will be set.
+	Type string `json:"type"`
+	// The timestamp of the approval (ISO 8601 Datetime).
+	CreatedAt string `json:"created_at"`
+	// The timestamp at which the approval expires (ISO 8601 Datetime), if it expires.
+	ExpiresAt *string `json:"expires_at"`
+	// Reason code given for the approval, if any.
+	Reason *string `json:"reason"`
+	// The account that performed the approved interaction.
+	Account *Account `json:"account"`
+	// Status that was interacted with.
+	Status *Status `json:"status,omitempty"`
+	// Reply status that was approved, if type = reply.
+	Reply *Status `json:"reply,omitempty"`
+	// ActivityPub URI of the Accept sent for this approval.
+	URI string `json:"uri"`
+}
+
+// PendingInteractionsCount represents the number of
+// interactions (likes, replies, or boosts) which are
+// currently awaiting approval by the requesting account.
+//
+// swagger:model pendingInteractionsCount
+type PendingInteractionsCount struct {
+	// Number of interactions pending approval.
+	Count int `json:"count"`
+}
+
+// PendingInteractionsRejectRequest models a request to reject
+// all interactions pending approval by the requesting account,
+// which were made by accounts on any of the given domains.
+//
+// swagger:ignore
+type PendingInteractionsRejectRequest struct {
+	// Domains to reject pending interactions from.
+	Domains []string `form:"domains[]" json:"domains"`
+	// Optional reason code to record for the rejections.
+	Reason string `form:"reason" json:"reason"`
+}
+
+// PendingInteractionsRejected represents the number of
+// interactions pending approval by the requesting account
+// which were rejected in response to a reject request.
+//
+// swagger:model pendingInteractionsRejected
+type PendingInteractionsRejected struct {
+	// Number of rejected interactions.
+	Rejected int `json:"rejected"`
+}
+
+// InteractionReasons represents counts of the reason codes
+// given by the requesting account when approving or rejecting
+// interactions with its statuses. Approvals and rejections
+// made without a reason code are not counted.
+//
+// swagger:model interactionReasons
+type InteractionReasons struct {
+	// Number of approvals per reason code.
+	Approved map[string]int `json:"approved"`
+	// Number of rejections per reason code.
+	Rejected map[string]int `json:"rejected"`
+}
@@ -34,6 +34,8 @@ type Notification struct {
 	// 	poll = A poll you have voted in or created has ended. `status` will be set. `account` will be set.
 	// 	status = Someone you enabled notifications for has posted a status. `status` will be set. `account` will be set.
 	// 	admin.sign_up = Someone has signed up for a new account on the instance. `account` will be set.
+	// 	pending.reminder = You have interactions awaiting your approval. `account` (you) and `pending_count` will be set.
+	// 	interaction.approved = Your reply, boost, or favourite awaiting approval has been approved. `status` will be set. `account` (the approver) and `approval_uri` will be set.
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -44,6 +46,12 @@ type Notification struct {
 
 	// Status that was the object of the notification, e.g. in mentions, reblogs, favourites, or polls.
 	Status *Status `json:"status,omitempty"`
+
+	// Number of interactions awaiting approval, for pending.reminder notifications.
+	PendingCount int `json:"pending_count,omitempty"`
+
+	// URI of the approval (Accept) of your interaction, for interaction.approved notifications.
+	ApprovalURI string `json:"approval_uri,omitempty"`
 }
 
 /*
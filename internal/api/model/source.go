@@ -38,6 +38,23 @@ type Source struct {
 	Fields []Field `json:"fields"`
 	// The number of pending follow requests.
 	FollowRequestsCount int `json:"follow_requests_count"`
+	// Interactions requiring approval from accounts younger
+	// than this many days are automatically rejected.
+	// 0 means this is disabled.
+	InteractionMinAccountAgeDays int `json:"interaction_min_account_age_days"`
+	// ID of a list owned by this account. Interactions requiring
+	// approval from members of this list are approved automatically.
+	InteractionTrustedListID string `json:"interaction_trusted_list_id,omitempty"`
+	// Name of a hashtag, without leading #. Replies requiring
+	// approval that use this hashtag are approved automatically.
+	InteractionApprovalTag string `json:"interaction_approval_tag,omitempty"`
+	// Approvals of interactions with this account's statuses
+	// expire after this many days, hiding the interactions
+	// again. 0 means approvals don't expire.
+	InteractionApprovalExpiryDays int `json:"interaction_approval_expiry_days"`
+	// Periodically receive a notification reminding
+	// of interactions awaiting this account's approval.
+	InteractionPendingReminders bool `json:"interaction_pending_reminders"`
 	// This account is aliased to / also known as accounts at the
 	// given ActivityPub URIs. To set this, use `/api/v1/accounts/alias`.
 	//
@@ -213,10 +213,19 @@ type StatusCreateRequest struct {
 	// Providing this parameter will cause ScheduledStatus to be returned instead of Status.
 	// Must be at least 5 minutes in the future.
 	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
+	// ISO 8601 Datetime at which to automatically delete this status.
+	// Must be in the future.
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
 	// ISO 639 language code for this status.
 	Language string `form:"language" json:"language" xml:"language"`
 	// Content type to use when parsing this status.
 	ContentType StatusContentType `form:"content_type" json:"content_type" xml:"content_type"`
+	// Interaction policy to use for this status.
+	// If not set, the account's default policy
+	// for the status visibility will be used.
+	//
+	// Only settable via JSON.
+	InteractionPolicy *InteractionPolicy `form:"-" json:"interaction_policy" xml:"-"`
 }
 
 // Visibility models the visibility of a status.
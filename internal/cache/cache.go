@@ -89,6 +89,7 @@ func (c *Caches) Init() {
 	c.initMention()
 	c.initMove()
 	c.initNotification()
+	c.initPendingInteractionCount()
 	c.initPoll()
 	c.initPollVote()
 	c.initPollVoteIDs()
@@ -98,6 +99,7 @@ func (c *Caches) Init() {
 	c.initStatusBookmarkIDs()
 	c.initStatusFave()
 	c.initStatusFaveIDs()
+	c.initStatusInteractionCounts()
 	c.initTag()
 	c.initTagIDsFollowedByAccount()
 	c.initThreadMute()
@@ -166,6 +168,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.Mention.Trim(threshold)
 	c.DB.Move.Trim(threshold)
 	c.DB.Notification.Trim(threshold)
+	c.DB.PendingInteractionCount.Trim(threshold)
 	c.DB.Poll.Trim(threshold)
 	c.DB.PollVote.Trim(threshold)
 	c.DB.PollVoteIDs.Trim(threshold)
@@ -175,6 +178,7 @@ func (c *Caches) Sweep(threshold float64) {
 	c.DB.StatusBookmarkIDs.Trim(threshold)
 	c.DB.StatusFave.Trim(threshold)
 	c.DB.StatusFaveIDs.Trim(threshold)
+	c.DB.StatusInteractionCounts.Trim(threshold)
 	c.DB.Tag.Trim(threshold)
 	c.DB.TagIDsFollowedByAccount.Trim(threshold)
 	c.DB.ThreadMute.Trim(threshold)
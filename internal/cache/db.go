@@ -18,6 +18,8 @@
 package cache
 
 import (
+	"unsafe"
+
 	"codeberg.org/gruf/go-structr"
 	"github.com/superseriousbusiness/gotosocial/internal/cache/domain"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -133,6 +135,10 @@ type DBCaches struct {
 	// Notification provides access to the gtsmodel Notification database cache.
 	Notification StructCache[*gtsmodel.Notification]
 
+	// PendingInteractionCount provides access to the
+	// pending interaction count (by account ID) database cache.
+	PendingInteractionCount ValueCache[int]
+
 	// Poll provides access to the gtsmodel Poll database cache.
 	Poll StructCache[*gtsmodel.Poll]
 
@@ -160,6 +166,10 @@ type DBCaches struct {
 	// StatusFaveIDs provides access to the status fave IDs list database cache.
 	StatusFaveIDs SliceCache[string]
 
+	// StatusInteractionCounts provides access to the status
+	// interaction counts (by status ID) database cache.
+	StatusInteractionCounts ValueCache[gtsmodel.StatusInteractionCounts]
+
 	// Tag provides access to the gtsmodel Tag database cache.
 	Tag StructCache[*gtsmodel.Tag]
 
@@ -1046,6 +1056,19 @@ func (c *Caches) initNotification() {
 	})
 }
 
+func (c *Caches) initPendingInteractionCount() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofIDStr,
+		unsafe.Sizeof(int(0)),
+		config.GetCachePendingInteractionCountMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DB.PendingInteractionCount.Init(0, cap)
+}
+
 func (c *Caches) initPoll() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -1308,6 +1331,19 @@ func (c *Caches) initStatusFaveIDs() {
 	c.DB.StatusFaveIDs.Init(0, cap)
 }
 
+func (c *Caches) initStatusInteractionCounts() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofIDStr,
+		unsafe.Sizeof(gtsmodel.StatusInteractionCounts{}),
+		config.GetCacheStatusInteractionCountsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.DB.StatusInteractionCounts.Init(0, cap)
+}
+
 func (c *Caches) initTag() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -187,14 +187,29 @@ func (c *Caches) OnInvalidateStatus(status *gtsmodel.Status) {
 	// c.DB.Media().Invalidate("StatusID") will not work.
 	c.DB.Media.InvalidateIDs("ID", status.AttachmentIDs)
 
+	// Invalidate interaction counts of this status.
+	c.DB.StatusInteractionCounts.Invalidate(status.ID)
+
 	if status.BoostOfID != "" {
 		// Invalidate boost ID list of the original status.
 		c.DB.BoostOfIDs.Invalidate(status.BoostOfID)
+
+		// Invalidate interaction counts of the original status.
+		c.DB.StatusInteractionCounts.Invalidate(status.BoostOfID)
+
+		// Invalidate pending interaction count of boosted account.
+		c.DB.PendingInteractionCount.Invalidate(status.BoostOfAccountID)
 	}
 
 	if status.InReplyToID != "" {
 		// Invalidate in reply to ID list of original status.
 		c.DB.InReplyToIDs.Invalidate(status.InReplyToID)
+
+		// Invalidate interaction counts of original status.
+		c.DB.StatusInteractionCounts.Invalidate(status.InReplyToID)
+
+		// Invalidate pending interaction count of replied-to account.
+		c.DB.PendingInteractionCount.Invalidate(status.InReplyToAccountID)
 	}
 
 	if status.PollID != "" {
@@ -206,11 +221,20 @@ func (c *Caches) OnInvalidateStatus(status *gtsmodel.Status) {
 func (c *Caches) OnInvalidateStatusBookmark(bookmark *gtsmodel.StatusBookmark) {
 	// Invalidate status bookmark ID list for this status.
 	c.DB.StatusBookmarkIDs.Invalidate(bookmark.StatusID)
+
+	// Invalidate interaction counts for this status.
+	c.DB.StatusInteractionCounts.Invalidate(bookmark.StatusID)
 }
 
 func (c *Caches) OnInvalidateStatusFave(fave *gtsmodel.StatusFave) {
 	// Invalidate status fave ID list for this status.
 	c.DB.StatusFaveIDs.Invalidate(fave.StatusID)
+
+	// Invalidate interaction counts for this status.
+	c.DB.StatusInteractionCounts.Invalidate(fave.StatusID)
+
+	// Invalidate pending interaction count of faved account.
+	c.DB.PendingInteractionCount.Invalidate(fave.TargetAccountID)
 }
 
 func (c *Caches) OnInvalidateUser(user *gtsmodel.User) {
@@ -199,6 +199,7 @@ func totalOfRatios() float64 {
 		config.GetCacheMentionMemRatio() +
 		config.GetCacheMoveMemRatio() +
 		config.GetCacheNotificationMemRatio() +
+		config.GetCachePendingInteractionCountMemRatio() +
 		config.GetCachePollMemRatio() +
 		config.GetCachePollVoteMemRatio() +
 		config.GetCacheReportMemRatio() +
@@ -207,6 +208,7 @@ func totalOfRatios() float64 {
 		config.GetCacheStatusBookmarkIDsMemRatio() +
 		config.GetCacheStatusFaveMemRatio() +
 		config.GetCacheStatusFaveIDsMemRatio() +
+		config.GetCacheStatusInteractionCountsMemRatio() +
 		config.GetCacheTagMemRatio() +
 		config.GetCacheThreadMuteMemRatio() +
 		config.GetCacheTokenMemRatio() +
@@ -276,12 +278,14 @@ func sizeofAccountSettings() uintptr {
 
 func sizeofAccountStats() uintptr {
 	return uintptr(size.Of(&gtsmodel.AccountStats{
-		AccountID:           exampleID,
-		FollowersCount:      util.Ptr(100),
-		FollowingCount:      util.Ptr(100),
-		StatusesCount:       util.Ptr(100),
-		StatusesPinnedCount: util.Ptr(100),
-		LastStatusAt:        exampleTime,
+		AccountID:            exampleID,
+		FollowersCount:       util.Ptr(100),
+		LocalFollowersCount:  util.Ptr(50),
+		RemoteFollowersCount: util.Ptr(50),
+		FollowingCount:       util.Ptr(100),
+		StatusesCount:        util.Ptr(100),
+		StatusesPinnedCount:  util.Ptr(100),
+		LastStatusAt:         exampleTime,
 	}))
 }
 
@@ -612,6 +616,7 @@ func sizeofStatus() uintptr {
 		InReplyToID:              exampleID,
 		InReplyToURI:             exampleURI,
 		InReplyToAccountID:       exampleID,
+		InReplyToBoostID:         exampleID,
 		BoostOfID:                exampleID,
 		BoostOfAccountID:         exampleID,
 		ContentWarning:           exampleUsername, // similar length
@@ -83,6 +83,65 @@ func (c *SliceCache[T]) Cap() int {
 	return c.cache.Cap()
 }
 
+// ValueCache wraps a simple.Cache to provide simple loader-callback
+// functions for fetching + caching single values (e.g. counts).
+type ValueCache[T any] struct {
+	cache simple.Cache[string, T]
+}
+
+// Init initializes the cache with given length + capacity.
+func (c *ValueCache[T]) Init(len, cap int) {
+	c.cache = simple.Cache[string, T]{}
+	c.cache.Init(len, cap)
+}
+
+// Load will attempt to load an existing value from cache for key, else calling load function and caching the result.
+func (c *ValueCache[T]) Load(key string, load func() (T, error)) (T, error) {
+	// Look for cached value.
+	data, ok := c.cache.Get(key)
+
+	if !ok {
+		var err error
+
+		// Not cached, load!
+		data, err = load()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+
+		// Store the data.
+		c.cache.Set(key, data)
+	}
+
+	return data, nil
+}
+
+// Invalidate: see simple.Cache{}.InvalidateAll().
+func (c *ValueCache[T]) Invalidate(keys ...string) {
+	_ = c.cache.InvalidateAll(keys...)
+}
+
+// Trim: see simple.Cache{}.Trim().
+func (c *ValueCache[T]) Trim(perc float64) {
+	c.cache.Trim(perc)
+}
+
+// Clear: see simple.Cache{}.Clear().
+func (c *ValueCache[T]) Clear() {
+	c.cache.Clear()
+}
+
+// Len: see simple.Cache{}.Len().
+func (c *ValueCache[T]) Len() int {
+	return c.cache.Len()
+}
+
+// Cap: see simple.Cache{}.Cap().
+func (c *ValueCache[T]) Cap() int {
+	return c.cache.Cap()
+}
+
 // StructCache wraps a structr.Cache{} to simple index caching
 // by name (also to ease update to library version that introduced
 // this). (in the future it may be worth embedding these indexes by
@@ -34,16 +34,20 @@ const (
 )
 
 type Cleaner struct {
-	state *state.State
-	emoji Emoji
-	media Media
+	state       *state.State
+	emoji       Emoji
+	interaction Interaction
+	media       Media
+	status      Status
 }
 
 func New(state *state.State) *Cleaner {
 	c := new(Cleaner)
 	c.state = state
 	c.emoji.Cleaner = c
+	c.interaction.Cleaner = c
 	c.media.Cleaner = c
+	c.status.Cleaner = c
 	return c
 }
 
@@ -52,11 +56,21 @@ func (c *Cleaner) Emoji() *Emoji {
 	return &c.emoji
 }
 
+// Interaction returns the interaction set of cleaner utilities.
+func (c *Cleaner) Interaction() *Interaction {
+	return &c.interaction
+}
+
 // Media returns the media set of cleaner utilities.
 func (c *Cleaner) Media() *Media {
 	return &c.media
 }
 
+// Status returns the status set of cleaner utilities.
+func (c *Cleaner) Status() *Status {
+	return &c.status
+}
+
 // haveFiles returns whether all of the provided files exist within current storage.
 func (c *Cleaner) haveFiles(ctx context.Context, files ...string) (bool, error) {
 	for _, path := range files {
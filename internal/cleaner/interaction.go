@@ -0,0 +1,163 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Interaction encompasses a set of
+// interaction cleanup / admin utils.
+type Interaction struct{ *Cleaner }
+
+// LogMergeDuplicateApprovals performs Interaction.MergeDuplicateApprovals(...), logging the start and outcome.
+func (i *Interaction) LogMergeDuplicateApprovals(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := i.MergeDuplicateApprovals(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "merged: %d", n)
+	}
+}
+
+// MergeDuplicateApprovals will check for interactions that have more than
+// one approval stored, eg., due to duplicate delivery of the interaction.
+// Only the earliest approval of each is kept, the interaction is updated to
+// be approved by it, and the others are deleted. Returns the number of
+// duplicate approvals deleted. Context will be checked for `gtscontext.DryRun()`
+// to perform the action.
+func (i *Interaction) MergeDuplicateApprovals(ctx context.Context) (int, error) {
+	interactionURIs, err := i.state.DB.GetInteractionURIsWithDuplicateApprovals(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return 0, gtserror.Newf("error getting interactions with duplicate approvals: %w", err)
+	}
+
+	var total int
+
+	for _, interactionURI := range interactionURIs {
+		// Merge approvals of this interaction.
+		merged, err := i.mergeApprovals(ctx, interactionURI)
+		if err != nil {
+			return total, err
+		}
+
+		// Update
+		// count.
+		total += merged
+	}
+
+	return total, nil
+}
+
+func (i *Interaction) mergeApprovals(ctx context.Context, interactionURI string) (int, error) {
+	// Start a log entry for interaction.
+	l := log.WithContext(ctx).
+		WithField("interaction", interactionURI)
+
+	approvals, err := i.state.DB.GetInteractionApprovalsByInteractionURI(ctx, interactionURI)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return 0, gtserror.Newf("error getting approvals of interaction %s: %w", interactionURI, err)
+	}
+
+	if len(approvals) < 2 {
+		// Nothing
+		// to merge.
+		return 0, nil
+	}
+
+	// Keep the earliest approval.
+	keep, dupes := approvals[0], approvals[1:]
+	l.Warnf("%d duplicate approvals, keeping %s", len(dupes), keep.URI)
+
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return len(dupes), nil
+	}
+
+	// Ensure interaction is approved by the kept approval,
+	// before deleting any approval it may point to now.
+	l.Debug("merging duplicate approvals")
+	if err := i.setApprovedBy(ctx, keep); err != nil {
+		return 0, err
+	}
+
+	for _, dupe := range dupes {
+		if err := i.state.DB.DeleteInteractionApprovalByID(ctx, dupe.ID); err != nil {
+			return 0, gtserror.Newf("error deleting approval %s: %w", dupe.ID, err)
+		}
+
+		// Cancel any scheduled expiry of dupe.
+		_ = i.state.Workers.Scheduler.Cancel(gtsmodel.ApprovalExpiryID(dupe.ID))
+	}
+
+	return len(dupes), nil
+}
+
+// setApprovedBy updates the interaction targeted by
+// the given approval to be approved by it, if needed.
+// Interactions that no longer exist are skipped.
+func (i *Interaction) setApprovedBy(ctx context.Context, approval *gtsmodel.InteractionApproval) error {
+	switch approval.InteractionType {
+
+	case gtsmodel.InteractionLike:
+		fave, err := i.state.DB.GetStatusFaveByURI(
+			gtscontext.SetBarebones(ctx),
+			approval.InteractionURI,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting fave %s: %w", approval.InteractionURI, err)
+		}
+
+		if fave == nil || fave.ApprovedByURI == approval.URI {
+			return nil
+		}
+
+		fave.ApprovedByURI = approval.URI
+		if err := i.state.DB.UpdateStatusFave(ctx, fave, "approved_by_uri"); err != nil {
+			return gtserror.Newf("error updating fave: %w", err)
+		}
+
+	case gtsmodel.InteractionReply,
+		gtsmodel.InteractionAnnounce:
+		status, err := i.state.DB.GetStatusByURI(
+			gtscontext.SetBarebones(ctx),
+			approval.InteractionURI,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("error getting status %s: %w", approval.InteractionURI, err)
+		}
+
+		if status == nil || status.ApprovedByURI == approval.URI {
+			return nil
+		}
+
+		status.ApprovedByURI = approval.URI
+		if err := i.state.DB.UpdateStatus(ctx, status, "approved_by_uri"); err != nil {
+			return gtserror.Newf("error updating status: %w", err)
+		}
+	}
+
+	return nil
+}
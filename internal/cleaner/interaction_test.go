@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestInteractionMergeDuplicateApprovals() {
+	suite.testInteractionMergeDuplicateApprovals(context.Background())
+}
+
+func (suite *CleanerTestSuite) TestInteractionMergeDuplicateApprovalsDryRun() {
+	suite.testInteractionMergeDuplicateApprovals(gtscontext.SetDryRun(context.Background()))
+}
+
+func (suite *CleanerTestSuite) testInteractionMergeDuplicateApprovals(ctx context.Context) {
+	var (
+		// Reply that was approved three times over.
+		reply    = testrig.NewTestStatuses()["local_account_2_status_1"]
+		approver = testrig.NewTestAccounts()["local_account_1"]
+	)
+
+	// Seed the duplicate approvals, oldest first.
+	approvals := make([]*gtsmodel.InteractionApproval, 0, 3)
+	for _, id := range []string{
+		"01J2Q4X6V4SNPN3R5B8FJ1K0AC",
+		"01J2Q4XDJ3YZ1F9G2KQ7W4B6TM",
+		"01J2Q4XMQ8H5R0C6N3VXE2J7PD",
+	} {
+		approval := &gtsmodel.InteractionApproval{
+			ID:                   id,
+			AccountID:            approver.ID,
+			InteractingAccountID: reply.AccountID,
+			InteractionURI:       reply.URI,
+			InteractionType:      gtsmodel.InteractionReply,
+			URI:                  approver.URI + "/accepts/" + id,
+		}
+		if err := suite.state.DB.PutInteractionApproval(ctx, approval); err != nil {
+			suite.FailNow(err.Error())
+		}
+		approvals = append(approvals, approval)
+	}
+
+	// Reply points at the latest approval.
+	reply.ApprovedByURI = approvals[2].URI
+	if err := suite.state.DB.UpdateStatus(ctx, reply, "approved_by_uri"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Merge duplicate approvals.
+	n, err := suite.cleaner.Interaction().MergeDuplicateApprovals(ctx)
+	suite.NoError(err)
+	suite.Equal(2, n)
+
+	// Get the approvals + reply again.
+	dbApprovals, err := suite.state.DB.GetInteractionApprovalsByInteractionURI(ctx, reply.URI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbReply, err := suite.state.DB.GetStatusByID(ctx, reply.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if gtscontext.DryRun(ctx) {
+		// Nothing should be changed.
+		suite.Len(dbApprovals, 3)
+		suite.Equal(approvals[2].URI, dbReply.ApprovedByURI)
+		return
+	}
+
+	// Only the earliest approval should remain,
+	// with the reply now approved by it.
+	suite.Len(dbApprovals, 1)
+	suite.Equal(approvals[0].ID, dbApprovals[0].ID)
+	suite.Equal(approvals[0].URI, dbReply.ApprovedByURI)
+
+	// Merging again should be a no-op.
+	n, err = suite.cleaner.Interaction().MergeDuplicateApprovals(ctx)
+	suite.NoError(err)
+	suite.Zero(n)
+}
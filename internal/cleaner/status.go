@@ -0,0 +1,174 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+// Status encompasses a set of
+// status cleanup / admin utils.
+type Status struct{ *Cleaner }
+
+// LogFixRelations performs Status.FixRelations(...), logging the start and outcome.
+func (s *Status) LogFixRelations(ctx context.Context) {
+	log.Info(ctx, "start")
+	if n, err := s.FixRelations(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "fixed: %d", n)
+	}
+}
+
+// FixRelations will check all statuses for AttachmentIDs and MentionIDs
+// that don't match the media attachments and mentions actually pointing
+// to each status in the database, logging each mismatch found. Statuses
+// with mismatches are updated to reference exactly the related models.
+// Context will be checked for `gtscontext.DryRun()` to perform the action.
+func (s *Status) FixRelations(ctx context.Context) (int, error) {
+	var (
+		total int
+		page  paging.Page
+	)
+
+	// Set page select limit.
+	page.Limit = selectLimit
+
+	for {
+		// Fetch the next batch of statuses to next max ID.
+		statuses, err := s.state.DB.GetStatuses(
+			gtscontext.SetBarebones(ctx),
+			&page,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return total, gtserror.Newf("error getting statuses: %w", err)
+		}
+
+		// Get current max ID.
+		maxID := page.Max.Value
+
+		// If no statuses or the same group is returned, we reached end.
+		if len(statuses) == 0 || maxID == statuses[len(statuses)-1].ID {
+			break
+		}
+
+		// Use last ID as the next 'maxID'.
+		maxID = statuses[len(statuses)-1].ID
+		page.Max = paging.MaxID(maxID)
+
+		for _, status := range statuses {
+			// Check / fix mismatched relations.
+			fixed, err := s.fixRelations(ctx, status)
+			if err != nil {
+				return total, err
+			}
+
+			if fixed {
+				// Update
+				// count.
+				total++
+			}
+		}
+	}
+
+	return total, nil
+}
+
+func (s *Status) fixRelations(ctx context.Context, status *gtsmodel.Status) (bool, error) {
+	// Start a log entry for status.
+	l := log.WithContext(ctx).
+		WithField("status", status.ID)
+
+	// Get IDs of attachments actually pointing to this status.
+	attachmentIDs, err := s.state.DB.GetAttachmentIDsForStatus(ctx, status.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting attachment ids for status %s: %w", status.ID, err)
+	}
+
+	// Get IDs of mentions actually pointing to this status.
+	mentionIDs, err := s.state.DB.GetMentionIDsForStatus(ctx, status.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting mention ids for status %s: %w", status.ID, err)
+	}
+
+	var columns []string
+
+	if ids, ok := matchIDs(status.AttachmentIDs, attachmentIDs); !ok {
+		l.Warnf("attachment ids mismatch: have %v, related %v", status.AttachmentIDs, attachmentIDs)
+		status.AttachmentIDs = ids
+		columns = append(columns, "attachment_ids")
+	}
+
+	if ids, ok := matchIDs(status.MentionIDs, mentionIDs); !ok {
+		l.Warnf("mention ids mismatch: have %v, related %v", status.MentionIDs, mentionIDs)
+		status.MentionIDs = ids
+		columns = append(columns, "mention_ids")
+	}
+
+	if len(columns) == 0 {
+		// All
+		// fine.
+		return false, nil
+	}
+
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return true, nil
+	}
+
+	// Update status model in the database to match related models.
+	l.Debug("fixing mismatched status relations")
+	if err := s.state.DB.UpdateStatus(ctx, status, columns...); err != nil {
+		return true, gtserror.Newf("error updating status: %w", err)
+	}
+
+	return true, nil
+}
+
+// matchIDs checks whether the given slice of IDs contains exactly
+// the given related IDs. If not, it returns a fixed slice, keeping
+// the order of existing IDs and appending any missing related IDs.
+func matchIDs(ids []string, related []string) ([]string, bool) {
+	fixed := make([]string, 0, len(related))
+
+	// Keep existing IDs that are related, in order.
+	for _, id := range ids {
+		if slices.Contains(related, id) &&
+			!slices.Contains(fixed, id) {
+			fixed = append(fixed, id)
+		}
+	}
+
+	// Append missing related IDs.
+	for _, id := range related {
+		if !slices.Contains(fixed, id) {
+			fixed = append(fixed, id)
+		}
+	}
+
+	return fixed, slices.Equal(ids, fixed)
+}
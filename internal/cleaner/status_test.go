@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestStatusFixRelations() {
+	suite.testStatusFixRelations(context.Background())
+}
+
+func (suite *CleanerTestSuite) TestStatusFixRelationsDryRun() {
+	suite.testStatusFixRelations(gtscontext.SetDryRun(context.Background()))
+}
+
+func (suite *CleanerTestSuite) testStatusFixRelations(ctx context.Context) {
+	var (
+		statuses = testrig.NewTestStatuses()
+		accounts = testrig.NewTestAccounts()
+
+		// Status that will reference a missing attachment.
+		missingAttachment = statuses["local_account_1_status_1"]
+
+		// Status that will be missing a reference to a mention.
+		missingMention = statuses["admin_account_status_1"]
+	)
+
+	// Seed the mismatches.
+	const bogusID = "01J1FXRT3KFJ6VBTKGBFCW2XHN"
+	missingAttachment.AttachmentIDs = append(missingAttachment.AttachmentIDs, bogusID)
+	if err := suite.state.DB.UpdateStatus(ctx, missingAttachment, "attachment_ids"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	mention := &gtsmodel.Mention{
+		ID:               "01J1FXVZJ6QH5XYFJ4K8RWC0Z7",
+		StatusID:         missingMention.ID,
+		OriginAccountID:  missingMention.AccountID,
+		OriginAccountURI: missingMention.AccountURI,
+		TargetAccountID:  accounts["local_account_1"].ID,
+		Silent:           util.Ptr(false),
+	}
+	if err := suite.state.DB.PutMention(ctx, mention); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check / fix status relations.
+	n, err := suite.cleaner.Status().FixRelations(ctx)
+	suite.NoError(err)
+	suite.GreaterOrEqual(n, 2)
+
+	// Get the statuses again.
+	dbMissingAttachment, err := suite.state.DB.GetStatusByID(ctx, missingAttachment.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbMissingMention, err := suite.state.DB.GetStatusByID(ctx, missingMention.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if gtscontext.DryRun(ctx) {
+		// Nothing should have changed.
+		suite.Contains(dbMissingAttachment.AttachmentIDs, bogusID)
+		suite.NotContains(dbMissingMention.MentionIDs, mention.ID)
+		return
+	}
+
+	// Mismatches should be fixed.
+	suite.NotContains(dbMissingAttachment.AttachmentIDs, bogusID)
+	suite.Contains(dbMissingMention.MentionIDs, mention.ID)
+
+	// A second run should find nothing left to fix.
+	n, err = suite.cleaner.Status().FixRelations(ctx)
+	suite.NoError(err)
+	suite.Zero(n)
+}
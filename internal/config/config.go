@@ -58,6 +58,7 @@ type Configuration struct {
 	BindAddress        string   `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
 	Port               int      `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
 	TrustedProxies     []string `name:"trusted-proxies" usage:"Proxies to trust when parsing x-forwarded headers into real IPs."`
+	NodeName           string   `name:"node-name" usage:"Name identifying this GoToSocial process, eg., in a clustered setup. If set, it's recorded against account stats whenever they're written, for debugging stats drift."`
 	SoftwareVersion    string   `name:"software-version" usage:""`
 
 	DbType                   string        `name:"db-type" usage:"Database type: eg., postgres"`
@@ -77,20 +78,30 @@ type Configuration struct {
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceFederationMode         string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
-	InstanceFederationSpamFilter   bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
-	InstanceExposePeers            bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeSuspended        bool               `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
-	InstanceExposeSuspendedWeb     bool               `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
-	InstanceExposePublicTimeline   bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceDeliverToSharedInboxes bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
-	InstanceInjectMastodonVersion  bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
-	InstanceLanguages              language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
+	InstanceFederationMode                  string             `name:"instance-federation-mode" usage:"Set instance federation mode."`
+	InstanceFederationSpamFilter            bool               `name:"instance-federation-spam-filter" usage:"Enable basic spam filter heuristics for messages coming from other instances, and drop messages identified as spam"`
+	InstanceExposePeers                     bool               `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeSuspended                 bool               `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
+	InstanceExposeSuspendedWeb              bool               `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
+	InstanceExposePublicTimeline            bool               `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceDeliverToSharedInboxes          bool               `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceInjectMastodonVersion           bool               `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	InstanceLanguages                       language.Languages `name:"instance-languages" usage:"BCP47 language tags for the instance. Used to indicate the preferred languages of instance residents (in order from most-preferred to least-preferred)."`
+	InstanceInteractionApprovalCascadeDepth int                `name:"instance-interaction-approval-cascade-depth" usage:"When a reply is approved, also approve pending replies by the same account nested up to this many levels beneath it in the thread, which await approval by the same account. 0 to disable."`
+	InstanceInteractionApprovedBoostable    bool               `name:"instance-interaction-approved-boostable" usage:"Leave replies boostable by others once they have been approved. If false, only the author of a reply approved on this instance may boost it."`
+	InstanceBlockRemoveFaves                bool               `name:"instance-block-remove-faves" usage:"When a local account blocks another account, remove any faves by the blocked account on the blocking account's statuses."`
+	InstanceInteractionPendingLimit         int                `name:"instance-interaction-pending-limit" usage:"Maximum number of interactions from one account that may be pending approval by another account at once. Further interactions will be rejected. 0 to disable."`
+	InstanceInteractionPendingReminderEvery time.Duration      `name:"instance-interaction-pending-reminder-every" usage:"Minimum interval between notifications reminding an account of interactions pending its approval, for accounts that opted in to such reminders. 0 to disable."`
+	InstanceReportsPreserveStatuses         bool               `name:"instance-reports-preserve-statuses" usage:"When a reported status is deleted, keep a copy of its content, content warning and attachment metadata in the report(s) referencing it, for moderators to refer back to."`
+	InstanceHideBlockedDomainStats          bool               `name:"instance-hide-blocked-domain-stats" usage:"Don't update stats of accounts on domains blocked by this instance, and show their follower, following and status counts as zero."`
 
-	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
-	AccountsReasonRequired   bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
-	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
-	AccountsCustomCSSLength  int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsRegistrationOpen        bool          `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
+	AccountsReasonRequired          bool          `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
+	AccountsAllowCustomCSS          bool          `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
+	AccountsCustomCSSLength         int           `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
+	AccountsMoveConcurrency         int           `name:"accounts-move-concurrency" usage:"Maximum number of account Moves processed at once instance-wide. Further Moves are deferred until later. 0 for no limit."`
+	AccountsMoveFollowRetryAttempts int           `name:"accounts-move-follow-retry-attempts" usage:"Number of times to re-send the follow of a Move target by a migrated local follower, if the target's instance hasn't confirmed it. 0 to disable."`
+	AccountsMoveFollowRetryDelay    time.Duration `name:"accounts-move-follow-retry-delay" usage:"Delay before each re-send of an unconfirmed follow of a Move target by a migrated local follower."`
 
 	MediaDescriptionMinChars int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
 	MediaDescriptionMaxChars int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
@@ -102,6 +113,7 @@ type Configuration struct {
 	MediaCleanupFrom         string        `name:"media-cleanup-from" usage:"Time of day from which to start running media cleanup/prune jobs. Should be in the format 'hh:mm:ss', eg., '15:04:05'."`
 	MediaCleanupEvery        time.Duration `name:"media-cleanup-every" usage:"Period to elapse between cleanups, starting from media-cleanup-at."`
 	MediaFfmpegPoolSize      int           `name:"media-ffmpeg-pool-size" usage:"Number of instances of the embedded ffmpeg WASM binary to add to the media processing pool. 0 or less uses GOMAXPROCS."`
+	MediaRemoteDeleteRetain  bool          `name:"media-remote-delete-retain" usage:"If true, media attachments of remote statuses will be unattached rather than deleted when the status is deleted by its origin, and left for the next media cleanup to remove."`
 
 	StorageBackend       string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
 	StorageLocalBasePath string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
@@ -113,10 +125,16 @@ type Configuration struct {
 	StorageS3Proxy       bool   `name:"storage-s3-proxy" usage:"Proxy S3 contents through GoToSocial instead of redirecting to a presigned URL"`
 	StorageS3RedirectURL string `name:"storage-s3-redirect-url" usage:"Custom URL to use for redirecting S3 media links. If set, this will be used instead of the S3 bucket URL."`
 
-	StatusesMaxChars           int `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
-	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
-	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
-	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesMaxChars                      int           `name:"statuses-max-chars" usage:"Max permitted characters for posted statuses, including content warning"`
+	StatusesPollMaxOptions                int           `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
+	StatusesPollOptionMaxChars            int           `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
+	StatusesPollArchiveOnDelete           bool          `name:"statuses-poll-archive-on-delete" usage:"When a status with a poll is deleted, archive the poll's final tallies before deleting its votes"`
+	StatusesPollExpirySweepAge            time.Duration `name:"statuses-poll-expiry-sweep-age" usage:"Scheduled poll expiries for polls that no longer exist are cancelled by a sweep running at this interval, once they are at least this old. 0 to disable."`
+	StatusesMediaMaxFiles                 int           `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesOrphanedReplyPlaceholder      bool          `name:"statuses-orphaned-reply-placeholder" usage:"When a status with replies is deleted, reparent its replies to a placeholder 'deleted' status instead of leaving them dangling"`
+	StatusesReplyDeleteParentUpdate       bool          `name:"statuses-reply-delete-parent-update" usage:"When a reply to a local status is deleted, federate an Update of the parent status reflecting its new reply count"`
+	StatusesReplyDeleteUpdateDelay        time.Duration `name:"statuses-reply-delete-update-delay" usage:"Wait this long before federating a parent Update after a reply is deleted; further reply deletions within this window are coalesced into the same Update"`
+	StatusesTimelineRemovalDeferThreshold int           `name:"statuses-timeline-removal-defer-threshold" usage:"When a status is deleted whose author has more than this many followers, remove it from timelines in the background instead of before the deletion completes. 0 to disable."`
 
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
@@ -181,6 +199,8 @@ type Configuration struct {
 	AdminMediaPruneDryRun    bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
 	AdminMediaListLocalOnly  bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true"`
 	AdminMediaListRemoteOnly bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true"`
+	AdminStatusFixRelations  bool   `name:"fix" usage:"fix statuses with mismatched attachments/mentions, instead of only logging them"`
+	AdminInteractionMerge    bool   `name:"merge" usage:"merge duplicate approvals of interactions, instead of only logging them"`
 
 	RequestIDHeader string `name:"request-id-header" usage:"Header to extract the Request ID from. Eg.,'X-Request-Id'."`
 }
@@ -225,6 +245,7 @@ type CacheConfiguration struct {
 	MentionMemRatio                   float64       `name:"mention-mem-ratio"`
 	MoveMemRatio                      float64       `name:"move-mem-ratio"`
 	NotificationMemRatio              float64       `name:"notification-mem-ratio"`
+	PendingInteractionCountMemRatio   float64       `name:"pending-interaction-count-mem-ratio"`
 	PollMemRatio                      float64       `name:"poll-mem-ratio"`
 	PollVoteMemRatio                  float64       `name:"poll-vote-mem-ratio"`
 	PollVoteIDsMemRatio               float64       `name:"poll-vote-ids-mem-ratio"`
@@ -234,6 +255,7 @@ type CacheConfiguration struct {
 	StatusBookmarkIDsMemRatio         float64       `name:"status-bookmark-ids-mem-ratio"`
 	StatusFaveMemRatio                float64       `name:"status-fave-mem-ratio"`
 	StatusFaveIDsMemRatio             float64       `name:"status-fave-ids-mem-ratio"`
+	StatusInteractionCountsMemRatio   float64       `name:"status-interaction-counts-mem-ratio"`
 	TagMemRatio                       float64       `name:"tag-mem-ratio"`
 	TagIDsFollowedByAccountMemRatio   float64       `name:"tag-ids-followed-by-account-mem-ratio"`
 	ThreadMuteMemRatio                float64       `name:"thread-mute-mem-ratio"`
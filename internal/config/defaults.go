@@ -40,6 +40,7 @@ var Defaults = Configuration{
 	BindAddress:        "0.0.0.0",
 	Port:               8080,
 	TrustedProxies:     []string{"127.0.0.1/32", "::1"}, // localhost
+	NodeName:           "",
 
 	DbType:                   "postgres",
 	DbAddress:                "",
@@ -58,18 +59,28 @@ var Defaults = Configuration{
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
 
-	InstanceFederationMode:         InstanceFederationModeDefault,
-	InstanceFederationSpamFilter:   false,
-	InstanceExposePeers:            false,
-	InstanceExposeSuspended:        false,
-	InstanceExposeSuspendedWeb:     false,
-	InstanceDeliverToSharedInboxes: true,
-	InstanceLanguages:              make(language.Languages, 0),
-
-	AccountsRegistrationOpen: false,
-	AccountsReasonRequired:   true,
-	AccountsAllowCustomCSS:   false,
-	AccountsCustomCSSLength:  10000,
+	InstanceFederationMode:                  InstanceFederationModeDefault,
+	InstanceFederationSpamFilter:            false,
+	InstanceExposePeers:                     false,
+	InstanceExposeSuspended:                 false,
+	InstanceExposeSuspendedWeb:              false,
+	InstanceDeliverToSharedInboxes:          true,
+	InstanceLanguages:                       make(language.Languages, 0),
+	InstanceInteractionApprovalCascadeDepth: 0,
+	InstanceInteractionApprovedBoostable:    true,
+	InstanceBlockRemoveFaves:                false,
+	InstanceInteractionPendingLimit:         0,
+	InstanceInteractionPendingReminderEvery: 24 * time.Hour,
+	InstanceReportsPreserveStatuses:         false,
+	InstanceHideBlockedDomainStats:          false,
+
+	AccountsRegistrationOpen:        false,
+	AccountsReasonRequired:          true,
+	AccountsAllowCustomCSS:          false,
+	AccountsCustomCSSLength:         10000,
+	AccountsMoveConcurrency:         0,
+	AccountsMoveFollowRetryAttempts: 3,
+	AccountsMoveFollowRetryDelay:    time.Hour,
 
 	MediaDescriptionMinChars: 0,
 	MediaDescriptionMaxChars: 1500,
@@ -81,6 +92,7 @@ var Defaults = Configuration{
 	MediaCleanupFrom:         "00:00",        // Midnight.
 	MediaCleanupEvery:        24 * time.Hour, // 1/day.
 	MediaFfmpegPoolSize:      1,
+	MediaRemoteDeleteRetain:  false,
 
 	StorageBackend:       "local",
 	StorageLocalBasePath: "/gotosocial/storage",
@@ -88,10 +100,16 @@ var Defaults = Configuration{
 	StorageS3Proxy:       false,
 	StorageS3RedirectURL: "",
 
-	StatusesMaxChars:           5000,
-	StatusesPollMaxOptions:     6,
-	StatusesPollOptionMaxChars: 50,
-	StatusesMediaMaxFiles:      6,
+	StatusesMaxChars:                      5000,
+	StatusesPollMaxOptions:                6,
+	StatusesPollOptionMaxChars:            50,
+	StatusesPollArchiveOnDelete:           false,
+	StatusesPollExpirySweepAge:            24 * time.Hour,
+	StatusesMediaMaxFiles:                 6,
+	StatusesOrphanedReplyPlaceholder:      false,
+	StatusesReplyDeleteParentUpdate:       false,
+	StatusesReplyDeleteUpdateDelay:        time.Minute,
+	StatusesTimelineRemovalDeferThreshold: 0,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
@@ -189,6 +207,7 @@ var Defaults = Configuration{
 		MentionMemRatio:                   2,
 		MoveMemRatio:                      0.1,
 		NotificationMemRatio:              2,
+		PendingInteractionCountMemRatio:   0.1,
 		PollMemRatio:                      1,
 		PollVoteMemRatio:                  2,
 		PollVoteIDsMemRatio:               2,
@@ -198,6 +217,7 @@ var Defaults = Configuration{
 		StatusBookmarkIDsMemRatio:         2,
 		StatusFaveMemRatio:                2,
 		StatusFaveIDsMemRatio:             3,
+		StatusInteractionCountsMemRatio:   0.5,
 		TagMemRatio:                       2,
 		TagIDsFollowedByAccountMemRatio:   1,
 		ThreadMuteMemRatio:                0.2,
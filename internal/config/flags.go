@@ -77,6 +77,7 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(BindAddressFlag(), cfg.BindAddress, fieldtag("BindAddress", "usage"))
 		cmd.PersistentFlags().Int(PortFlag(), cfg.Port, fieldtag("Port", "usage"))
 		cmd.PersistentFlags().StringSlice(TrustedProxiesFlag(), cfg.TrustedProxies, fieldtag("TrustedProxies", "usage"))
+		cmd.PersistentFlags().String(NodeNameFlag(), cfg.NodeName, fieldtag("NodeName", "usage"))
 
 		// Template
 		cmd.Flags().String(WebTemplateBaseDirFlag(), cfg.WebTemplateBaseDir, fieldtag("WebTemplateBaseDir", "usage"))
@@ -90,11 +91,21 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
 		cmd.Flags().StringSlice(InstanceLanguagesFlag(), cfg.InstanceLanguages.TagStrs(), fieldtag("InstanceLanguages", "usage"))
+		cmd.Flags().Int(InstanceInteractionApprovalCascadeDepthFlag(), cfg.InstanceInteractionApprovalCascadeDepth, fieldtag("InstanceInteractionApprovalCascadeDepth", "usage"))
+		cmd.Flags().Bool(InstanceInteractionApprovedBoostableFlag(), cfg.InstanceInteractionApprovedBoostable, fieldtag("InstanceInteractionApprovedBoostable", "usage"))
+		cmd.Flags().Bool(InstanceBlockRemoveFavesFlag(), cfg.InstanceBlockRemoveFaves, fieldtag("InstanceBlockRemoveFaves", "usage"))
+		cmd.Flags().Int(InstanceInteractionPendingLimitFlag(), cfg.InstanceInteractionPendingLimit, fieldtag("InstanceInteractionPendingLimit", "usage"))
+		cmd.Flags().Duration(InstanceInteractionPendingReminderEveryFlag(), cfg.InstanceInteractionPendingReminderEvery, fieldtag("InstanceInteractionPendingReminderEvery", "usage"))
+		cmd.Flags().Bool(InstanceReportsPreserveStatusesFlag(), cfg.InstanceReportsPreserveStatuses, fieldtag("InstanceReportsPreserveStatuses", "usage"))
+		cmd.Flags().Bool(InstanceHideBlockedDomainStatsFlag(), cfg.InstanceHideBlockedDomainStats, fieldtag("InstanceHideBlockedDomainStats", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Int(AccountsMoveConcurrencyFlag(), cfg.AccountsMoveConcurrency, fieldtag("AccountsMoveConcurrency", "usage"))
+		cmd.Flags().Int(AccountsMoveFollowRetryAttemptsFlag(), cfg.AccountsMoveFollowRetryAttempts, fieldtag("AccountsMoveFollowRetryAttempts", "usage"))
+		cmd.Flags().Duration(AccountsMoveFollowRetryDelayFlag(), cfg.AccountsMoveFollowRetryDelay, fieldtag("AccountsMoveFollowRetryDelay", "usage"))
 
 		// Media
 		cmd.Flags().Int(MediaDescriptionMinCharsFlag(), cfg.MediaDescriptionMinChars, fieldtag("MediaDescriptionMinChars", "usage"))
@@ -106,6 +117,7 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Uint64(MediaEmojiRemoteMaxSizeFlag(), uint64(cfg.MediaEmojiRemoteMaxSize), fieldtag("MediaEmojiRemoteMaxSize", "usage"))
 		cmd.Flags().String(MediaCleanupFromFlag(), cfg.MediaCleanupFrom, fieldtag("MediaCleanupFrom", "usage"))
 		cmd.Flags().Duration(MediaCleanupEveryFlag(), cfg.MediaCleanupEvery, fieldtag("MediaCleanupEvery", "usage"))
+		cmd.Flags().Bool(MediaRemoteDeleteRetainFlag(), cfg.MediaRemoteDeleteRetain, fieldtag("MediaRemoteDeleteRetain", "usage"))
 
 		// Storage
 		cmd.Flags().String(StorageBackendFlag(), cfg.StorageBackend, fieldtag("StorageBackend", "usage"))
@@ -115,7 +127,13 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(StatusesMaxCharsFlag(), cfg.StatusesMaxChars, fieldtag("StatusesMaxChars", "usage"))
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
+		cmd.Flags().Bool(StatusesPollArchiveOnDeleteFlag(), cfg.StatusesPollArchiveOnDelete, fieldtag("StatusesPollArchiveOnDelete", "usage"))
+		cmd.Flags().Duration(StatusesPollExpirySweepAgeFlag(), cfg.StatusesPollExpirySweepAge, fieldtag("StatusesPollExpirySweepAge", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
+		cmd.Flags().Bool(StatusesOrphanedReplyPlaceholderFlag(), cfg.StatusesOrphanedReplyPlaceholder, fieldtag("StatusesOrphanedReplyPlaceholder", "usage"))
+		cmd.Flags().Bool(StatusesReplyDeleteParentUpdateFlag(), cfg.StatusesReplyDeleteParentUpdate, fieldtag("StatusesReplyDeleteParentUpdate", "usage"))
+		cmd.Flags().Duration(StatusesReplyDeleteUpdateDelayFlag(), cfg.StatusesReplyDeleteUpdateDelay, fieldtag("StatusesReplyDeleteUpdateDelay", "usage"))
+		cmd.Flags().Int(StatusesTimelineRemovalDeferThresholdFlag(), cfg.StatusesTimelineRemovalDeferThreshold, fieldtag("StatusesTimelineRemovalDeferThreshold", "usage"))
 
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
@@ -218,6 +236,20 @@ func AddAdminMediaList(cmd *cobra.Command) {
 	cmd.Flags().Bool(remoteOnly, false, remoteOnlyUsage)
 }
 
+// AddAdminStatusRelations attaches flags pertaining to status relations commands.
+func AddAdminStatusRelations(cmd *cobra.Command) {
+	name := AdminStatusFixRelationsFlag()
+	usage := fieldtag("AdminStatusFixRelations", "usage")
+	cmd.Flags().Bool(name, false, usage)
+}
+
+// AddAdminInteractionApprovals attaches flags pertaining to interaction approvals commands.
+func AddAdminInteractionApprovals(cmd *cobra.Command) {
+	name := AdminInteractionMergeFlag()
+	usage := fieldtag("AdminInteractionMerge", "usage")
+	cmd.Flags().Bool(name, false, usage)
+}
+
 // AddAdminMediaPrune attaches flags pertaining to media storage prune commands.
 func AddAdminMediaPrune(cmd *cobra.Command) {
 	name := AdminMediaPruneDryRunFlag()
@@ -350,6 +350,31 @@ func GetTrustedProxies() []string { return global.GetTrustedProxies() }
 // SetTrustedProxies safely sets the value for global configuration 'TrustedProxies' field
 func SetTrustedProxies(v []string) { global.SetTrustedProxies(v) }
 
+// GetNodeName safely fetches the Configuration value for state's 'NodeName' field
+func (st *ConfigState) GetNodeName() (v string) {
+	st.mutex.RLock()
+	v = st.config.NodeName
+	st.mutex.RUnlock()
+	return
+}
+
+// SetNodeName safely sets the Configuration value for state's 'NodeName' field
+func (st *ConfigState) SetNodeName(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.NodeName = v
+	st.reloadToViper()
+}
+
+// NodeNameFlag returns the flag name for the 'NodeName' field
+func NodeNameFlag() string { return "node-name" }
+
+// GetNodeName safely fetches the value for global configuration 'NodeName' field
+func GetNodeName() string { return global.GetNodeName() }
+
+// SetNodeName safely sets the value for global configuration 'NodeName' field
+func SetNodeName(v string) { global.SetNodeName(v) }
+
 // GetSoftwareVersion safely fetches the Configuration value for state's 'SoftwareVersion' field
 func (st *ConfigState) GetSoftwareVersion() (v string) {
 	st.mutex.RLock()
@@ -975,6 +1000,199 @@ func GetInstanceLanguages() language.Languages { return global.GetInstanceLangua
 // SetInstanceLanguages safely sets the value for global configuration 'InstanceLanguages' field
 func SetInstanceLanguages(v language.Languages) { global.SetInstanceLanguages(v) }
 
+// GetInstanceInteractionApprovalCascadeDepth safely fetches the Configuration value for state's 'InstanceInteractionApprovalCascadeDepth' field
+func (st *ConfigState) GetInstanceInteractionApprovalCascadeDepth() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceInteractionApprovalCascadeDepth
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceInteractionApprovalCascadeDepth safely sets the Configuration value for state's 'InstanceInteractionApprovalCascadeDepth' field
+func (st *ConfigState) SetInstanceInteractionApprovalCascadeDepth(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceInteractionApprovalCascadeDepth = v
+	st.reloadToViper()
+}
+
+// InstanceInteractionApprovalCascadeDepthFlag returns the flag name for the 'InstanceInteractionApprovalCascadeDepth' field
+func InstanceInteractionApprovalCascadeDepthFlag() string {
+	return "instance-interaction-approval-cascade-depth"
+}
+
+// GetInstanceInteractionApprovalCascadeDepth safely fetches the value for global configuration 'InstanceInteractionApprovalCascadeDepth' field
+func GetInstanceInteractionApprovalCascadeDepth() int {
+	return global.GetInstanceInteractionApprovalCascadeDepth()
+}
+
+// SetInstanceInteractionApprovalCascadeDepth safely sets the value for global configuration 'InstanceInteractionApprovalCascadeDepth' field
+func SetInstanceInteractionApprovalCascadeDepth(v int) {
+	global.SetInstanceInteractionApprovalCascadeDepth(v)
+}
+
+// GetInstanceInteractionApprovedBoostable safely fetches the Configuration value for state's 'InstanceInteractionApprovedBoostable' field
+func (st *ConfigState) GetInstanceInteractionApprovedBoostable() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceInteractionApprovedBoostable
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceInteractionApprovedBoostable safely sets the Configuration value for state's 'InstanceInteractionApprovedBoostable' field
+func (st *ConfigState) SetInstanceInteractionApprovedBoostable(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceInteractionApprovedBoostable = v
+	st.reloadToViper()
+}
+
+// InstanceInteractionApprovedBoostableFlag returns the flag name for the 'InstanceInteractionApprovedBoostable' field
+func InstanceInteractionApprovedBoostableFlag() string {
+	return "instance-interaction-approved-boostable"
+}
+
+// GetInstanceInteractionApprovedBoostable safely fetches the value for global configuration 'InstanceInteractionApprovedBoostable' field
+func GetInstanceInteractionApprovedBoostable() bool {
+	return global.GetInstanceInteractionApprovedBoostable()
+}
+
+// SetInstanceInteractionApprovedBoostable safely sets the value for global configuration 'InstanceInteractionApprovedBoostable' field
+func SetInstanceInteractionApprovedBoostable(v bool) {
+	global.SetInstanceInteractionApprovedBoostable(v)
+}
+
+// GetInstanceBlockRemoveFaves safely fetches the Configuration value for state's 'InstanceBlockRemoveFaves' field
+func (st *ConfigState) GetInstanceBlockRemoveFaves() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceBlockRemoveFaves
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceBlockRemoveFaves safely sets the Configuration value for state's 'InstanceBlockRemoveFaves' field
+func (st *ConfigState) SetInstanceBlockRemoveFaves(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceBlockRemoveFaves = v
+	st.reloadToViper()
+}
+
+// InstanceBlockRemoveFavesFlag returns the flag name for the 'InstanceBlockRemoveFaves' field
+func InstanceBlockRemoveFavesFlag() string { return "instance-block-remove-faves" }
+
+// GetInstanceBlockRemoveFaves safely fetches the value for global configuration 'InstanceBlockRemoveFaves' field
+func GetInstanceBlockRemoveFaves() bool { return global.GetInstanceBlockRemoveFaves() }
+
+// SetInstanceBlockRemoveFaves safely sets the value for global configuration 'InstanceBlockRemoveFaves' field
+func SetInstanceBlockRemoveFaves(v bool) { global.SetInstanceBlockRemoveFaves(v) }
+
+// GetInstanceInteractionPendingLimit safely fetches the Configuration value for state's 'InstanceInteractionPendingLimit' field
+func (st *ConfigState) GetInstanceInteractionPendingLimit() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceInteractionPendingLimit
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceInteractionPendingLimit safely sets the Configuration value for state's 'InstanceInteractionPendingLimit' field
+func (st *ConfigState) SetInstanceInteractionPendingLimit(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceInteractionPendingLimit = v
+	st.reloadToViper()
+}
+
+// InstanceInteractionPendingLimitFlag returns the flag name for the 'InstanceInteractionPendingLimit' field
+func InstanceInteractionPendingLimitFlag() string { return "instance-interaction-pending-limit" }
+
+// GetInstanceInteractionPendingLimit safely fetches the value for global configuration 'InstanceInteractionPendingLimit' field
+func GetInstanceInteractionPendingLimit() int { return global.GetInstanceInteractionPendingLimit() }
+
+// SetInstanceInteractionPendingLimit safely sets the value for global configuration 'InstanceInteractionPendingLimit' field
+func SetInstanceInteractionPendingLimit(v int) { global.SetInstanceInteractionPendingLimit(v) }
+
+// GetInstanceInteractionPendingReminderEvery safely fetches the Configuration value for state's 'InstanceInteractionPendingReminderEvery' field
+func (st *ConfigState) GetInstanceInteractionPendingReminderEvery() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceInteractionPendingReminderEvery
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceInteractionPendingReminderEvery safely sets the Configuration value for state's 'InstanceInteractionPendingReminderEvery' field
+func (st *ConfigState) SetInstanceInteractionPendingReminderEvery(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceInteractionPendingReminderEvery = v
+	st.reloadToViper()
+}
+
+// InstanceInteractionPendingReminderEveryFlag returns the flag name for the 'InstanceInteractionPendingReminderEvery' field
+func InstanceInteractionPendingReminderEveryFlag() string {
+	return "instance-interaction-pending-reminder-every"
+}
+
+// GetInstanceInteractionPendingReminderEvery safely fetches the value for global configuration 'InstanceInteractionPendingReminderEvery' field
+func GetInstanceInteractionPendingReminderEvery() time.Duration {
+	return global.GetInstanceInteractionPendingReminderEvery()
+}
+
+// SetInstanceInteractionPendingReminderEvery safely sets the value for global configuration 'InstanceInteractionPendingReminderEvery' field
+func SetInstanceInteractionPendingReminderEvery(v time.Duration) {
+	global.SetInstanceInteractionPendingReminderEvery(v)
+}
+
+// GetInstanceReportsPreserveStatuses safely fetches the Configuration value for state's 'InstanceReportsPreserveStatuses' field
+func (st *ConfigState) GetInstanceReportsPreserveStatuses() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceReportsPreserveStatuses
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceReportsPreserveStatuses safely sets the Configuration value for state's 'InstanceReportsPreserveStatuses' field
+func (st *ConfigState) SetInstanceReportsPreserveStatuses(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceReportsPreserveStatuses = v
+	st.reloadToViper()
+}
+
+// InstanceReportsPreserveStatusesFlag returns the flag name for the 'InstanceReportsPreserveStatuses' field
+func InstanceReportsPreserveStatusesFlag() string { return "instance-reports-preserve-statuses" }
+
+// GetInstanceReportsPreserveStatuses safely fetches the value for global configuration 'InstanceReportsPreserveStatuses' field
+func GetInstanceReportsPreserveStatuses() bool { return global.GetInstanceReportsPreserveStatuses() }
+
+// SetInstanceReportsPreserveStatuses safely sets the value for global configuration 'InstanceReportsPreserveStatuses' field
+func SetInstanceReportsPreserveStatuses(v bool) { global.SetInstanceReportsPreserveStatuses(v) }
+
+// GetInstanceHideBlockedDomainStats safely fetches the Configuration value for state's 'InstanceHideBlockedDomainStats' field
+func (st *ConfigState) GetInstanceHideBlockedDomainStats() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceHideBlockedDomainStats
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceHideBlockedDomainStats safely sets the Configuration value for state's 'InstanceHideBlockedDomainStats' field
+func (st *ConfigState) SetInstanceHideBlockedDomainStats(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceHideBlockedDomainStats = v
+	st.reloadToViper()
+}
+
+// InstanceHideBlockedDomainStatsFlag returns the flag name for the 'InstanceHideBlockedDomainStats' field
+func InstanceHideBlockedDomainStatsFlag() string { return "instance-hide-blocked-domain-stats" }
+
+// GetInstanceHideBlockedDomainStats safely fetches the value for global configuration 'InstanceHideBlockedDomainStats' field
+func GetInstanceHideBlockedDomainStats() bool { return global.GetInstanceHideBlockedDomainStats() }
+
+// SetInstanceHideBlockedDomainStats safely sets the value for global configuration 'InstanceHideBlockedDomainStats' field
+func SetInstanceHideBlockedDomainStats(v bool) { global.SetInstanceHideBlockedDomainStats(v) }
+
 // GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
 func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.RLock()
@@ -1075,6 +1293,81 @@ func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength
 // SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
 func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
 
+// GetAccountsMoveConcurrency safely fetches the Configuration value for state's 'AccountsMoveConcurrency' field
+func (st *ConfigState) GetAccountsMoveConcurrency() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsMoveConcurrency
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsMoveConcurrency safely sets the Configuration value for state's 'AccountsMoveConcurrency' field
+func (st *ConfigState) SetAccountsMoveConcurrency(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsMoveConcurrency = v
+	st.reloadToViper()
+}
+
+// AccountsMoveConcurrencyFlag returns the flag name for the 'AccountsMoveConcurrency' field
+func AccountsMoveConcurrencyFlag() string { return "accounts-move-concurrency" }
+
+// GetAccountsMoveConcurrency safely fetches the value for global configuration 'AccountsMoveConcurrency' field
+func GetAccountsMoveConcurrency() int { return global.GetAccountsMoveConcurrency() }
+
+// SetAccountsMoveConcurrency safely sets the value for global configuration 'AccountsMoveConcurrency' field
+func SetAccountsMoveConcurrency(v int) { global.SetAccountsMoveConcurrency(v) }
+
+// GetAccountsMoveFollowRetryAttempts safely fetches the Configuration value for state's 'AccountsMoveFollowRetryAttempts' field
+func (st *ConfigState) GetAccountsMoveFollowRetryAttempts() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsMoveFollowRetryAttempts
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsMoveFollowRetryAttempts safely sets the Configuration value for state's 'AccountsMoveFollowRetryAttempts' field
+func (st *ConfigState) SetAccountsMoveFollowRetryAttempts(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsMoveFollowRetryAttempts = v
+	st.reloadToViper()
+}
+
+// AccountsMoveFollowRetryAttemptsFlag returns the flag name for the 'AccountsMoveFollowRetryAttempts' field
+func AccountsMoveFollowRetryAttemptsFlag() string { return "accounts-move-follow-retry-attempts" }
+
+// GetAccountsMoveFollowRetryAttempts safely fetches the value for global configuration 'AccountsMoveFollowRetryAttempts' field
+func GetAccountsMoveFollowRetryAttempts() int { return global.GetAccountsMoveFollowRetryAttempts() }
+
+// SetAccountsMoveFollowRetryAttempts safely sets the value for global configuration 'AccountsMoveFollowRetryAttempts' field
+func SetAccountsMoveFollowRetryAttempts(v int) { global.SetAccountsMoveFollowRetryAttempts(v) }
+
+// GetAccountsMoveFollowRetryDelay safely fetches the Configuration value for state's 'AccountsMoveFollowRetryDelay' field
+func (st *ConfigState) GetAccountsMoveFollowRetryDelay() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsMoveFollowRetryDelay
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsMoveFollowRetryDelay safely sets the Configuration value for state's 'AccountsMoveFollowRetryDelay' field
+func (st *ConfigState) SetAccountsMoveFollowRetryDelay(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsMoveFollowRetryDelay = v
+	st.reloadToViper()
+}
+
+// AccountsMoveFollowRetryDelayFlag returns the flag name for the 'AccountsMoveFollowRetryDelay' field
+func AccountsMoveFollowRetryDelayFlag() string { return "accounts-move-follow-retry-delay" }
+
+// GetAccountsMoveFollowRetryDelay safely fetches the value for global configuration 'AccountsMoveFollowRetryDelay' field
+func GetAccountsMoveFollowRetryDelay() time.Duration { return global.GetAccountsMoveFollowRetryDelay() }
+
+// SetAccountsMoveFollowRetryDelay safely sets the value for global configuration 'AccountsMoveFollowRetryDelay' field
+func SetAccountsMoveFollowRetryDelay(v time.Duration) { global.SetAccountsMoveFollowRetryDelay(v) }
+
 // GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
 func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
 	st.mutex.RLock()
@@ -1325,6 +1618,31 @@ func GetMediaFfmpegPoolSize() int { return global.GetMediaFfmpegPoolSize() }
 // SetMediaFfmpegPoolSize safely sets the value for global configuration 'MediaFfmpegPoolSize' field
 func SetMediaFfmpegPoolSize(v int) { global.SetMediaFfmpegPoolSize(v) }
 
+// GetMediaRemoteDeleteRetain safely fetches the Configuration value for state's 'MediaRemoteDeleteRetain' field
+func (st *ConfigState) GetMediaRemoteDeleteRetain() (v bool) {
+	st.mutex.RLock()
+	v = st.config.MediaRemoteDeleteRetain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaRemoteDeleteRetain safely sets the Configuration value for state's 'MediaRemoteDeleteRetain' field
+func (st *ConfigState) SetMediaRemoteDeleteRetain(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaRemoteDeleteRetain = v
+	st.reloadToViper()
+}
+
+// MediaRemoteDeleteRetainFlag returns the flag name for the 'MediaRemoteDeleteRetain' field
+func MediaRemoteDeleteRetainFlag() string { return "media-remote-delete-retain" }
+
+// GetMediaRemoteDeleteRetain safely fetches the value for global configuration 'MediaRemoteDeleteRetain' field
+func GetMediaRemoteDeleteRetain() bool { return global.GetMediaRemoteDeleteRetain() }
+
+// SetMediaRemoteDeleteRetain safely sets the value for global configuration 'MediaRemoteDeleteRetain' field
+func SetMediaRemoteDeleteRetain(v bool) { global.SetMediaRemoteDeleteRetain(v) }
+
 // GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
 func (st *ConfigState) GetStorageBackend() (v string) {
 	st.mutex.RLock()
@@ -1625,6 +1943,56 @@ func GetStatusesPollOptionMaxChars() int { return global.GetStatusesPollOptionMa
 // SetStatusesPollOptionMaxChars safely sets the value for global configuration 'StatusesPollOptionMaxChars' field
 func SetStatusesPollOptionMaxChars(v int) { global.SetStatusesPollOptionMaxChars(v) }
 
+// GetStatusesPollArchiveOnDelete safely fetches the Configuration value for state's 'StatusesPollArchiveOnDelete' field
+func (st *ConfigState) GetStatusesPollArchiveOnDelete() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StatusesPollArchiveOnDelete
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesPollArchiveOnDelete safely sets the Configuration value for state's 'StatusesPollArchiveOnDelete' field
+func (st *ConfigState) SetStatusesPollArchiveOnDelete(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollArchiveOnDelete = v
+	st.reloadToViper()
+}
+
+// StatusesPollArchiveOnDeleteFlag returns the flag name for the 'StatusesPollArchiveOnDelete' field
+func StatusesPollArchiveOnDeleteFlag() string { return "statuses-poll-archive-on-delete" }
+
+// GetStatusesPollArchiveOnDelete safely fetches the value for global configuration 'StatusesPollArchiveOnDelete' field
+func GetStatusesPollArchiveOnDelete() bool { return global.GetStatusesPollArchiveOnDelete() }
+
+// SetStatusesPollArchiveOnDelete safely sets the value for global configuration 'StatusesPollArchiveOnDelete' field
+func SetStatusesPollArchiveOnDelete(v bool) { global.SetStatusesPollArchiveOnDelete(v) }
+
+// GetStatusesPollExpirySweepAge safely fetches the Configuration value for state's 'StatusesPollExpirySweepAge' field
+func (st *ConfigState) GetStatusesPollExpirySweepAge() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.StatusesPollExpirySweepAge
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesPollExpirySweepAge safely sets the Configuration value for state's 'StatusesPollExpirySweepAge' field
+func (st *ConfigState) SetStatusesPollExpirySweepAge(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPollExpirySweepAge = v
+	st.reloadToViper()
+}
+
+// StatusesPollExpirySweepAgeFlag returns the flag name for the 'StatusesPollExpirySweepAge' field
+func StatusesPollExpirySweepAgeFlag() string { return "statuses-poll-expiry-sweep-age" }
+
+// GetStatusesPollExpirySweepAge safely fetches the value for global configuration 'StatusesPollExpirySweepAge' field
+func GetStatusesPollExpirySweepAge() time.Duration { return global.GetStatusesPollExpirySweepAge() }
+
+// SetStatusesPollExpirySweepAge safely sets the value for global configuration 'StatusesPollExpirySweepAge' field
+func SetStatusesPollExpirySweepAge(v time.Duration) { global.SetStatusesPollExpirySweepAge(v) }
+
 // GetStatusesMediaMaxFiles safely fetches the Configuration value for state's 'StatusesMediaMaxFiles' field
 func (st *ConfigState) GetStatusesMediaMaxFiles() (v int) {
 	st.mutex.RLock()
@@ -1650,6 +2018,114 @@ func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
 // SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
 func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
 
+// GetStatusesOrphanedReplyPlaceholder safely fetches the Configuration value for state's 'StatusesOrphanedReplyPlaceholder' field
+func (st *ConfigState) GetStatusesOrphanedReplyPlaceholder() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StatusesOrphanedReplyPlaceholder
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesOrphanedReplyPlaceholder safely sets the Configuration value for state's 'StatusesOrphanedReplyPlaceholder' field
+func (st *ConfigState) SetStatusesOrphanedReplyPlaceholder(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesOrphanedReplyPlaceholder = v
+	st.reloadToViper()
+}
+
+// StatusesOrphanedReplyPlaceholderFlag returns the flag name for the 'StatusesOrphanedReplyPlaceholder' field
+func StatusesOrphanedReplyPlaceholderFlag() string { return "statuses-orphaned-reply-placeholder" }
+
+// GetStatusesOrphanedReplyPlaceholder safely fetches the value for global configuration 'StatusesOrphanedReplyPlaceholder' field
+func GetStatusesOrphanedReplyPlaceholder() bool { return global.GetStatusesOrphanedReplyPlaceholder() }
+
+// SetStatusesOrphanedReplyPlaceholder safely sets the value for global configuration 'StatusesOrphanedReplyPlaceholder' field
+func SetStatusesOrphanedReplyPlaceholder(v bool) { global.SetStatusesOrphanedReplyPlaceholder(v) }
+
+// GetStatusesReplyDeleteParentUpdate safely fetches the Configuration value for state's 'StatusesReplyDeleteParentUpdate' field
+func (st *ConfigState) GetStatusesReplyDeleteParentUpdate() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StatusesReplyDeleteParentUpdate
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesReplyDeleteParentUpdate safely sets the Configuration value for state's 'StatusesReplyDeleteParentUpdate' field
+func (st *ConfigState) SetStatusesReplyDeleteParentUpdate(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesReplyDeleteParentUpdate = v
+	st.reloadToViper()
+}
+
+// StatusesReplyDeleteParentUpdateFlag returns the flag name for the 'StatusesReplyDeleteParentUpdate' field
+func StatusesReplyDeleteParentUpdateFlag() string { return "statuses-reply-delete-parent-update" }
+
+// GetStatusesReplyDeleteParentUpdate safely fetches the value for global configuration 'StatusesReplyDeleteParentUpdate' field
+func GetStatusesReplyDeleteParentUpdate() bool { return global.GetStatusesReplyDeleteParentUpdate() }
+
+// SetStatusesReplyDeleteParentUpdate safely sets the value for global configuration 'StatusesReplyDeleteParentUpdate' field
+func SetStatusesReplyDeleteParentUpdate(v bool) { global.SetStatusesReplyDeleteParentUpdate(v) }
+
+// GetStatusesReplyDeleteUpdateDelay safely fetches the Configuration value for state's 'StatusesReplyDeleteUpdateDelay' field
+func (st *ConfigState) GetStatusesReplyDeleteUpdateDelay() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.StatusesReplyDeleteUpdateDelay
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesReplyDeleteUpdateDelay safely sets the Configuration value for state's 'StatusesReplyDeleteUpdateDelay' field
+func (st *ConfigState) SetStatusesReplyDeleteUpdateDelay(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesReplyDeleteUpdateDelay = v
+	st.reloadToViper()
+}
+
+// StatusesReplyDeleteUpdateDelayFlag returns the flag name for the 'StatusesReplyDeleteUpdateDelay' field
+func StatusesReplyDeleteUpdateDelayFlag() string { return "statuses-reply-delete-update-delay" }
+
+// GetStatusesReplyDeleteUpdateDelay safely fetches the value for global configuration 'StatusesReplyDeleteUpdateDelay' field
+func GetStatusesReplyDeleteUpdateDelay() time.Duration {
+	return global.GetStatusesReplyDeleteUpdateDelay()
+}
+
+// SetStatusesReplyDeleteUpdateDelay safely sets the value for global configuration 'StatusesReplyDeleteUpdateDelay' field
+func SetStatusesReplyDeleteUpdateDelay(v time.Duration) { global.SetStatusesReplyDeleteUpdateDelay(v) }
+
+// GetStatusesTimelineRemovalDeferThreshold safely fetches the Configuration value for state's 'StatusesTimelineRemovalDeferThreshold' field
+func (st *ConfigState) GetStatusesTimelineRemovalDeferThreshold() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesTimelineRemovalDeferThreshold
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesTimelineRemovalDeferThreshold safely sets the Configuration value for state's 'StatusesTimelineRemovalDeferThreshold' field
+func (st *ConfigState) SetStatusesTimelineRemovalDeferThreshold(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesTimelineRemovalDeferThreshold = v
+	st.reloadToViper()
+}
+
+// StatusesTimelineRemovalDeferThresholdFlag returns the flag name for the 'StatusesTimelineRemovalDeferThreshold' field
+func StatusesTimelineRemovalDeferThresholdFlag() string {
+	return "statuses-timeline-removal-defer-threshold"
+}
+
+// GetStatusesTimelineRemovalDeferThreshold safely fetches the value for global configuration 'StatusesTimelineRemovalDeferThreshold' field
+func GetStatusesTimelineRemovalDeferThreshold() int {
+	return global.GetStatusesTimelineRemovalDeferThreshold()
+}
+
+// SetStatusesTimelineRemovalDeferThreshold safely sets the value for global configuration 'StatusesTimelineRemovalDeferThreshold' field
+func SetStatusesTimelineRemovalDeferThreshold(v int) {
+	global.SetStatusesTimelineRemovalDeferThreshold(v)
+}
+
 // GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
 func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
 	st.mutex.RLock()
@@ -3589,6 +4065,37 @@ func GetCacheNotificationMemRatio() float64 { return global.GetCacheNotification
 // SetCacheNotificationMemRatio safely sets the value for global configuration 'Cache.NotificationMemRatio' field
 func SetCacheNotificationMemRatio(v float64) { global.SetCacheNotificationMemRatio(v) }
 
+// GetCachePendingInteractionCountMemRatio safely fetches the Configuration value for state's 'Cache.PendingInteractionCountMemRatio' field
+func (st *ConfigState) GetCachePendingInteractionCountMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.PendingInteractionCountMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCachePendingInteractionCountMemRatio safely sets the Configuration value for state's 'Cache.PendingInteractionCountMemRatio' field
+func (st *ConfigState) SetCachePendingInteractionCountMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.PendingInteractionCountMemRatio = v
+	st.reloadToViper()
+}
+
+// CachePendingInteractionCountMemRatioFlag returns the flag name for the 'Cache.PendingInteractionCountMemRatio' field
+func CachePendingInteractionCountMemRatioFlag() string {
+	return "cache-pending-interaction-count-mem-ratio"
+}
+
+// GetCachePendingInteractionCountMemRatio safely fetches the value for global configuration 'Cache.PendingInteractionCountMemRatio' field
+func GetCachePendingInteractionCountMemRatio() float64 {
+	return global.GetCachePendingInteractionCountMemRatio()
+}
+
+// SetCachePendingInteractionCountMemRatio safely sets the value for global configuration 'Cache.PendingInteractionCountMemRatio' field
+func SetCachePendingInteractionCountMemRatio(v float64) {
+	global.SetCachePendingInteractionCountMemRatio(v)
+}
+
 // GetCachePollMemRatio safely fetches the Configuration value for state's 'Cache.PollMemRatio' field
 func (st *ConfigState) GetCachePollMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3814,6 +4321,37 @@ func GetCacheStatusFaveIDsMemRatio() float64 { return global.GetCacheStatusFaveI
 // SetCacheStatusFaveIDsMemRatio safely sets the value for global configuration 'Cache.StatusFaveIDsMemRatio' field
 func SetCacheStatusFaveIDsMemRatio(v float64) { global.SetCacheStatusFaveIDsMemRatio(v) }
 
+// GetCacheStatusInteractionCountsMemRatio safely fetches the Configuration value for state's 'Cache.StatusInteractionCountsMemRatio' field
+func (st *ConfigState) GetCacheStatusInteractionCountsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusInteractionCountsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusInteractionCountsMemRatio safely sets the Configuration value for state's 'Cache.StatusInteractionCountsMemRatio' field
+func (st *ConfigState) SetCacheStatusInteractionCountsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusInteractionCountsMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheStatusInteractionCountsMemRatioFlag returns the flag name for the 'Cache.StatusInteractionCountsMemRatio' field
+func CacheStatusInteractionCountsMemRatioFlag() string {
+	return "cache-status-interaction-counts-mem-ratio"
+}
+
+// GetCacheStatusInteractionCountsMemRatio safely fetches the value for global configuration 'Cache.StatusInteractionCountsMemRatio' field
+func GetCacheStatusInteractionCountsMemRatio() float64 {
+	return global.GetCacheStatusInteractionCountsMemRatio()
+}
+
+// SetCacheStatusInteractionCountsMemRatio safely sets the value for global configuration 'Cache.StatusInteractionCountsMemRatio' field
+func SetCacheStatusInteractionCountsMemRatio(v float64) {
+	global.SetCacheStatusInteractionCountsMemRatio(v)
+}
+
 // GetCacheTagMemRatio safely fetches the Configuration value for state's 'Cache.TagMemRatio' field
 func (st *ConfigState) GetCacheTagMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -4245,6 +4783,56 @@ func GetAdminMediaListRemoteOnly() bool { return global.GetAdminMediaListRemoteO
 // SetAdminMediaListRemoteOnly safely sets the value for global configuration 'AdminMediaListRemoteOnly' field
 func SetAdminMediaListRemoteOnly(v bool) { global.SetAdminMediaListRemoteOnly(v) }
 
+// GetAdminStatusFixRelations safely fetches the Configuration value for state's 'AdminStatusFixRelations' field
+func (st *ConfigState) GetAdminStatusFixRelations() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminStatusFixRelations
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminStatusFixRelations safely sets the Configuration value for state's 'AdminStatusFixRelations' field
+func (st *ConfigState) SetAdminStatusFixRelations(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminStatusFixRelations = v
+	st.reloadToViper()
+}
+
+// AdminStatusFixRelationsFlag returns the flag name for the 'AdminStatusFixRelations' field
+func AdminStatusFixRelationsFlag() string { return "fix" }
+
+// GetAdminStatusFixRelations safely fetches the value for global configuration 'AdminStatusFixRelations' field
+func GetAdminStatusFixRelations() bool { return global.GetAdminStatusFixRelations() }
+
+// SetAdminStatusFixRelations safely sets the value for global configuration 'AdminStatusFixRelations' field
+func SetAdminStatusFixRelations(v bool) { global.SetAdminStatusFixRelations(v) }
+
+// GetAdminInteractionMerge safely fetches the Configuration value for state's 'AdminInteractionMerge' field
+func (st *ConfigState) GetAdminInteractionMerge() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminInteractionMerge
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminInteractionMerge safely sets the Configuration value for state's 'AdminInteractionMerge' field
+func (st *ConfigState) SetAdminInteractionMerge(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminInteractionMerge = v
+	st.reloadToViper()
+}
+
+// AdminInteractionMergeFlag returns the flag name for the 'AdminInteractionMerge' field
+func AdminInteractionMergeFlag() string { return "merge" }
+
+// GetAdminInteractionMerge safely fetches the value for global configuration 'AdminInteractionMerge' field
+func GetAdminInteractionMerge() bool { return global.GetAdminInteractionMerge() }
+
+// SetAdminInteractionMerge safely sets the value for global configuration 'AdminInteractionMerge' field
+func SetAdminInteractionMerge(v bool) { global.SetAdminInteractionMerge(v) }
+
 // GetRequestIDHeader safely fetches the Configuration value for state's 'RequestIDHeader' field
 func (st *ConfigState) GetRequestIDHeader() (v string) {
 	st.mutex.RLock()
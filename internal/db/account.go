@@ -20,6 +20,7 @@ package db
 import (
 	"context"
 	"net/netip"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
@@ -109,6 +110,13 @@ type Account interface {
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountStatuses(ctx context.Context, accountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, mediaOnly bool, publicOnly bool) ([]*gtsmodel.Status, error)
 
+	// GetAccountStatusesOlderThan pages through statuses by the given account
+	// created before the given time, newest first, optionally excluding pinned
+	// statuses. Intended for finding statuses eligible for automatic deletion.
+	//
+	// In the case of no statuses, this function returns an empty slice and nil error.
+	GetAccountStatusesOlderThan(ctx context.Context, accountID string, before time.Time, excludePinned bool, page *paging.Page) ([]*gtsmodel.Status, error)
+
 	// GetAccountPinnedStatuses returns ONLY statuses owned by the give accountID for which a corresponding StatusPin
 	// exists in the database. Statuses which are not pinned will not be returned by this function.
 	//
@@ -167,9 +175,34 @@ type Account interface {
 	// specifically), callers should prefer GetAccountStats in 99% of cases.
 	RegenerateAccountStats(ctx context.Context, account *gtsmodel.Account) error
 
+	// CountAccountStats counts stats for the given account ID fresh
+	// from the database using COUNT queries, returning them without
+	// storing them. Use UpdateAccountStats to store them if needed.
+	CountAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error)
+
+	// GetAccountIDsWithDriftedStatsBatch returns up to count account IDs, strictly
+	// greater than minID and in ascending order, of accounts whose stored stats
+	// have likely drifted from what's in the database. Only the cheaper counts
+	// (statuses, followers, following) are compared, so the returned accounts
+	// can be targeted by RegenerateAccountStats instead of regenerating all.
+	GetAccountIDsWithDriftedStatsBatch(ctx context.Context, minID string, count int) ([]string, error)
+
 	// Update account stats.
 	UpdateAccountStats(ctx context.Context, stats *gtsmodel.AccountStats, columns ...string) error
 
+	// UpdateAccountStatsBatch applies the given deltas, keyed by account ID,
+	// to the stored stats of each account within a single transaction, with
+	// resulting counts clamped to zero. Accounts without stored stats are
+	// skipped, as their stats will be freshly generated when next populated.
+	UpdateAccountStatsBatch(ctx context.Context, deltas map[string]gtsmodel.AccountStatsDelta) error
+
 	// DeleteAccountStats deletes the accountStats entry for the given accountID.
 	DeleteAccountStats(ctx context.Context, accountID string) error
+
+	// SnapshotAccountStats returns a value copy of the current stats for
+	// the given accountID, populating them first if necessary. The copy
+	// is not affected by later stats updates, so it can be compared with
+	// a later snapshot using AccountStats.Delta(). Useful for diagnostics
+	// and testing of stats-affecting operations.
+	SnapshotAccountStats(ctx context.Context, accountID string) (gtsmodel.AccountStats, error)
 }
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/oklog/ulid"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -924,7 +925,9 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		// Select only IDs from table
 		Column("status.id").
-		Where("? = ?", bun.Ident("status.account_id"), accountID)
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		// Don't show placeholders for deleted statuses.
+		Where("? = ?", bun.Ident("status.placeholder"), false)
 
 	if excludeReplies {
 		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
@@ -1018,6 +1021,82 @@ func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, li
 	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
 }
 
+func (a *accountDB) GetAccountStatusesOlderThan(
+	ctx context.Context,
+	accountID string,
+	before time.Time,
+	excludePinned bool,
+	page *paging.Page,
+) ([]*gtsmodel.Status, error) {
+	var (
+		// Get paging params.
+		minID = page.GetMin()
+		maxID = page.GetMax()
+		limit = page.GetLimit()
+		order = page.GetOrder()
+
+		// Make educated guess for slice size
+		statusIDs = make([]string, 0, limit)
+	)
+
+	// Status IDs are ULIDs generated from the
+	// status creation time, so rather than
+	// filtering on created_at we can compare
+	// against the lowest possible ULID at the
+	// cutoff time. This lets the query be served
+	// by the statuses_account_id_id_idx index.
+	beforeID := ulid.MustNew(ulid.Timestamp(before), nil).String()
+	if maxID == "" || maxID > beforeID {
+		maxID = beforeID
+	}
+
+	q := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		// Select only IDs from table
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		// Return only statuses with id
+		// lower than cutoff / provided maxID.
+		Where("? < ?", bun.Ident("status.id"), maxID)
+
+	// Return only statuses with id
+	// greater than provided minID.
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if excludePinned {
+		q = q.Where("? IS NULL", bun.Ident("status.pinned_at"))
+	}
+
+	if limit > 0 {
+		// Limit amount of
+		// statuses returned.
+		q = q.Limit(limit)
+	}
+
+	if order == paging.OrderAscending {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("status.id"))
+	} else {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("status.id"))
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// If we're paging up, we still want statuses
+	// to be sorted by ID desc, so reverse ids slice.
+	if order == paging.OrderAscending {
+		slices.Reverse(statusIDs)
+	}
+
+	return a.state.DB.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (a *accountDB) GetAccountPinnedStatuses(ctx context.Context, accountID string) ([]*gtsmodel.Status, error) {
 	statusIDs := []string{}
 
@@ -1222,13 +1301,15 @@ func (a *accountDB) PopulateAccountStats(ctx context.Context, account *gtsmodel.
 
 func (a *accountDB) StubAccountStats(ctx context.Context, account *gtsmodel.Account) error {
 	stats := &gtsmodel.AccountStats{
-		AccountID:           account.ID,
-		RegeneratedAt:       time.Now(),
-		FollowersCount:      util.Ptr(0),
-		FollowingCount:      util.Ptr(0),
-		FollowRequestsCount: util.Ptr(0),
-		StatusesCount:       util.Ptr(0),
-		StatusesPinnedCount: util.Ptr(0),
+		AccountID:            account.ID,
+		RegeneratedAt:        time.Now(),
+		FollowersCount:       util.Ptr(0),
+		LocalFollowersCount:  util.Ptr(0),
+		RemoteFollowersCount: util.Ptr(0),
+		FollowingCount:       util.Ptr(0),
+		FollowRequestsCount:  util.Ptr(0),
+		StatusesCount:        util.Ptr(0),
+		StatusesPinnedCount:  util.Ptr(0),
 	}
 
 	// Upsert this stats in case a race
@@ -1254,6 +1335,7 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	stats := &gtsmodel.AccountStats{
 		AccountID:     account.ID,
 		RegeneratedAt: time.Now(),
+		UpdatedBy:     config.GetNodeName(),
 	}
 
 	// Count followers outside of transaction since
@@ -1264,6 +1346,15 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	}
 	stats.FollowersCount = util.Ptr(len(followerIDs))
 
+	// Count local followers the same way; any
+	// followers that aren't local are remote.
+	localFollowerIDs, err := a.state.DB.GetAccountLocalFollowerIDs(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	stats.LocalFollowersCount = util.Ptr(len(localFollowerIDs))
+	stats.RemoteFollowersCount = util.Ptr(max(len(followerIDs)-len(localFollowerIDs), 0))
+
 	// Count following outside of transaction since
 	// it uses a cache + requires its own db calls.
 	followIDs, err := a.state.DB.GetAccountFollowIDs(ctx, account.ID, nil)
@@ -1344,7 +1435,137 @@ func (a *accountDB) RegenerateAccountStats(ctx context.Context, account *gtsmode
 	return nil
 }
 
+func (a *accountDB) CountAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error) {
+	stats := &gtsmodel.AccountStats{AccountID: accountID}
+
+	// Do all counts in one transaction
+	// so they're consistent with each other.
+	if err := a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// count returns a query counting rows
+		// in table where column equals accountID.
+		count := func(table string, column string) *bun.SelectQuery {
+			return tx.NewSelect().
+				Table(table).
+				Where("? = ?", bun.Ident(column), accountID)
+		}
+
+		// localAccounts returns a query
+		// selecting IDs of local accounts.
+		localAccounts := func() *bun.SelectQuery {
+			return tx.NewSelect().
+				Table("accounts").
+				Column("id").
+				Where("? IS NULL", bun.Ident("domain"))
+		}
+
+		for _, c := range []struct {
+			dst *(*int)
+			q   *bun.SelectQuery
+		}{
+			{&stats.FollowersCount, count("follows", "target_account_id")},
+			{&stats.LocalFollowersCount, count("follows", "target_account_id").
+				Where("? IN (?)", bun.Ident("account_id"), localAccounts())},
+			{&stats.RemoteFollowersCount, count("follows", "target_account_id").
+				Where("? NOT IN (?)", bun.Ident("account_id"), localAccounts())},
+			{&stats.FollowingCount, count("follows", "account_id")},
+			{&stats.FollowRequestsCount, count("follow_requests", "target_account_id")},
+			{&stats.StatusesCount, count("statuses", "account_id")},
+			{&stats.StatusesPinnedCount, count("statuses", "account_id").
+				Where("? IS NOT NULL", bun.Ident("pinned_at"))},
+		} {
+			n, err := c.q.Count(ctx)
+			if err != nil {
+				return err
+			}
+			*c.dst = &n
+		}
+
+		// Scan database for last status.
+		err := tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			Column("status.created_at").
+			Where("? = ?", bun.Ident("status.account_id"), accountID).
+			Order("status.id DESC").
+			Limit(1).
+			Scan(ctx, &stats.LastStatusAt)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (a *accountDB) GetAccountIDsWithDriftedStatsBatch(ctx context.Context, minID string, count int) ([]string, error) {
+	// Subquery to count
+	// statuses of account.
+	statusesQ := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("status.account_id"), bun.Ident("account_stats.account_id"))
+
+	// Subquery to count
+	// follows of account.
+	followersQ := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("follow.target_account_id"), bun.Ident("account_stats.account_id"))
+
+	// Subquery to count
+	// follows by account.
+	followingQ := a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("follow.account_id"), bun.Ident("account_stats.account_id"))
+
+	var accountIDs []string
+	if err := a.db.
+		NewSelect().
+		Model((*gtsmodel.AccountStats)(nil)).
+		Column("account_id").
+		Where("? > ?", bun.Ident("account_id"), minID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? != (?)", bun.Ident("statuses_count"), statusesQ).
+				WhereOr("? != (?)", bun.Ident("followers_count"), followersQ).
+				WhereOr("? != (?)", bun.Ident("following_count"), followingQ)
+		}).
+		Order("account_id ASC").
+		Limit(count).
+		Scan(ctx, &accountIDs); // nocollapse
+	err != nil {
+		return nil, err
+	}
+	return accountIDs, nil
+}
+
 func (a *accountDB) UpdateAccountStats(ctx context.Context, stats *gtsmodel.AccountStats, columns ...string) error {
+	frozen, err := a.statsFrozen(ctx, stats.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		// Stats of this
+		// account aren't kept.
+		return nil
+	}
+
+	stats.UpdatedBy = config.GetNodeName()
+	if len(columns) > 0 && stats.UpdatedBy != "" {
+		// If we're updating by column, ensure
+		// provenance of the update is included.
+		columns = append(columns, "updated_by")
+	}
+
 	return a.state.Caches.DB.AccountStats.Store(stats, func() error {
 		if _, err := a.db.
 			NewUpdate().
@@ -1359,6 +1580,133 @@ func (a *accountDB) UpdateAccountStats(ctx context.Context, stats *gtsmodel.Acco
 	})
 }
 
+func (a *accountDB) UpdateAccountStatsBatch(ctx context.Context, deltas map[string]gtsmodel.AccountStatsDelta) error {
+	if len(deltas) == 0 {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	accountIDs := make([]string, 0, len(deltas))
+	for accountID := range deltas {
+		frozen, err := a.statsFrozen(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if frozen {
+			// Stats of this
+			// account aren't kept.
+			continue
+		}
+
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	if len(accountIDs) == 0 {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	// Stats are updated outside of the cache,
+	// so ensure any cached stats are dropped.
+	defer func() {
+		for _, accountID := range accountIDs {
+			a.state.Caches.DB.AccountStats.Invalidate("AccountID", accountID)
+		}
+	}()
+
+	// Columns updated for each account.
+	columns := []string{
+		"followers_count",
+		"local_followers_count",
+		"remote_followers_count",
+		"following_count",
+		"follow_requests_count",
+		"statuses_count",
+		"statuses_pinned_count",
+	}
+
+	updatedBy := config.GetNodeName()
+	if updatedBy != "" {
+		// Include provenance
+		// of the update.
+		columns = append(columns, "updated_by")
+	}
+
+	return a.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var stats []*gtsmodel.AccountStats
+
+		// Select stored stats of all accounts.
+		if err := tx.NewSelect().
+			Model(&stats).
+			Where("? IN (?)", bun.Ident("account_stats.account_id"), bun.In(accountIDs)).
+			Scan(ctx); err != nil {
+			return err
+		}
+
+		for _, s := range stats {
+			delta := deltas[s.AccountID]
+
+			// Apply delta to each count.
+			s.FollowersCount = addDeltaClamped(s.FollowersCount, delta.FollowersCount)
+			s.LocalFollowersCount = addDeltaClamped(s.LocalFollowersCount, delta.LocalFollowersCount)
+			s.RemoteFollowersCount = addDeltaClamped(s.RemoteFollowersCount, delta.RemoteFollowersCount)
+			s.FollowingCount = addDeltaClamped(s.FollowingCount, delta.FollowingCount)
+			s.FollowRequestsCount = addDeltaClamped(s.FollowRequestsCount, delta.FollowRequestsCount)
+			s.StatusesCount = addDeltaClamped(s.StatusesCount, delta.StatusesCount)
+			s.StatusesPinnedCount = addDeltaClamped(s.StatusesPinnedCount, delta.StatusesPinnedCount)
+
+			s.UpdatedBy = updatedBy
+
+			if _, err := tx.NewUpdate().
+				Model(s).
+				Column(columns...).
+				Where("? = ?", bun.Ident("account_stats.account_id"), s.AccountID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// statsFrozen returns whether updates to the stats
+// of account with given ID should be skipped, which
+// is the case for accounts on blocked domains if the
+// instance is configured to hide their stats.
+func (a *accountDB) statsFrozen(ctx context.Context, accountID string) (bool, error) {
+	if !config.GetInstanceHideBlockedDomainStats() {
+		// Stats of all
+		// accounts kept.
+		return false, nil
+	}
+
+	account, err := a.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting account %s: %w", accountID, err)
+	}
+
+	if account == nil || account.IsLocal() {
+		// Missing or local
+		// accounts are kept.
+		return false, nil
+	}
+
+	return a.state.DB.IsDomainBlocked(ctx, account.Domain)
+}
+
+// addDeltaClamped returns a pointer to
+// count + delta, clamped to zero.
+func addDeltaClamped(count *int, delta int) *int {
+	return util.Ptr(max(util.PtrOrZero(count)+delta, 0))
+}
+
 func (a *accountDB) DeleteAccountStats(ctx context.Context, accountID string) error {
 	defer a.state.Caches.DB.AccountStats.Invalidate("AccountID", accountID)
 
@@ -1372,3 +1720,19 @@ func (a *accountDB) DeleteAccountStats(ctx context.Context, accountID string) er
 
 	return nil
 }
+
+func (a *accountDB) SnapshotAccountStats(ctx context.Context, accountID string) (gtsmodel.AccountStats, error) {
+	account, err := a.GetAccountByID(gtscontext.SetBarebones(ctx), accountID)
+	if err != nil {
+		return gtsmodel.AccountStats{}, err
+	}
+
+	// Ensure stats populated
+	// fresh for this account.
+	account.Stats = nil
+	if err := a.PopulateAccountStats(ctx, account); err != nil {
+		return gtsmodel.AccountStats{}, err
+	}
+
+	return account.Stats.Snapshot(), nil
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -77,6 +78,66 @@ func (suite *AccountTestSuite) TestGetAccountStatusesPageDown() {
 	suite.Empty(statuses)
 }
 
+func (suite *AccountTestSuite) TestGetAccountStatusesOlderThan() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		before  = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		pinned  = suite.testStatuses["local_account_1_status_2"]
+	)
+
+	// Pin one of the old statuses.
+	pinned.PinnedAt = time.Now()
+	if err := suite.db.UpdateStatus(ctx, pinned, "pinned_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Old, non-pinned statuses, newest first.
+	expectIDs := []string{
+		suite.testStatuses["local_account_1_status_5"].ID,
+		suite.testStatuses["local_account_1_status_3"].ID,
+		suite.testStatuses["local_account_1_status_1"].ID,
+		suite.testStatuses["local_account_1_status_4"].ID,
+	}
+
+	// Page through in pages of 2.
+	var (
+		gotIDs []string
+		page   = &paging.Page{Limit: 2}
+	)
+
+	for {
+		statuses, err := suite.db.GetAccountStatusesOlderThan(ctx, account.ID, before, true, page)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		if len(statuses) == 0 {
+			break
+		}
+
+		suite.LessOrEqual(len(statuses), 2)
+		for _, status := range statuses {
+			gotIDs = append(gotIDs, status.ID)
+		}
+
+		page = &paging.Page{
+			Max:   paging.MaxID(statuses[len(statuses)-1].ID),
+			Limit: 2,
+		}
+	}
+
+	suite.Equal(expectIDs, gotIDs)
+
+	// Including pinned should
+	// return the pinned status too.
+	statuses, err := suite.db.GetAccountStatusesOlderThan(ctx, account.ID, before, false, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(statuses, len(expectIDs)+1)
+}
+
 func (suite *AccountTestSuite) TestGetAccountStatusesExcludeRepliesAndReblogs() {
 	statuses, err := suite.db.GetAccountStatuses(context.Background(), suite.testAccounts["local_account_1"].ID, 20, true, true, "", "", false, false)
 	suite.NoError(err)
@@ -768,6 +829,239 @@ func (suite *AccountTestSuite) TestAccountStatsAll() {
 	}
 }
 
+func (suite *AccountTestSuite) TestAccountStatsProvenance() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+	defer config.SetNodeName("")
+
+	// Regenerated stats should
+	// record the node that did it.
+	config.SetNodeName("node-1")
+	if err := suite.db.RegenerateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("node-1", account.Stats.UpdatedBy)
+	before := account.Stats.Snapshot()
+
+	// Update stats by column from another node.
+	config.SetNodeName("node-2")
+	stats := before.Snapshot()
+	*stats.StatusesCount++
+	if err := suite.db.UpdateAccountStats(ctx, &stats, "statuses_count"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Drop cached stats to
+	// check the stored ones.
+	suite.state.Caches.DB.AccountStats.Invalidate("AccountID", account.ID)
+	after, err := suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("node-2", after.UpdatedBy)
+	suite.Equal(gtsmodel.AccountStatsDelta{StatusesCount: 1}, after.Delta(&before))
+
+	// Batch update from a third node.
+	config.SetNodeName("node-3")
+	if err := suite.db.UpdateAccountStatsBatch(ctx, map[string]gtsmodel.AccountStatsDelta{
+		account.ID: {FollowersCount: 2},
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	after, err = suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("node-3", after.UpdatedBy)
+	suite.Equal(gtsmodel.AccountStatsDelta{
+		StatusesCount:  1,
+		FollowersCount: 2,
+	}, after.Delta(&before))
+}
+
+func (suite *AccountTestSuite) TestAccountStatsBlockedDomain() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["remote_account_1"]
+	)
+
+	before, err := suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Hide stats of blocked domains.
+	config.SetInstanceHideBlockedDomainStats(true)
+	defer config.SetInstanceHideBlockedDomainStats(false)
+
+	// Block the account's domain.
+	if err := suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01J4ZKQ0WY6T0M8XQH4Y1H2N5E",
+		Domain:             account.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Neither a column update nor
+	// a batch update should apply.
+	stats := before.Snapshot()
+	*stats.StatusesCount++
+	if err := suite.db.UpdateAccountStats(ctx, &stats, "statuses_count"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.UpdateAccountStatsBatch(ctx, map[string]gtsmodel.AccountStatsDelta{
+		account.ID: {FollowersCount: 2},
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.state.Caches.DB.AccountStats.Invalidate("AccountID", account.ID)
+	after, err := suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AccountStatsDelta{}, after.Delta(&before))
+
+	// With stats kept for blocked
+	// domains, updates apply again.
+	config.SetInstanceHideBlockedDomainStats(false)
+
+	if err := suite.db.UpdateAccountStats(ctx, &stats, "statuses_count"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.state.Caches.DB.AccountStats.Invalidate("AccountID", account.ID)
+	after, err = suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.AccountStatsDelta{StatusesCount: 1}, after.Delta(&before))
+}
+
+func (suite *AccountTestSuite) TestGetAccountIDsWithDriftedStats() {
+	var (
+		ctx      = context.Background()
+		drifted  = suite.testAccounts["local_account_1"]
+		accurate = suite.testAccounts["local_account_2"]
+	)
+
+	// Freshly regenerate stats
+	// of both, so they're accurate.
+	for _, account := range []*gtsmodel.Account{drifted, accurate} {
+		if err := suite.db.RegenerateAccountStats(ctx, account); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	accountIDs, err := suite.db.GetAccountIDsWithDriftedStatsBatch(ctx, "", 100)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotContains(accountIDs, drifted.ID)
+	suite.NotContains(accountIDs, accurate.ID)
+
+	// Inject drift into
+	// the first's stats.
+	stats := drifted.Stats.Snapshot()
+	*stats.FollowersCount += 3
+	if err := suite.db.UpdateAccountStats(ctx, &stats, "followers_count"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accountIDs, err = suite.db.GetAccountIDsWithDriftedStatsBatch(ctx, "", 100)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(accountIDs, drifted.ID)
+	suite.NotContains(accountIDs, accurate.ID)
+
+	// Paging past the drifted
+	// account should exclude it.
+	accountIDs, err = suite.db.GetAccountIDsWithDriftedStatsBatch(ctx, drifted.ID, 100)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotContains(accountIDs, drifted.ID)
+}
+
+func (suite *AccountTestSuite) TestCountAccountStats() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	// Regenerate stats of zork,
+	// to compare counts against.
+	if err := suite.db.RegenerateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	counted, err := suite.db.CountAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(account.ID, counted.AccountID)
+	suite.Equal(*account.Stats.FollowersCount, *counted.FollowersCount)
+	suite.Equal(*account.Stats.LocalFollowersCount, *counted.LocalFollowersCount)
+	suite.Equal(*account.Stats.RemoteFollowersCount, *counted.RemoteFollowersCount)
+	suite.Equal(*account.Stats.FollowersCount, *counted.LocalFollowersCount+*counted.RemoteFollowersCount)
+	suite.Equal(*account.Stats.FollowingCount, *counted.FollowingCount)
+	suite.Equal(*account.Stats.FollowRequestsCount, *counted.FollowRequestsCount)
+	suite.Equal(*account.Stats.StatusesCount, *counted.StatusesCount)
+	suite.Equal(*account.Stats.StatusesPinnedCount, *counted.StatusesPinnedCount)
+	suite.True(account.Stats.LastStatusAt.Equal(counted.LastStatusAt))
+}
+
+func (suite *AccountTestSuite) TestSnapshotAccountStats() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	before, err := suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Mix some increments + decrements.
+	stats := before.Snapshot()
+	*stats.StatusesCount += 2
+	*stats.FollowersCount--
+	*stats.FollowRequestsCount++
+	if err := suite.db.UpdateAccountStats(ctx,
+		&stats,
+		"statuses_count",
+		"followers_count",
+		"follow_requests_count",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	after, err := suite.db.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Before snapshot should be unaffected
+	// by the changes made to the stats.
+	suite.Equal(*stats.StatusesCount-2, *before.StatusesCount)
+
+	suite.Equal(gtsmodel.AccountStatsDelta{
+		StatusesCount:       2,
+		FollowersCount:      -1,
+		FollowRequestsCount: 1,
+	}, after.Delta(&before))
+
+	// No change from a snapshot to itself.
+	suite.Zero(after.Delta(&after))
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
@@ -19,11 +19,17 @@ package bundb
 
 import (
 	"context"
+	"errors"
+	"net/url"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 )
 
@@ -53,7 +59,7 @@ func (r *interactionDB) GetInteractionApprovalByID(ctx context.Context, id strin
 }
 
 func (r *interactionDB) GetInteractionApprovalByURI(ctx context.Context, uri string) (*gtsmodel.InteractionApproval, error) {
-	return r.getInteractionApproval(
+	approval, err := r.getInteractionApproval(
 		ctx,
 		"URI",
 		func(approval *gtsmodel.InteractionApproval) error {
@@ -64,6 +70,104 @@ func (r *interactionDB) GetInteractionApprovalByURI(ctx context.Context, uri str
 		},
 		uri,
 	)
+	if errors.Is(err, db.ErrNoEntries) {
+		// Approvals of pre-approved interactions
+		// aren't stored, try to synthesize one.
+		return r.getPreApproval(ctx, uri)
+	}
+	return approval, err
+}
+
+// getPreApproval synthesizes an (unstored) approval with
+// the given URI from the local pre-approved interaction
+// approved by it, returning db.ErrNoEntries if none.
+func (r *interactionDB) getPreApproval(ctx context.Context, uri string) (*gtsmodel.InteractionApproval, error) {
+	acceptIRI, err := url.Parse(uri)
+	if err != nil {
+		return nil, db.ErrNoEntries
+	}
+
+	_, approvalID, byID, err := uris.ParseAcceptsPath(acceptIRI)
+	if err != nil || !byID {
+		// Pre-approvals are only ever
+		// made with account ID-based URIs.
+		return nil, db.ErrNoEntries
+	}
+
+	approval := &gtsmodel.InteractionApproval{
+		ID:  approvalID,
+		URI: uri,
+	}
+
+	var faveIDs []string
+
+	// Look for a fave approved by uri.
+	if err := r.db.NewSelect().
+		Table("status_faves").
+		Column("id").
+		Where("? = ?", bun.Ident("approved_by_uri"), uri).
+		Limit(1).
+		Scan(ctx, &faveIDs); err != nil {
+		return nil, err
+	}
+
+	if len(faveIDs) != 0 {
+		fave, err := r.state.DB.GetStatusFaveByID(gtscontext.SetBarebones(ctx), faveIDs[0])
+		if err != nil {
+			return nil, err
+		}
+
+		approval.CreatedAt = fave.UpdatedAt
+		approval.AccountID = fave.TargetAccountID
+		approval.InteractingAccountID = fave.AccountID
+		approval.InteractionURI = fave.URI
+		approval.InteractionType = gtsmodel.InteractionLike
+	} else {
+		var statusIDs []string
+
+		// Else look for a reply or
+		// boost approved by uri.
+		if err := r.db.NewSelect().
+			Table("statuses").
+			Column("id").
+			Where("? = ?", bun.Ident("approved_by_uri"), uri).
+			Limit(1).
+			Scan(ctx, &statusIDs); err != nil {
+			return nil, err
+		}
+
+		if len(statusIDs) == 0 {
+			return nil, db.ErrNoEntries
+		}
+
+		status, err := r.state.DB.GetStatusByID(gtscontext.SetBarebones(ctx), statusIDs[0])
+		if err != nil {
+			return nil, err
+		}
+
+		approval.CreatedAt = status.UpdatedAt
+		approval.InteractingAccountID = status.AccountID
+		approval.InteractionURI = status.URI
+		if status.BoostOfID != "" {
+			approval.AccountID = status.BoostOfAccountID
+			approval.InteractionType = gtsmodel.InteractionAnnounce
+		} else {
+			approval.AccountID = status.InReplyToAccountID
+			approval.InteractionType = gtsmodel.InteractionReply
+		}
+	}
+
+	if err := r.PopulateInteractionApproval(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	if !approval.Account.IsLocal() {
+		// Only local accounts
+		// pre-approve interactions.
+		return nil, db.ErrNoEntries
+	}
+
+	return approval, nil
 }
 
 func (r *interactionDB) getInteractionApproval(
@@ -138,6 +242,215 @@ func (r *interactionDB) PutInteractionApproval(ctx context.Context, approval *gt
 	})
 }
 
+func (r *interactionDB) GetExpiringInteractionApprovals(ctx context.Context) ([]*gtsmodel.InteractionApproval, error) {
+	var approvalIDs []string
+
+	// Select IDs of all approvals with
+	// a set `expires_at` time. Approvals
+	// are only ever stored for local Accepts.
+	if err := r.db.NewSelect().
+		Table("interaction_approvals").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("expires_at")).
+		Scan(ctx, &approvalIDs); err != nil {
+		return nil, err
+	}
+
+	// Preallocate a slice to contain the approval models.
+	approvals := make([]*gtsmodel.InteractionApproval, 0, len(approvalIDs))
+
+	for _, id := range approvalIDs {
+		// Attempt to fetch approval from DB.
+		approval, err := r.GetInteractionApprovalByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting interaction approval %s: %v", id, err)
+			continue
+		}
+
+		// Append approval to return slice.
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+func (r *interactionDB) GetInteractionApprovalsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.InteractionApproval, error) {
+	var approvalIDs []string
+
+	// Select IDs of all approvals
+	// sent by the given account.
+	if err := r.db.NewSelect().
+		Table("interaction_approvals").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Order("id ASC").
+		Scan(ctx, &approvalIDs); err != nil {
+		return nil, err
+	}
+
+	// Preallocate a slice to contain the approval models.
+	approvals := make([]*gtsmodel.InteractionApproval, 0, len(approvalIDs))
+
+	for _, id := range approvalIDs {
+		// Attempt to fetch approval from DB.
+		approval, err := r.GetInteractionApprovalByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting interaction approval %s: %v", id, err)
+			continue
+		}
+
+		// Append approval to return slice.
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+func (r *interactionDB) GetInteractionApprovalsByInteractionURI(ctx context.Context, interactionURI string) ([]*gtsmodel.InteractionApproval, error) {
+	var approvalIDs []string
+
+	// Select IDs of all approvals
+	// of the given interaction.
+	if err := r.db.NewSelect().
+		Table("interaction_approvals").
+		Column("id").
+		Where("? = ?", bun.Ident("interaction_uri"), interactionURI).
+		Order("id ASC").
+		Scan(ctx, &approvalIDs); err != nil {
+		return nil, err
+	}
+
+	// Preallocate a slice to contain the approval models.
+	approvals := make([]*gtsmodel.InteractionApproval, 0, len(approvalIDs))
+
+	for _, id := range approvalIDs {
+		// Attempt to fetch approval from DB.
+		approval, err := r.GetInteractionApprovalByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting interaction approval %s: %v", id, err)
+			continue
+		}
+
+		// Append approval to return slice.
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+func (r *interactionDB) GetInteractionURIsWithDuplicateApprovals(ctx context.Context) ([]string, error) {
+	var interactionURIs []string
+
+	// Select interaction URIs
+	// appearing more than once.
+	if err := r.db.NewSelect().
+		Table("interaction_approvals").
+		Column("interaction_uri").
+		Group("interaction_uri").
+		Having("COUNT(*) > 1").
+		Order("interaction_uri ASC").
+		Scan(ctx, &interactionURIs); err != nil {
+		return nil, err
+	}
+
+	return interactionURIs, nil
+}
+
+func (r *interactionDB) PutInteractionRejection(ctx context.Context, rejection *gtsmodel.InteractionRejection) error {
+	_, err := r.db.NewInsert().Model(rejection).Exec(ctx)
+	return err
+}
+
+func (r *interactionDB) DeleteInteractionRejectionsByAccountID(ctx context.Context, accountID string) error {
+	_, err := r.db.NewDelete().
+		Table("interaction_rejections").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
+func (r *interactionDB) DeleteInteractionRejectionsForStatus(ctx context.Context, statusID string) error {
+	// Delete all rejections of the status or
+	// of its boosts / faves, matching on
+	// interaction URIs.
+	_, err := r.db.NewDelete().
+		Table("interaction_rejections").
+		WhereGroup(" AND ", func(q *bun.DeleteQuery) *bun.DeleteQuery {
+			return q.
+				Where("? IN (?)",
+					bun.Ident("interaction_uri"),
+					r.db.NewSelect().
+						Table("statuses").
+						Column("uri").
+						Where("? = ?", bun.Ident("id"), statusID).
+						WhereOr("? = ?", bun.Ident("boost_of_id"), statusID),
+				).
+				WhereOr("? IN (?)",
+					bun.Ident("interaction_uri"),
+					r.db.NewSelect().
+						Table("status_faves").
+						Column("uri").
+						Where("? = ?", bun.Ident("status_id"), statusID),
+				)
+		}).
+		Exec(ctx)
+	return err
+}
+
+func (r *interactionDB) CountInteractionReasons(
+	ctx context.Context,
+	accountID string,
+) (
+	map[gtsmodel.InteractionReason]int,
+	map[gtsmodel.InteractionReason]int,
+	error,
+) {
+	approved, err := r.countInteractionReasons(ctx, "interaction_approvals", accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rejected, err := r.countInteractionReasons(ctx, "interaction_rejections", accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return approved, rejected, nil
+}
+
+// countInteractionReasons counts the non-null reason
+// codes in the given table owned by given account ID.
+func (r *interactionDB) countInteractionReasons(
+	ctx context.Context,
+	table string,
+	accountID string,
+) (map[gtsmodel.InteractionReason]int, error) {
+	var rows []struct {
+		Reason gtsmodel.InteractionReason `bun:"reason"`
+		Count  int                        `bun:"count"`
+	}
+
+	// Count occurrences of each
+	// reason given by the account.
+	if err := r.db.NewSelect().
+		Table(table).
+		Column("reason").
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? IS NOT NULL", bun.Ident("reason")).
+		Group("reason").
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[gtsmodel.InteractionReason]int, len(rows))
+	for _, row := range rows {
+		counts[row.Reason] = row.Count
+	}
+
+	return counts, nil
+}
+
 func (r *interactionDB) DeleteInteractionApprovalByID(ctx context.Context, id string) error {
 	defer r.state.Caches.DB.InteractionApproval.Invalidate("ID", id)
 
@@ -147,3 +460,364 @@ func (r *interactionDB) DeleteInteractionApprovalByID(ctx context.Context, id st
 		Exec(ctx)
 	return err
 }
+
+func (r *interactionDB) DeleteInteractionApprovalsByInteractingAccountID(ctx context.Context, accountID string) ([]string, error) {
+	var approvalIDs []string
+
+	// Delete all approvals of interactions by
+	// account, returning deleted approval IDs.
+	if _, err := r.db.NewDelete().
+		Table("interaction_approvals").
+		Where("? = ?", bun.Ident("interacting_account_id"), accountID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &approvalIDs); err != nil {
+		return nil, err
+	}
+
+	// Invalidate any cached approvals by their IDs.
+	r.state.Caches.DB.InteractionApproval.InvalidateIDs("ID", approvalIDs)
+
+	return approvalIDs, nil
+}
+
+func (r *interactionDB) DeleteInteractionApprovalsForStatus(ctx context.Context, statusID string) ([]string, error) {
+	var approvalIDs []string
+
+	// Delete all approvals of the status or of
+	// its boosts / faves, matching on interaction
+	// URIs, returning deleted approval IDs.
+	if _, err := r.db.NewDelete().
+		Table("interaction_approvals").
+		WhereGroup(" AND ", func(q *bun.DeleteQuery) *bun.DeleteQuery {
+			return q.
+				Where("? IN (?)",
+					bun.Ident("interaction_uri"),
+					r.db.NewSelect().
+						Table("statuses").
+						Column("uri").
+						Where("? = ?", bun.Ident("id"), statusID).
+						WhereOr("? = ?", bun.Ident("boost_of_id"), statusID),
+				).
+				WhereOr("? IN (?)",
+					bun.Ident("interaction_uri"),
+					r.db.NewSelect().
+						Table("status_faves").
+						Column("uri").
+						Where("? = ?", bun.Ident("status_id"), statusID),
+				)
+		}).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &approvalIDs); err != nil {
+		return nil, err
+	}
+
+	// Invalidate any cached approvals by their IDs.
+	r.state.Caches.DB.InteractionApproval.InvalidateIDs("ID", approvalIDs)
+
+	return approvalIDs, nil
+}
+
+func (r *interactionDB) DeletePendingInteractionsForStatus(
+	ctx context.Context,
+	statusID string,
+) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error) {
+	var faveIDs []string
+
+	// Select IDs of all pending faves of status.
+	if err := r.db.NewSelect().
+		Table("status_faves").
+		Column("id").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Scan(ctx, &faveIDs); err != nil {
+		return nil, nil, gtserror.Newf("error selecting pending faves: %w", err)
+	}
+
+	var statusIDs []string
+
+	// Select IDs of all pending replies to / boosts of status.
+	if err := r.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("in_reply_to_id"), statusID).
+				WhereOr("? = ?", bun.Ident("boost_of_id"), statusID)
+		}).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, nil, gtserror.Newf("error selecting pending statuses: %w", err)
+	}
+
+	return r.deletePendingInteractions(ctx, faveIDs, statusIDs)
+}
+
+func (r *interactionDB) DeletePendingInteractionsFromDomains(
+	ctx context.Context,
+	targetAccountID string,
+	domains []string,
+) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error) {
+	if len(domains) == 0 {
+		// Nothing
+		// to delete.
+		return nil, nil, nil
+	}
+
+	// Subquery to select IDs of
+	// accounts on given domains.
+	accountIDsQ := r.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IN (?)", bun.Ident("account.domain"), bun.In(domains))
+
+	var faveIDs []string
+
+	// Select IDs of all pending faves
+	// by domains targeting target.
+	if err := r.db.NewSelect().
+		Table("status_faves").
+		Column("id").
+		Where("? = ?", bun.Ident("target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Where("? IN (?)", bun.Ident("account_id"), accountIDsQ).
+		Scan(ctx, &faveIDs); err != nil {
+		return nil, nil, gtserror.Newf("error selecting pending faves: %w", err)
+	}
+
+	var statusIDs []string
+
+	// Select IDs of all pending replies +
+	// boosts by domains targeting target.
+	if err := r.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("in_reply_to_account_id"), targetAccountID).
+				WhereOr("? = ?", bun.Ident("boost_of_account_id"), targetAccountID)
+		}).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Where("? IN (?)", bun.Ident("account_id"), accountIDsQ).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, nil, gtserror.Newf("error selecting pending statuses: %w", err)
+	}
+
+	return r.deletePendingInteractions(ctx, faveIDs, statusIDs)
+}
+
+// deletePendingInteractions deletes the pending faves
+// with given IDs, returning the deleted (barebones)
+// faves, and the (not deleted) statuses with given IDs.
+func (r *interactionDB) deletePendingInteractions(
+	ctx context.Context,
+	faveIDs []string,
+	statusIDs []string,
+) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error) {
+	faves := make([]*gtsmodel.StatusFave, 0, len(faveIDs))
+	for _, id := range faveIDs {
+		// We only need a barebones
+		// fave, don't populate it.
+		fave, err := r.state.DB.GetStatusFaveByID(
+			gtscontext.SetBarebones(ctx),
+			id,
+		)
+		if err != nil {
+			return nil, nil, gtserror.Newf("error getting pending fave %s: %w", id, err)
+		}
+
+		// Delete using the fave DB func
+		// so all caches are invalidated.
+		if err := r.state.DB.DeleteStatusFaveByID(ctx, id); err != nil {
+			return nil, nil, gtserror.Newf("error deleting pending fave %s: %w", id, err)
+		}
+
+		faves = append(faves, fave)
+	}
+
+	// Statuses are left in place for the
+	// caller to wipe along with everything
+	// hanging off them (mentions, media,
+	// notifications etc), so fully populate.
+	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+	for _, id := range statusIDs {
+		status, err := r.state.DB.GetStatusByID(ctx, id)
+		if err != nil {
+			return nil, nil, gtserror.Newf("error getting pending status %s: %w", id, err)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return faves, statuses, nil
+}
+
+func (r *interactionDB) GetPendingInteractionsForThread(
+	ctx context.Context,
+	threadID string,
+) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error) {
+	// Subquery to select IDs
+	// of statuses in thread.
+	threadStatusIDsQ := r.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("thread_status")).
+		Column("thread_status.id").
+		Where("? = ?", bun.Ident("thread_status.thread_id"), threadID)
+
+	var faveIDs []string
+
+	// Select IDs of all pending
+	// faves of thread statuses.
+	if err := r.db.NewSelect().
+		Table("status_faves").
+		Column("id").
+		Where("? IN (?)", bun.Ident("status_id"), threadStatusIDsQ).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Order("id ASC").
+		Scan(ctx, &faveIDs); err != nil {
+		return nil, nil, gtserror.Newf("error selecting pending faves: %w", err)
+	}
+
+	var statusIDs []string
+
+	// Select IDs of all pending replies
+	// to / boosts of thread statuses.
+	if err := r.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IN (?)", bun.Ident("in_reply_to_id"), threadStatusIDsQ).
+				WhereOr("? IN (?)", bun.Ident("boost_of_id"), threadStatusIDsQ)
+		}).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Order("id ASC").
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, nil, gtserror.Newf("error selecting pending statuses: %w", err)
+	}
+
+	faves := make([]*gtsmodel.StatusFave, 0, len(faveIDs))
+	for _, id := range faveIDs {
+		fave, err := r.state.DB.GetStatusFaveByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting pending fave %s: %v", id, err)
+			continue
+		}
+		faves = append(faves, fave)
+	}
+
+	statuses, err := r.state.DB.GetStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, nil, gtserror.Newf("error getting pending statuses: %w", err)
+	}
+
+	return faves, statuses, nil
+}
+
+func (r *interactionDB) CountPendingInteractions(ctx context.Context, accountID string, targetAccountID string) (int, error) {
+	// Count pending replies + boosts
+	// by account targeting target.
+	statuses, err := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? = ?", bun.Ident("status.pending_approval"), true).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("status.in_reply_to_account_id"), targetAccountID).
+				WhereOr("? = ?", bun.Ident("status.boost_of_account_id"), targetAccountID)
+		}).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Count pending faves by
+	// account targeting target.
+	faves, err := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		Where("? = ?", bun.Ident("status_fave.account_id"), accountID).
+		Where("? = ?", bun.Ident("status_fave.target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("status_fave.pending_approval"), true).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return statuses + faves, nil
+}
+
+func (r *interactionDB) CountPendingInteractionsForAccount(ctx context.Context, targetAccountID string) (int, error) {
+	return r.state.Caches.DB.PendingInteractionCount.Load(targetAccountID, func() (int, error) {
+		return r.countPendingInteractionsForAccount(ctx, targetAccountID)
+	})
+}
+
+func (r *interactionDB) countPendingInteractionsForAccount(ctx context.Context, targetAccountID string) (int, error) {
+	// Count pending replies +
+	// boosts targeting target.
+	statuses, err := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? = ?", bun.Ident("status.pending_approval"), true).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("status.in_reply_to_account_id"), targetAccountID).
+				WhereOr("? = ?", bun.Ident("status.boost_of_account_id"), targetAccountID)
+		}).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Count pending faves
+	// targeting target.
+	faves, err := r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		Where("? = ?", bun.Ident("status_fave.target_account_id"), targetAccountID).
+		Where("? = ?", bun.Ident("status_fave.pending_approval"), true).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return statuses + faves, nil
+}
+
+func (r *interactionDB) GetAccountIDsWithPendingInteractions(ctx context.Context) ([]string, error) {
+	var accountIDs []string
+
+	// Select IDs of accounts targeted
+	// by pending replies + boosts.
+	for _, column := range []string{
+		"in_reply_to_account_id",
+		"boost_of_account_id",
+	} {
+		var ids []string
+		if err := r.db.
+			NewSelect().
+			Table("statuses").
+			ColumnExpr("DISTINCT ?", bun.Ident(column)).
+			Where("? = ?", bun.Ident("pending_approval"), true).
+			Where("? IS NOT NULL", bun.Ident(column)).
+			Scan(ctx, &ids); err != nil {
+			return nil, err
+		}
+		accountIDs = append(accountIDs, ids...)
+	}
+
+	// Select IDs of accounts
+	// targeted by pending faves.
+	var ids []string
+	if err := r.db.
+		NewSelect().
+		Table("status_faves").
+		ColumnExpr("DISTINCT ?", bun.Ident("target_account_id")).
+		Where("? = ?", bun.Ident("pending_approval"), true).
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	accountIDs = append(accountIDs, ids...)
+
+	return util.Deduplicate(accountIDs), nil
+}
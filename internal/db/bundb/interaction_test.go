@@ -0,0 +1,531 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type InteractionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// putApproval puts a new interaction approval owned
+// by account, approving an interaction by interacter.
+func (suite *InteractionTestSuite) putApproval(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	interacter *gtsmodel.Account,
+	interactionType gtsmodel.InteractionType,
+) *gtsmodel.InteractionApproval {
+	approvalID := id.NewULID()
+	approval := &gtsmodel.InteractionApproval{
+		ID:                   approvalID,
+		AccountID:            account.ID,
+		InteractingAccountID: interacter.ID,
+		InteractionURI:       interacter.URI + "/statuses/" + approvalID,
+		InteractionType:      interactionType,
+		URI:                  uris.GenerateURIForAccept(account.Username, approvalID),
+	}
+
+	if err := suite.state.DB.PutInteractionApproval(ctx, approval); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return approval
+}
+
+func (suite *InteractionTestSuite) TestDeleteInteractionApprovalsByInteractingAccountID() {
+	var (
+		ctx           = context.Background()
+		account       = suite.testAccounts["local_account_1"]
+		interacter    = suite.testAccounts["remote_account_1"]
+		otherAccount  = suite.testAccounts["local_account_2"]
+		approvals     []*gtsmodel.InteractionApproval
+		otherApproval = suite.putApproval(ctx, account, otherAccount, gtsmodel.InteractionLike)
+	)
+
+	// Put a few approvals of interactions by interacter.
+	approvals = append(approvals,
+		suite.putApproval(ctx, account, interacter, gtsmodel.InteractionLike),
+		suite.putApproval(ctx, account, interacter, gtsmodel.InteractionReply),
+		suite.putApproval(ctx, account, interacter, gtsmodel.InteractionAnnounce),
+	)
+
+	// Ensure approvals are cached before the delete.
+	for _, approval := range approvals {
+		if _, err := suite.state.DB.GetInteractionApprovalByID(ctx, approval.ID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	deletedIDs, err := suite.state.DB.DeleteInteractionApprovalsByInteractingAccountID(
+		ctx,
+		interacter.ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(deletedIDs, len(approvals))
+
+	// All approvals of interacter's interactions should be gone.
+	for _, approval := range approvals {
+		_, err := suite.state.DB.GetInteractionApprovalByID(ctx, approval.ID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	// Approval of the other account's interaction should remain.
+	if _, err := suite.state.DB.GetInteractionApprovalByID(ctx, otherApproval.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InteractionTestSuite) TestGetInteractionApprovalsByAccountID() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+		approvals  []*gtsmodel.InteractionApproval
+	)
+
+	// Put a few approvals sent by account.
+	approvals = append(approvals,
+		suite.putApproval(ctx, account, interacter, gtsmodel.InteractionLike),
+		suite.putApproval(ctx, account, interacter, gtsmodel.InteractionReply),
+		suite.putApproval(ctx, account, interacter, gtsmodel.InteractionAnnounce),
+	)
+
+	// Put an approval sent by another account.
+	suite.putApproval(ctx, suite.testAccounts["local_account_2"], interacter, gtsmodel.InteractionLike)
+
+	got, err := suite.state.DB.GetInteractionApprovalsByAccountID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only account's approvals should be
+	// returned, oldest (lowest ID) first.
+	expectIDs := make([]string, 0, len(approvals))
+	for _, approval := range approvals {
+		expectIDs = append(expectIDs, approval.ID)
+	}
+	slices.Sort(expectIDs)
+
+	gotIDs := make([]string, 0, len(got))
+	for _, approval := range got {
+		gotIDs = append(gotIDs, approval.ID)
+	}
+
+	suite.Equal(expectIDs, gotIDs)
+}
+
+func (suite *InteractionTestSuite) TestInteractionReasons() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+	)
+
+	// Put approvals sent by account with
+	// a few reasons, and one without.
+	var reasonedApprovalID string
+	for _, reason := range []gtsmodel.InteractionReason{
+		gtsmodel.InteractionReasonTrusted,
+		gtsmodel.InteractionReasonTrusted,
+		gtsmodel.InteractionReasonOnTopic,
+		"",
+	} {
+		approvalID := id.NewULID()
+		if err := suite.state.DB.PutInteractionApproval(ctx, &gtsmodel.InteractionApproval{
+			ID:                   approvalID,
+			AccountID:            account.ID,
+			InteractingAccountID: interacter.ID,
+			InteractionURI:       interacter.URI + "/statuses/" + approvalID,
+			InteractionType:      gtsmodel.InteractionReply,
+			URI:                  uris.GenerateURIForAccept(account.Username, approvalID),
+			Reason:               reason,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		if reason != "" {
+			reasonedApprovalID = approvalID
+		}
+	}
+
+	// Put rejections recorded by account.
+	for _, reason := range []gtsmodel.InteractionReason{
+		gtsmodel.InteractionReasonSpam,
+		gtsmodel.InteractionReasonSpam,
+		gtsmodel.InteractionReasonSpam,
+		gtsmodel.InteractionReasonOffTopic,
+	} {
+		rejectionID := id.NewULID()
+		if err := suite.state.DB.PutInteractionRejection(ctx, &gtsmodel.InteractionRejection{
+			ID:                   rejectionID,
+			AccountID:            account.ID,
+			InteractingAccountID: interacter.ID,
+			InteractionURI:       interacter.URI + "/statuses/" + rejectionID,
+			InteractionType:      gtsmodel.InteractionReply,
+			Reason:               reason,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Put an approval + rejection by another
+	// account, which shouldn't be counted.
+	otherAccount := suite.testAccounts["local_account_2"]
+	otherApproval := suite.putApproval(ctx, otherAccount, interacter, gtsmodel.InteractionLike)
+	if err := suite.state.DB.PutInteractionRejection(ctx, &gtsmodel.InteractionRejection{
+		ID:                   id.NewULID(),
+		AccountID:            otherAccount.ID,
+		InteractingAccountID: interacter.ID,
+		InteractionURI:       otherApproval.InteractionURI,
+		InteractionType:      gtsmodel.InteractionLike,
+		Reason:               gtsmodel.InteractionReasonSpam,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reason should round-trip through the
+	// db, bypassing the cache to make sure.
+	suite.state.Caches.DB.InteractionApproval.Invalidate("ID", reasonedApprovalID)
+	approval, err := suite.state.DB.GetInteractionApprovalByID(ctx, reasonedApprovalID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.InteractionReasonOnTopic, approval.Reason)
+
+	approved, rejected, err := suite.state.DB.CountInteractionReasons(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(map[gtsmodel.InteractionReason]int{
+		gtsmodel.InteractionReasonTrusted: 2,
+		gtsmodel.InteractionReasonOnTopic: 1,
+	}, approved)
+	suite.Equal(map[gtsmodel.InteractionReason]int{
+		gtsmodel.InteractionReasonSpam:     3,
+		gtsmodel.InteractionReasonOffTopic: 1,
+	}, rejected)
+
+	// Deleting account's rejections should
+	// leave the other account's untouched.
+	if err := suite.state.DB.DeleteInteractionRejectionsByAccountID(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, rejected, err = suite.state.DB.CountInteractionReasons(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(rejected)
+
+	_, rejected, err = suite.state.DB.CountInteractionReasons(ctx, otherAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(map[gtsmodel.InteractionReason]int{
+		gtsmodel.InteractionReasonSpam: 1,
+	}, rejected)
+}
+
+func (suite *InteractionTestSuite) TestDeleteInteractionApprovalsForStatus() {
+	var (
+		ctx           = context.Background()
+		account       = suite.testAccounts["local_account_1"]
+		target        = suite.testStatuses["local_account_1_status_1"]
+		fave          = suite.testFaves["admin_account_local_account_1_status_1"]
+		boost         = suite.testStatuses["admin_account_status_4"]
+		otherApproval = suite.putApproval(ctx, account, suite.testAccounts["admin_account"], gtsmodel.InteractionLike)
+	)
+
+	// putApprovalOf puts an approval
+	// of the given interaction URI.
+	putApprovalOf := func(
+		interacterID string,
+		interactionURI string,
+		interactionType gtsmodel.InteractionType,
+	) *gtsmodel.InteractionApproval {
+		approvalID := id.NewULID()
+		approval := &gtsmodel.InteractionApproval{
+			ID:                   approvalID,
+			AccountID:            account.ID,
+			InteractingAccountID: interacterID,
+			InteractionURI:       interactionURI,
+			InteractionType:      interactionType,
+			URI:                  uris.GenerateURIForAccept(account.Username, approvalID),
+		}
+
+		if err := suite.state.DB.PutInteractionApproval(ctx, approval); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		return approval
+	}
+
+	// Put approvals of the status itself,
+	// and of a fave and a boost of it.
+	approvals := []*gtsmodel.InteractionApproval{
+		putApprovalOf(target.AccountID, target.URI, gtsmodel.InteractionReply),
+		putApprovalOf(fave.AccountID, fave.URI, gtsmodel.InteractionLike),
+		putApprovalOf(boost.AccountID, boost.URI, gtsmodel.InteractionAnnounce),
+	}
+
+	// Ensure approvals are cached before the delete.
+	for _, approval := range approvals {
+		if _, err := suite.state.DB.GetInteractionApprovalByID(ctx, approval.ID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	deletedIDs, err := suite.state.DB.DeleteInteractionApprovalsForStatus(
+		ctx,
+		target.ID,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(deletedIDs, len(approvals))
+
+	// All approvals relating to status should be gone.
+	for _, approval := range approvals {
+		_, err := suite.state.DB.GetInteractionApprovalByID(ctx, approval.ID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	// Unrelated approval should remain.
+	if _, err := suite.state.DB.GetInteractionApprovalByID(ctx, otherApproval.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InteractionTestSuite) TestCountPendingInteractions() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+		target     = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Put a pending reply by interacter.
+	reply := new(gtsmodel.Status)
+	*reply = *suite.testStatuses["remote_account_1_status_1"]
+	reply.ID = id.NewULID()
+	reply.URI = interacter.URI + "/statuses/" + reply.ID
+	reply.InReplyToID = target.ID
+	reply.InReplyToURI = target.URI
+	reply.InReplyToAccountID = account.ID
+	reply.AttachmentIDs = nil
+	reply.PendingApproval = util.Ptr(true)
+	if err := suite.state.DB.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a pending fave by interacter.
+	faveID := id.NewULID()
+	if err := suite.state.DB.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       interacter.ID,
+		TargetAccountID: account.ID,
+		StatusID:        target.ID,
+		URI:             interacter.URI + "/likes/" + faveID,
+		PendingApproval: util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	count, err := suite.state.DB.CountPendingInteractions(ctx, interacter.ID, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, count)
+
+	// Interacter has nothing pending with another account.
+	count, err = suite.state.DB.CountPendingInteractions(ctx, interacter.ID, suite.testAccounts["local_account_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(count)
+}
+
+func (suite *InteractionTestSuite) TestDeletePendingInteractionsForStatus() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+		target     = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Put a pending reply by interacter.
+	reply := new(gtsmodel.Status)
+	*reply = *suite.testStatuses["remote_account_1_status_1"]
+	reply.ID = id.NewULID()
+	reply.URI = interacter.URI + "/statuses/" + reply.ID
+	reply.InReplyToID = target.ID
+	reply.InReplyToURI = target.URI
+	reply.InReplyToAccountID = account.ID
+	reply.AttachmentIDs = nil
+	reply.PendingApproval = util.Ptr(true)
+	if err := suite.state.DB.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a pending fave by interacter.
+	faveID := id.NewULID()
+	if err := suite.state.DB.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       interacter.ID,
+		TargetAccountID: account.ID,
+		StatusID:        target.ID,
+		URI:             interacter.URI + "/likes/" + faveID,
+		PendingApproval: util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	faves, statuses, err := suite.state.DB.DeletePendingInteractionsForStatus(ctx, target.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the pending interactions should be returned.
+	if suite.Len(faves, 1) {
+		suite.Equal(faveID, faves[0].ID)
+	}
+	if suite.Len(statuses, 1) {
+		suite.Equal(reply.ID, statuses[0].ID)
+	}
+
+	// Pending fave should be gone.
+	_, err = suite.state.DB.GetStatusFaveByID(ctx, faveID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Pending reply is left for the caller to wipe.
+	_, err = suite.state.DB.GetStatusByID(ctx, reply.ID)
+	suite.NoError(err)
+
+	// Approved faves should remain.
+	remaining, err := suite.state.DB.GetStatusFaves(ctx, target.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(remaining)
+
+	// Only the pending reply is still counted.
+	count, err := suite.state.DB.CountPendingInteractions(ctx, interacter.ID, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, count)
+}
+
+func (suite *InteractionTestSuite) TestGetPendingInteractionsForThread() {
+	var (
+		ctx         = context.Background()
+		interacter  = suite.testAccounts["remote_account_1"]
+		target      = suite.testStatuses["local_account_1_status_1"]
+		otherTarget = suite.testStatuses["local_account_1_status_2"]
+	)
+
+	// putPending puts a pending status by interacter,
+	// replying to or boosting the given status.
+	putPending := func(status *gtsmodel.Status, boost bool) *gtsmodel.Status {
+		pending := new(gtsmodel.Status)
+		*pending = *suite.testStatuses["remote_account_1_status_1"]
+		pending.ID = id.NewULID()
+		pending.URI = interacter.URI + "/statuses/" + pending.ID
+		pending.AttachmentIDs = nil
+		pending.PendingApproval = util.Ptr(true)
+		if boost {
+			pending.BoostOfID = status.ID
+			pending.BoostOfURI = status.URI
+			pending.BoostOfAccountID = status.AccountID
+		} else {
+			pending.InReplyToID = status.ID
+			pending.InReplyToURI = status.URI
+			pending.InReplyToAccountID = status.AccountID
+			pending.ThreadID = status.ThreadID
+		}
+		if err := suite.state.DB.PutStatus(ctx, pending); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return pending
+	}
+
+	// Put a pending reply + boost in the thread.
+	reply := putPending(target, false)
+	boost := putPending(target, true)
+
+	// Put a pending fave in the thread.
+	faveID := id.NewULID()
+	if err := suite.state.DB.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       interacter.ID,
+		TargetAccountID: target.AccountID,
+		StatusID:        target.ID,
+		URI:             interacter.URI + "/likes/" + faveID,
+		PendingApproval: util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a pending reply in another
+	// thread, which shouldn't be returned.
+	putPending(otherTarget, false)
+
+	faves, statuses, err := suite.state.DB.GetPendingInteractionsForThread(ctx, target.ThreadID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the thread's pending
+	// interactions should be returned,
+	// oldest first.
+	if suite.Len(faves, 1) {
+		suite.Equal(faveID, faves[0].ID)
+	}
+	if suite.Len(statuses, 2) {
+		suite.Equal(reply.ID, statuses[0].ID)
+		suite.Equal(boost.ID, statuses[1].ID)
+	}
+
+	// Nothing pending should be
+	// returned for an unknown thread.
+	faves, statuses, err = suite.state.DB.GetPendingInteractionsForThread(ctx, id.NewULID())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(faves)
+	suite.Empty(statuses)
+}
+
+func TestInteractionTestSuite(t *testing.T) {
+	suite.Run(t, new(InteractionTestSuite))
+}
@@ -61,6 +61,29 @@ func (m *markerDB) GetMarker(ctx context.Context, accountID string, name gtsmode
 	return marker, nil
 }
 
+func (m *markerDB) GetMarkersByLastReadID(ctx context.Context, name gtsmodel.MarkerName, lastReadID string) ([]*gtsmodel.Marker, error) {
+	var accountIDs []string
+
+	if err := m.db.NewSelect().
+		Table("markers").
+		Column("account_id").
+		Where("? = ? AND ? = ?", bun.Ident("name"), name, bun.Ident("last_read_id"), lastReadID).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	markers := make([]*gtsmodel.Marker, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		marker, err := m.GetMarker(ctx, accountID, name)
+		if err != nil {
+			return nil, fmt.Errorf("GetMarkersByLastReadID: error getting marker for account %s: %w", accountID, err)
+		}
+		markers = append(markers, marker)
+	}
+
+	return markers, nil
+}
+
 func (m *markerDB) UpdateMarker(ctx context.Context, marker *gtsmodel.Marker) error {
 	prevMarker, err := m.GetMarker(ctx, marker.AccountID, marker.Name)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -121,6 +121,97 @@ func (m *mediaDB) UpdateAttachment(ctx context.Context, media *gtsmodel.MediaAtt
 	})
 }
 
+func (m *mediaDB) UnattachAttachmentsForStatus(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.MediaAttachment, error) {
+	var attachmentIDs []string
+
+	// Unattach all media owned by the status
+	// author from the status in one update,
+	// returning the IDs of unattached media.
+	if _, err := m.db.NewUpdate().
+		Table("media_attachments").
+		Set("? = NULL", bun.Ident("status_id")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("status_id"), status.ID).
+		Where("? = ?", bun.Ident("account_id"), status.AccountID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &attachmentIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	if len(attachmentIDs) == 0 {
+		// Nothing was attached.
+		return nil, nil
+	}
+
+	// Invalidate all unattached media by IDs.
+	m.state.Caches.DB.Media.InvalidateIDs("ID", attachmentIDs)
+
+	// Fetch the freshly unattached media.
+	media, err := m.GetAttachmentsByIDs(ctx, attachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder the media to match the order
+	// in which they were attached to status,
+	// so a redraft can preserve media order.
+	getID := func(m *gtsmodel.MediaAttachment) string { return m.ID }
+	util.OrderBy(media, status.AttachmentIDs, getID)
+
+	return media, nil
+}
+
+func (m *mediaDB) DeleteAttachmentsForStatus(ctx context.Context, statusID string) ([]*gtsmodel.MediaAttachment, error) {
+	var media []*gtsmodel.MediaAttachment
+
+	if err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// Select all media attached to status,
+		// so callers can clean up their files.
+		if err := tx.NewSelect().
+			Model(&media).
+			Where("? = ?", bun.Ident("media_attachment.status_id"), statusID).
+			Scan(ctx); err != nil {
+			return err
+		}
+
+		if len(media) == 0 {
+			// Nothing
+			// to do.
+			return nil
+		}
+
+		mediaIDs := make([]string, len(media))
+		for i, attachment := range media {
+			mediaIDs[i] = attachment.ID
+		}
+
+		// Delete all selected media in one go.
+		if _, err := tx.NewDelete().
+			Table("media_attachments").
+			Where("? IN (?)", bun.Ident("id"), bun.In(mediaIDs)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	}); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	for _, attachment := range media {
+		// Invalidate deleted media by ID,
+		// which in turn invalidates status.
+		m.state.Caches.DB.Media.Invalidate("ID", attachment.ID)
+	}
+
+	// Status may not have had any
+	// media cached, so invalidate it.
+	m.state.Caches.DB.Status.Invalidate("ID", statusID)
+
+	return media, nil
+}
+
 func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 	// Load media into cache before attempting a delete,
 	// as we need it cached in order to trigger the invalidate
@@ -220,6 +311,21 @@ func (m *mediaDB) DeleteAttachment(ctx context.Context, id string) error {
 	return err
 }
 
+func (m *mediaDB) GetAttachmentIDsForStatus(ctx context.Context, statusID string) ([]string, error) {
+	var attachmentIDs []string
+
+	if err := m.db.NewSelect().
+		Table("media_attachments").
+		Column("id").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Order("id ASC").
+		Scan(ctx, &attachmentIDs); err != nil {
+		return nil, err
+	}
+
+	return attachmentIDs, nil
+}
+
 func (m *mediaDB) GetAttachments(ctx context.Context, page *paging.Page) ([]*gtsmodel.MediaAttachment, error) {
 	maxID := page.GetMax()
 	limit := page.GetLimit()
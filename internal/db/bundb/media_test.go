@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 )
 
 type MediaTestSuite struct {
@@ -50,6 +51,68 @@ func (suite *MediaTestSuite) TestGetCachedAttachmentsOlderThan() {
 	suite.Len(attachments, 3)
 }
 
+func (suite *MediaTestSuite) TestUnattachAttachmentsForStatus() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_4"]
+
+	attachments, err := suite.db.UnattachAttachmentsForStatus(ctx, testStatus)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Returned attachments should be in
+	// the same order as on the status.
+	attachmentIDs := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		attachmentIDs[i] = attachment.ID
+	}
+	suite.Equal(testStatus.AttachmentIDs, attachmentIDs)
+
+	// Each attachment should now be unattached.
+	for _, id := range testStatus.AttachmentIDs {
+		attachment, err := suite.db.GetAttachmentByID(ctx, id)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Empty(attachment.StatusID)
+	}
+
+	// Unattaching again should be a no-op.
+	attachments, err = suite.db.UnattachAttachmentsForStatus(ctx, testStatus)
+	suite.NoError(err)
+	suite.Empty(attachments)
+}
+
+func (suite *MediaTestSuite) TestDeleteAttachmentsForStatus() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_4"]
+
+	attachments, err := suite.db.DeleteAttachmentsForStatus(ctx, testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// All attachments of the status should be
+	// returned, so their files can be removed.
+	attachmentIDs := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		suite.NotEmpty(attachment.File.Path)
+		attachmentIDs[i] = attachment.ID
+	}
+	suite.ElementsMatch(testStatus.AttachmentIDs, attachmentIDs)
+
+	// Each attachment should now be gone.
+	for _, id := range testStatus.AttachmentIDs {
+		_, err := suite.db.GetAttachmentByID(ctx, id)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	// Deleting again should be a no-op.
+	attachments, err = suite.db.DeleteAttachmentsForStatus(ctx, testStatus.ID)
+	suite.NoError(err)
+	suite.Empty(attachments)
+}
+
 func TestMediaTestSuite(t *testing.T) {
 	suite.Run(t, new(MediaTestSuite))
 }
@@ -112,6 +112,21 @@ func (m *mentionDB) GetMentions(ctx context.Context, ids []string) ([]*gtsmodel.
 
 }
 
+func (m *mentionDB) GetMentionIDsForStatus(ctx context.Context, statusID string) ([]string, error) {
+	var mentionIDs []string
+
+	if err := m.db.NewSelect().
+		Table("mentions").
+		Column("id").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Order("id ASC").
+		Scan(ctx, &mentionIDs); err != nil {
+		return nil, err
+	}
+
+	return mentionIDs, nil
+}
+
 func (m *mentionDB) PopulateMention(ctx context.Context, mention *gtsmodel.Mention) (err error) {
 	var errs gtserror.MultiError
 
@@ -180,3 +195,23 @@ func (m *mentionDB) DeleteMentionByID(ctx context.Context, id string) error {
 		Exec(ctx)
 	return err
 }
+
+func (m *mentionDB) DeleteMentionsForStatus(ctx context.Context, statusID string) error {
+	var mentionIDs []string
+
+	// Delete all mentions of the status,
+	// returning deleted mention IDs.
+	if _, err := m.db.NewDelete().
+		Table("mentions").
+		Where("? = ?", bun.Ident("status_id"), statusID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &mentionIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Invalidate any cached mentions by their IDs.
+	m.state.Caches.DB.Mention.InvalidateIDs("ID", mentionIDs)
+
+	return nil
+}
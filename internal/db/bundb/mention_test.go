@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 )
 
 type MentionTestSuite struct {
@@ -59,6 +60,33 @@ func (suite *MentionTestSuite) TestGetMentions() {
 	suite.NotNil(dbMention.Status)
 }
 
+func (suite *MentionTestSuite) TestDeleteMentionsForStatus() {
+	ctx := context.Background()
+	m := suite.testMentions["local_user_2_mention_zork"]
+
+	// Ensure mention is cached first.
+	if _, err := suite.db.GetMention(ctx, m.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.DeleteMentionsForStatus(ctx, m.StatusID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Mention should be gone,
+	// from cache and database.
+	_, err := suite.db.GetMention(ctx, m.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	mentionIDs, err := suite.db.GetMentionIDsForStatus(ctx, m.StatusID)
+	suite.NoError(err)
+	suite.Empty(mentionIDs)
+
+	// Deleting again should be a no-op.
+	err = suite.db.DeleteMentionsForStatus(ctx, m.StatusID)
+	suite.NoError(err)
+}
+
 func TestMentionTestSuite(t *testing.T) {
 	suite.Run(t, new(MentionTestSuite))
 }
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Approvals of boosts were previously stored
+			// typed as replies; retype any such approval
+			// whose interaction is a boost wrapper status.
+			res, err := tx.NewUpdate().
+				Table("interaction_approvals").
+				Set("? = ?", bun.Ident("interaction_type"), gtsmodel.InteractionAnnounce).
+				Where("? = ?", bun.Ident("interaction_type"), gtsmodel.InteractionReply).
+				Where("? IN (?)",
+					bun.Ident("interaction_uri"),
+					tx.NewSelect().
+						Table("statuses").
+						Column("uri").
+						Where("? IS NOT NULL", bun.Ident("boost_of_id")),
+				).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			if n, _ := res.RowsAffected(); n > 0 {
+				log.Infof(ctx, "retyped %d boost approvals stored as replies", n)
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, column := range []string{
+				"local_followers_count",
+				"remote_followers_count",
+			} {
+				exists, err := doesColumnExist(ctx, tx,
+					"account_stats", column,
+				)
+				if err != nil {
+					// Real error.
+					return err
+				} else if exists {
+					// Already created.
+					continue
+				}
+
+				log.Infof(ctx, "adding column '%s' to 'account_stats'...", column)
+				if _, err := tx.ExecContext(ctx,
+					"ALTER TABLE ? ADD COLUMN ? INTEGER NOT NULL DEFAULT 0",
+					bun.Ident("account_stats"),
+					bun.Ident(column),
+				); err != nil {
+					return err
+				}
+			}
+
+			// Subquery selecting
+			// IDs of local accounts.
+			localAccountsQ := tx.NewSelect().
+				Table("accounts").
+				Column("id").
+				Where("? IS NULL", bun.Ident("domain"))
+
+			// followersQ returns a subquery counting followers
+			// of the account of each stats row whose account
+			// ID is or isn't (as per op) a local account ID.
+			followersQ := func(op string) *bun.SelectQuery {
+				return tx.NewSelect().
+					Table("follows").
+					ColumnExpr("COUNT(*)").
+					Where("? = ?", bun.Ident("follows.target_account_id"), bun.Ident("account_stats.account_id")).
+					Where("? "+op+" (?)", bun.Ident("follows.account_id"), localAccountsQ)
+			}
+
+			// Populate the new columns
+			// from the existing follows.
+			log.Info(ctx, "counting local and remote followers of accounts...")
+			if _, err := tx.NewUpdate().
+				Table("account_stats").
+				Set("? = (?)", bun.Ident("local_followers_count"), followersQ("IN")).
+				Set("? = (?)", bun.Ident("remote_followers_count"), followersQ("NOT IN")).
+				Where("1 = 1").
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
@@ -236,11 +236,44 @@ func (m *moveDB) UpdateMove(ctx context.Context, move *gtsmodel.Move, columns ..
 func (m *moveDB) DeleteMoveByID(ctx context.Context, id string) error {
 	defer m.state.Caches.DB.Move.Invalidate("ID", id)
 
+	return m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("move_events"), bun.Ident("move_event")).
+			Where("? = ?", bun.Ident("move_event.move_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("moves"), bun.Ident("move")).
+			Where("? = ?", bun.Ident("move.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (m *moveDB) GetMoveEvents(ctx context.Context, moveID string) ([]*gtsmodel.MoveEvent, error) {
+	var events []*gtsmodel.MoveEvent
+
+	if err := m.db.
+		NewSelect().
+		Model(&events).
+		Where("? = ?", bun.Ident("move_event.move_id"), moveID).
+		Order("move_event.created_at ASC").
+		Order("move_event.id ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (m *moveDB) PutMoveEvent(ctx context.Context, event *gtsmodel.MoveEvent) error {
 	_, err := m.db.
-		NewDelete().
-		TableExpr("? AS ?", bun.Ident("moves"), bun.Ident("move")).
-		Where("? = ?", bun.Ident("move.id"), id).
+		NewInsert().
+		Model(event).
 		Exec(ctx)
-
 	return err
 }
@@ -357,3 +357,33 @@ func (n *notificationDB) DeleteNotificationsForStatus(ctx context.Context, statu
 	n.state.Caches.DB.Notification.InvalidateIDs("ID", notifIDs)
 	return nil
 }
+
+func (n *notificationDB) DeleteNotificationsForStatuses(ctx context.Context, statusIDs []string) ([]string, error) {
+	if len(statusIDs) == 0 {
+		// Nothing
+		// to delete.
+		return nil, nil
+	}
+
+	var notifs []*gtsmodel.Notification
+
+	if _, err := n.db.
+		NewDelete().
+		Table("notifications").
+		Where("? IN (?)", bun.Ident("status_id"), bun.In(statusIDs)).
+		Returning("?, ?", bun.Ident("id"), bun.Ident("target_account_id")).
+		Exec(ctx, &notifs); err != nil {
+		return nil, err
+	}
+
+	// Invalidate all deleted notifications by IDs.
+	notifIDs := util.Gather(nil, notifs, func(notif *gtsmodel.Notification) string {
+		return notif.ID
+	})
+	n.state.Caches.DB.Notification.InvalidateIDs("ID", notifIDs)
+
+	// Return deduplicated IDs of targeted accounts.
+	return util.Collate(notifs, func(notif *gtsmodel.Notification) string {
+		return notif.TargetAccountID
+	}), nil
+}
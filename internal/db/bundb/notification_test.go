@@ -263,6 +263,50 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsPertainingToStatusID(
 	}
 }
 
+func (suite *NotificationTestSuite) TestDeleteNotificationsForStatuses() {
+	var (
+		ctx         = context.Background()
+		testStatus1 = suite.testStatuses["local_account_1_status_1"]
+		testStatus2 = suite.testStatuses["admin_account_status_1"]
+	)
+
+	accountIDs, err := suite.db.DeleteNotificationsForStatuses(ctx, []string{
+		testStatus1.ID,
+		testStatus2.ID,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Accounts targeted by the deleted
+	// notifications should be returned.
+	suite.ElementsMatch([]string{
+		suite.testAccounts["local_account_1"].ID,
+		suite.testAccounts["admin_account"].ID,
+	}, accountIDs)
+
+	notif := []*gtsmodel.Notification{}
+	if err := suite.db.GetAll(ctx, &notif); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+
+	for _, n := range notif {
+		if n.StatusID == testStatus1.ID || n.StatusID == testStatus2.ID {
+			suite.FailNowf("", "no notifications with status id %s should remain", n.StatusID)
+		}
+	}
+
+	// Deleting again should be a no-op.
+	accountIDs, err = suite.db.DeleteNotificationsForStatuses(ctx, []string{
+		testStatus1.ID,
+		testStatus2.ID,
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(accountIDs)
+}
+
 func TestNotificationTestSuite(t *testing.T) {
 	suite.Run(t, new(NotificationTestSuite))
 }
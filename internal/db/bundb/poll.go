@@ -192,6 +192,22 @@ func (p *pollDB) DeletePollByID(ctx context.Context, id string) error {
 	return nil
 }
 
+func (p *pollDB) PutPollArchive(ctx context.Context, archive *gtsmodel.PollArchive) error {
+	_, err := p.db.NewInsert().Model(archive).Exec(ctx)
+	return err
+}
+
+func (p *pollDB) GetPollArchiveByPollID(ctx context.Context, pollID string) (*gtsmodel.PollArchive, error) {
+	var archive gtsmodel.PollArchive
+	if err := p.db.NewSelect().
+		Model(&archive).
+		Where("? = ?", bun.Ident("poll_id"), pollID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return &archive, nil
+}
+
 func (p *pollDB) GetPollVoteByID(ctx context.Context, id string) (*gtsmodel.PollVote, error) {
 	return p.getPollVote(
 		ctx,
@@ -219,6 +219,46 @@ func (suite *PollTestSuite) TestPutPoll() {
 	}
 }
 
+func (suite *PollTestSuite) TestPutPollArchive() {
+	// Create a new context for this test.
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+
+	for _, poll := range suite.testPolls {
+		// Fetch the status this poll is attached to.
+		status, err := suite.db.GetStatusByID(ctx, poll.StatusID)
+		suite.NoError(err)
+
+		// Archive this poll's tallies.
+		err = suite.db.PutPollArchive(ctx, &gtsmodel.PollArchive{
+			ID:        id.NewULID(),
+			PollID:    poll.ID,
+			StatusID:  poll.StatusID,
+			AccountID: status.AccountID,
+			Multiple:  poll.Multiple,
+			Options:   poll.Options,
+			Votes:     poll.Votes,
+			Voters:    poll.Voters,
+		})
+		suite.NoError(err)
+
+		// Delete this poll from the database.
+		err = suite.db.DeletePollByID(ctx, poll.ID)
+		suite.NoError(err)
+
+		// Ensure that afterwards we can still fetch tallies.
+		archive, err := suite.db.GetPollArchiveByPollID(ctx, poll.ID)
+		suite.NoError(err)
+		suite.Equal(poll.Options, archive.Options)
+		suite.Equal(poll.Votes, archive.Votes)
+		suite.Equal(*poll.Voters, *archive.Voters)
+	}
+
+	// Ensure fetching an unarchived poll returns no entries.
+	_, err := suite.db.GetPollArchiveByPollID(ctx, id.NewULID())
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *PollTestSuite) TestPutPollVote() {
 	// Create a new context for this test.
 	ctx, cncl := context.WithCancel(context.Background())
@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
@@ -478,6 +479,94 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status, co
 	})
 }
 
+func (s *statusDB) SwapStatusAttachments(ctx context.Context, status *gtsmodel.Status, attachmentIDs []string) ([]string, error) {
+	var added, removed []string
+	for _, id := range util.Deduplicate(attachmentIDs) {
+		if !slices.Contains(status.AttachmentIDs, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range status.AttachmentIDs {
+		if !slices.Contains(attachmentIDs, id) {
+			removed = append(removed, id)
+		}
+	}
+
+	// Update a copy, so the passed status
+	// is left untouched should the swap fail.
+	updated := new(gtsmodel.Status)
+	*updated = *status
+	updated.AttachmentIDs = attachmentIDs
+	updated.Attachments = nil
+	updated.UpdatedAt = time.Now()
+
+	if err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if len(added) > 0 {
+			// Attach added media to the status. Every
+			// added ID must match a row, otherwise the
+			// status would point to missing media.
+			res, err := tx.NewUpdate().
+				Table("media_attachments").
+				Set("? = ?", bun.Ident("status_id"), status.ID).
+				Set("? = ?", bun.Ident("updated_at"), updated.UpdatedAt).
+				Where("? IN (?)", bun.Ident("id"), bun.In(added)).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			n, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			if int(n) != len(added) {
+				return gtserror.Newf("%d of %d added attachments not found", len(added)-int(n), len(added))
+			}
+		}
+
+		if len(removed) > 0 {
+			// Unattach removed media still owned by the
+			// status from it, returning only the IDs of
+			// media actually unattached by this swap.
+			var unattached []string
+			if _, err := tx.NewUpdate().
+				Table("media_attachments").
+				Set("? = NULL", bun.Ident("status_id")).
+				Set("? = ?", bun.Ident("updated_at"), updated.UpdatedAt).
+				Where("? IN (?)", bun.Ident("id"), bun.In(removed)).
+				Where("? = ?", bun.Ident("status_id"), status.ID).
+				Returning("?", bun.Ident("id")).
+				Exec(ctx, &unattached); err != nil &&
+				!errors.Is(err, db.ErrNoEntries) {
+				return err
+			}
+			removed = unattached
+		}
+
+		// Finally, update the status.
+		_, err := tx.NewUpdate().
+			Model(updated).
+			Column("attachments", "updated_at").
+			Where("? = ?", bun.Ident("status.id"), status.ID).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	// Invalidate the status and all media whose owner changed.
+	s.state.Caches.DB.Status.Invalidate("ID", status.ID)
+	s.state.Caches.DB.Media.InvalidateIDs("ID", added)
+	s.state.Caches.DB.Media.InvalidateIDs("ID", removed)
+
+	status.AttachmentIDs = updated.AttachmentIDs
+	status.Attachments = nil
+	status.UpdatedAt = updated.UpdatedAt
+
+	return removed, nil
+}
+
 func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 	// Load status into cache before attempting a delete,
 	// as we need it cached in order to trigger the invalidate
@@ -539,6 +628,49 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 	})
 }
 
+func (s *statusDB) GetBoostIDsByAccount(ctx context.Context, accountID string) ([]string, error) {
+	var boostIDs []string
+
+	// Select IDs of all boosts by account.
+	if err := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? IS NOT NULL", bun.Ident("status.boost_of_id")).
+		Scan(ctx, &boostIDs); err != nil {
+		return nil, err
+	}
+
+	return boostIDs, nil
+}
+
+func (s *statusDB) GetStatuses(ctx context.Context, page *paging.Page) ([]*gtsmodel.Status, error) {
+	maxID := page.GetMax()
+	limit := page.GetLimit()
+
+	statusIDs := make([]string, 0, limit)
+
+	q := s.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Order("id DESC")
+
+	if maxID != "" {
+		q = q.Where("id < ?", maxID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error) {
 	var statusIDs []string
 
@@ -556,6 +688,24 @@ func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([
 	return s.GetStatusesByIDs(ctx, statusIDs)
 }
 
+func (s *statusDB) GetExpiringStatuses(ctx context.Context) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	// Select IDs of all local statuses
+	// with a set `expires_at` time.
+	if err := s.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("expires_at")).
+		Where("? = ?", bun.Ident("local"), true).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusParents(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Status, error) {
 	var parents []*gtsmodel.Status
 
@@ -639,6 +789,40 @@ func (s *statusDB) getStatusReplyIDs(ctx context.Context, statusID string) ([]st
 	})
 }
 
+func (s *statusDB) ReparentStatusReplies(ctx context.Context, statusID string, parent *gtsmodel.Status) ([]string, error) {
+	var replyIDs []string
+
+	// Repoint all direct replies to the
+	// new parent in one update, returning
+	// the IDs of the updated replies.
+	if _, err := s.db.NewUpdate().
+		Table("statuses").
+		Set("? = ?", bun.Ident("in_reply_to_id"), parent.ID).
+		Set("? = ?", bun.Ident("in_reply_to_account_id"), parent.AccountID).
+		Where("? = ?", bun.Ident("in_reply_to_id"), statusID).
+		Returning("?", bun.Ident("id")).
+		Exec(ctx, &replyIDs); err != nil &&
+		!errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	if len(replyIDs) == 0 {
+		// Nothing was reparented.
+		return nil, nil
+	}
+
+	// Invalidate all reparented statuses by IDs.
+	s.state.Caches.DB.Status.InvalidateIDs("ID", replyIDs)
+
+	// Invalidate reply ID lists of both parents.
+	s.state.Caches.DB.InReplyToIDs.Invalidate(statusID, parent.ID)
+
+	// And the interaction counts of both.
+	s.state.Caches.DB.StatusInteractionCounts.Invalidate(statusID, parent.ID)
+
+	return replyIDs, nil
+}
+
 func (s *statusDB) GetStatusBoosts(ctx context.Context, statusID string) ([]*gtsmodel.Status, error) {
 	statusIDs, err := s.getStatusBoostIDs(ctx, statusID)
 	if err != nil {
@@ -664,6 +848,40 @@ func (s *statusDB) CountStatusBoosts(ctx context.Context, statusID string) (int,
 	return len(statusIDs), err
 }
 
+func (s *statusDB) GetStatusInteractionCounts(ctx context.Context, statusID string) (gtsmodel.StatusInteractionCounts, error) {
+	return s.state.Caches.DB.StatusInteractionCounts.Load(statusID, func() (gtsmodel.StatusInteractionCounts, error) {
+		var counts gtsmodel.StatusInteractionCounts
+
+		// countQ returns a subquery counting
+		// rows of table where column = statusID.
+		countQ := func(table, column string) *bun.SelectQuery {
+			return s.db.
+				NewSelect().
+				Table(table).
+				ColumnExpr("COUNT(*)").
+				Where("? = ?", bun.Ident(column), statusID)
+		}
+
+		// Status interaction counts not in cache, perform DB query!
+		if err := s.db.
+			NewSelect().
+			ColumnExpr("(?)", countQ("status_faves", "status_id")).
+			ColumnExpr("(?)", countQ("status_bookmarks", "status_id")).
+			ColumnExpr("(?)", countQ("statuses", "boost_of_id")).
+			ColumnExpr("(?)", countQ("statuses", "in_reply_to_id")).
+			Scan(ctx,
+				&counts.Faves,
+				&counts.Bookmarks,
+				&counts.Boosts,
+				&counts.Replies,
+			); err != nil {
+			return counts, err
+		}
+
+		return counts, nil
+	})
+}
+
 func (s *statusDB) getStatusBoostIDs(ctx context.Context, statusID string) ([]string, error) {
 	return s.state.Caches.DB.BoostOfIDs.Load(statusID, func() ([]string, error) {
 		var statusIDs []string
@@ -714,3 +932,33 @@ func (s *statusDB) GetDirectStatusIDsBatch(ctx context.Context, minID string, ma
 	}
 	return statusIDs, nil
 }
+
+func (s *statusDB) GetAccountDirectStatusIDsBatch(ctx context.Context, accountID string, minID string, count int) ([]string, error) {
+	// Subquery to select IDs of
+	// statuses mentioning account.
+	mentionedQ := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+		Column("mention.status_id").
+		Where("? = ?", bun.Ident("mention.target_account_id"), accountID)
+
+	var statusIDs []string
+	if err := s.db.
+		NewSelect().
+		Model((*gtsmodel.Status)(nil)).
+		Column("id").
+		Where("? = ?", bun.Ident("visibility"), gtsmodel.VisibilityDirect).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("account_id"), accountID).
+				WhereOr("? IN (?)", bun.Ident("id"), mentionedQ)
+		}).
+		Where("? > ?", bun.Ident("id"), minID).
+		Order("id ASC").
+		Limit(count).
+		Scan(ctx, &statusIDs); // nocollapse
+	err != nil {
+		return nil, err
+	}
+	return statusIDs, nil
+}
@@ -19,12 +19,14 @@ package bundb_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 )
 
 type StatusTestSuite struct {
@@ -165,6 +167,179 @@ func (suite *StatusTestSuite) TestGetStatusReplies() {
 	}
 }
 
+func (suite *StatusTestSuite) TestReparentStatusReplies() {
+	ctx := context.Background()
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+	newParent := suite.testStatuses["admin_account_status_1"]
+
+	// Load replies into the cache first.
+	replies, err := suite.db.GetStatusReplies(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Len(replies, 2)
+
+	existing, err := suite.db.CountStatusReplies(ctx, newParent.ID)
+	suite.NoError(err)
+
+	replyIDs, err := suite.db.ReparentStatusReplies(ctx, targetStatus.ID, newParent)
+	suite.NoError(err)
+	suite.Len(replyIDs, 2)
+
+	// Old parent should have no replies now.
+	count, err := suite.db.CountStatusReplies(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Zero(count)
+
+	// New parent should have gained the replies.
+	count, err = suite.db.CountStatusReplies(ctx, newParent.ID)
+	suite.NoError(err)
+	suite.Equal(existing+2, count)
+
+	// Replies should all point at new parent.
+	for _, id := range replyIDs {
+		reply, err := suite.db.GetStatusByID(ctx, id)
+		suite.NoError(err)
+		suite.Equal(newParent.ID, reply.InReplyToID)
+		suite.Equal(newParent.AccountID, reply.InReplyToAccountID)
+	}
+}
+
+func (suite *StatusTestSuite) TestGetStatusInteractionCounts() {
+	ctx := context.Background()
+
+	for _, status := range suite.testStatuses {
+		counts, err := suite.db.GetStatusInteractionCounts(ctx, status.ID)
+		suite.NoError(err)
+
+		faves, err := suite.db.CountStatusFaves(ctx, status.ID)
+		suite.NoError(err)
+		suite.Equal(faves, counts.Faves, status.ID)
+
+		var bookmarks []*gtsmodel.StatusBookmark
+		err = suite.db.GetWhere(ctx,
+			[]db.Where{{Key: "status_id", Value: status.ID}},
+			&bookmarks,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(len(bookmarks), counts.Bookmarks, status.ID)
+
+		boosts, err := suite.db.CountStatusBoosts(ctx, status.ID)
+		suite.NoError(err)
+		suite.Equal(boosts, counts.Boosts, status.ID)
+
+		replies, err := suite.db.CountStatusReplies(ctx, status.ID)
+		suite.NoError(err)
+		suite.Equal(replies, counts.Replies, status.ID)
+	}
+}
+
+func (suite *StatusTestSuite) TestGetStatusInteractionCountsUpdated() {
+	ctx := context.Background()
+	status := suite.testStatuses["local_account_1_status_1"]
+	account := suite.testAccounts["local_account_2"]
+
+	// Load counts into the cache first.
+	before, err := suite.db.GetStatusInteractionCounts(ctx, status.ID)
+	suite.NoError(err)
+
+	faveID := id.NewULID()
+	err = suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       account.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		URI:             account.URI + "/liked/" + faveID,
+	})
+	suite.NoError(err)
+
+	err = suite.db.PutStatusBookmark(ctx, &gtsmodel.StatusBookmark{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+	})
+	suite.NoError(err)
+
+	// New fave + bookmark should be counted.
+	after, err := suite.db.GetStatusInteractionCounts(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal(before.Faves+1, after.Faves)
+	suite.Equal(before.Bookmarks+1, after.Bookmarks)
+	suite.Equal(before.Boosts, after.Boosts)
+	suite.Equal(before.Replies, after.Replies)
+
+	// Deleting the fave should uncount it.
+	err = suite.db.DeleteStatusFaveByID(ctx, faveID)
+	suite.NoError(err)
+
+	after, err = suite.db.GetStatusInteractionCounts(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal(before.Faves, after.Faves)
+	suite.Equal(before.Bookmarks+1, after.Bookmarks)
+}
+
+func (suite *StatusTestSuite) TestSwapStatusAttachments() {
+	ctx := context.Background()
+
+	// Take a copy of the status.
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["local_account_1_status_4"]
+	kept := suite.testAttachments["local_account_1_status_4_attachment_1"]
+	removed := suite.testAttachments["local_account_1_status_4_attachment_2"]
+	added := suite.testAttachments["local_account_1_unattached_1"]
+
+	removedIDs, err := suite.db.SwapStatusAttachments(ctx, status, []string{kept.ID, added.ID})
+	suite.NoError(err)
+	suite.Equal([]string{removed.ID}, removedIDs)
+	suite.Equal([]string{kept.ID, added.ID}, status.AttachmentIDs)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal([]string{kept.ID, added.ID}, dbStatus.AttachmentIDs)
+
+	// Added media should now be owned
+	// by the status, removed unattached.
+	dbAdded, err := suite.db.GetAttachmentByID(ctx, added.ID)
+	suite.NoError(err)
+	suite.Equal(status.ID, dbAdded.StatusID)
+
+	dbRemoved, err := suite.db.GetAttachmentByID(ctx, removed.ID)
+	suite.NoError(err)
+	suite.Empty(dbRemoved.StatusID)
+}
+
+func (suite *StatusTestSuite) TestSwapStatusAttachmentsMissingMedia() {
+	ctx := context.Background()
+
+	// Take a copy of the status.
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["local_account_1_status_4"]
+	oldIDs := status.AttachmentIDs
+	added := suite.testAttachments["local_account_1_unattached_1"]
+
+	// Swap in an existing and a nonexistent attachment,
+	// which should fail after attaching the existing one.
+	_, err := suite.db.SwapStatusAttachments(ctx, status, []string{added.ID, id.NewULID()})
+	suite.Error(err)
+	suite.Equal(oldIDs, status.AttachmentIDs)
+
+	// The status should be left with all its original
+	// media, none of which should've been unattached.
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal(oldIDs, dbStatus.AttachmentIDs)
+	suite.Len(dbStatus.Attachments, len(oldIDs))
+	for _, attachment := range dbStatus.Attachments {
+		suite.Equal(status.ID, attachment.StatusID)
+	}
+
+	// The partially attached media should be rolled back.
+	dbAdded, err := suite.db.GetAttachmentByID(ctx, added.ID)
+	suite.NoError(err)
+	suite.Empty(dbAdded.StatusID)
+}
+
 func (suite *StatusTestSuite) TestGetStatusChildren() {
 	targetStatus := suite.testStatuses["local_account_1_status_1"]
 	children, err := suite.db.GetStatusChildren(context.Background(), targetStatus.ID)
@@ -254,6 +429,39 @@ func (suite *StatusTestSuite) TestPutPopulatedStatus() {
 	)
 }
 
+func (suite *StatusTestSuite) TestGetExpiringStatuses() {
+	ctx := context.Background()
+
+	// No test statuses expire.
+	statuses, err := suite.db.GetExpiringStatuses(ctx)
+	suite.NoError(err)
+	suite.Empty(statuses)
+
+	// Set an expiry on one local
+	// and one remote status.
+	local := new(gtsmodel.Status)
+	*local = *suite.testStatuses["local_account_1_status_1"]
+	local.ExpiresAt = time.Now().Add(time.Hour)
+
+	remote := new(gtsmodel.Status)
+	*remote = *suite.testStatuses["remote_account_1_status_1"]
+	remote.ExpiresAt = time.Now().Add(time.Hour)
+
+	for _, status := range []*gtsmodel.Status{local, remote} {
+		if err := suite.db.UpdateStatus(ctx, status, "expires_at"); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Only the local status should be returned.
+	statuses, err = suite.db.GetExpiringStatuses(ctx)
+	suite.NoError(err)
+	if suite.Len(statuses, 1) {
+		suite.Equal(local.ID, statuses[0].ID)
+		suite.WithinDuration(local.ExpiresAt, statuses[0].ExpiresAt, time.Second)
+	}
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
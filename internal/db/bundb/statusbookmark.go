@@ -312,5 +312,6 @@ func (s *statusBookmarkDB) DeleteStatusBookmarksForStatus(ctx context.Context, s
 		return err
 	}
 	s.state.Caches.DB.StatusBookmark.Invalidate("StatusID", statusID)
+	s.state.Caches.DB.StatusInteractionCounts.Invalidate(statusID)
 	return nil
 }
@@ -302,6 +302,9 @@ func (s *statusFaveDB) DeleteStatusFaveByID(ctx context.Context, id string) erro
 
 		// Invalidate any cached status fave IDs for this status.
 		s.state.Caches.DB.StatusFaveIDs.Invalidate(statusID)
+
+		// Invalidate any cached interaction counts for this status.
+		s.state.Caches.DB.StatusInteractionCounts.Invalidate(statusID)
 	}
 
 	return nil
@@ -347,6 +350,9 @@ func (s *statusFaveDB) DeleteStatusFaves(ctx context.Context, targetAccountID st
 	// Invalidate any cached status fave IDs for this status ID.
 	s.state.Caches.DB.StatusFaveIDs.Invalidate(statusIDs...)
 
+	// Invalidate any cached interaction counts for this status ID.
+	s.state.Caches.DB.StatusInteractionCounts.Invalidate(statusIDs...)
+
 	return nil
 }
 
@@ -365,5 +371,8 @@ func (s *statusFaveDB) DeleteStatusFavesForStatus(ctx context.Context, statusID
 	// Invalidate any cached status fave IDs for this status.
 	s.state.Caches.DB.StatusFaveIDs.Invalidate(statusID)
 
+	// Invalidate any cached interaction counts for this status.
+	s.state.Caches.DB.StatusInteractionCounts.Invalidate(statusID)
+
 	return nil
 }
@@ -28,6 +28,10 @@ type Interaction interface {
 	GetInteractionApprovalByID(ctx context.Context, id string) (*gtsmodel.InteractionApproval, error)
 
 	// GetInteractionApprovalByID gets one approval with the given uri.
+	//
+	// Approvals of pre-approved interactions aren't stored, so if none is
+	// found with the uri, but a local interaction was pre-approved by it,
+	// an (unstored) approval is synthesized from that interaction instead.
 	GetInteractionApprovalByURI(ctx context.Context, id string) (*gtsmodel.InteractionApproval, error)
 
 	// PopulateInteractionApproval ensures that the approval's struct fields are populated.
@@ -36,6 +40,85 @@ type Interaction interface {
 	// PutInteractionApproval puts a new approval in the database.
 	PutInteractionApproval(ctx context.Context, approval *gtsmodel.InteractionApproval) error
 
+	// GetExpiringInteractionApprovals gets all approvals sent
+	// by local accounts that have an expiry time set on them.
+	GetExpiringInteractionApprovals(ctx context.Context) ([]*gtsmodel.InteractionApproval, error)
+
+	// GetInteractionApprovalsByAccountID gets all approvals sent by
+	// the given (local) account ID, oldest first. Used for exports.
+	GetInteractionApprovalsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.InteractionApproval, error)
+
+	// GetInteractionApprovalsByInteractionURI gets all approvals
+	// of the interaction with the given URI, oldest first.
+	GetInteractionApprovalsByInteractionURI(ctx context.Context, interactionURI string) ([]*gtsmodel.InteractionApproval, error)
+
+	// GetInteractionURIsWithDuplicateApprovals gets the URIs of
+	// all interactions that have more than one approval stored.
+	GetInteractionURIsWithDuplicateApprovals(ctx context.Context) ([]string, error)
+
+	// PutInteractionRejection puts a new rejection in the database.
+	PutInteractionRejection(ctx context.Context, rejection *gtsmodel.InteractionRejection) error
+
+	// DeleteInteractionRejectionsByAccountID deletes all
+	// rejections recorded by the given (local) account ID.
+	DeleteInteractionRejectionsByAccountID(ctx context.Context, accountID string) error
+
+	// DeleteInteractionRejectionsForStatus deletes all rejections of the given
+	// status itself (ie., as a reply or boost), and of faves and boosts of it.
+	// This must be called before the status' faves and boosts are deleted.
+	DeleteInteractionRejectionsForStatus(ctx context.Context, statusID string) error
+
+	// CountInteractionReasons counts the reason codes given on approvals
+	// and rejections by the given (local) account ID, returning counts of
+	// each reason for approvals and rejections respectively. Approvals
+	// and rejections without a reason code are not counted.
+	CountInteractionReasons(ctx context.Context, accountID string) (map[gtsmodel.InteractionReason]int, map[gtsmodel.InteractionReason]int, error)
+
 	// DeleteInteractionApprovalByID deletes one approval with the given ID.
 	DeleteInteractionApprovalByID(ctx context.Context, id string) error
+
+	// DeleteInteractionApprovalsByInteractingAccountID deletes all approvals
+	// of interactions performed by the given (interacting) account ID,
+	// returning the IDs of the deleted approvals.
+	DeleteInteractionApprovalsByInteractingAccountID(ctx context.Context, accountID string) ([]string, error)
+
+	// DeleteInteractionApprovalsForStatus deletes all approvals of the given
+	// status itself (ie., as a reply or boost), and of faves and boosts of it,
+	// returning the IDs of the deleted approvals. This must be called before
+	// the status' faves and boosts are deleted.
+	DeleteInteractionApprovalsForStatus(ctx context.Context, statusID string) ([]string, error)
+
+	// DeletePendingInteractionsForStatus deletes all faves targeting the given
+	// status ID that are still pending approval, returning the (barebones) deleted
+	// faves, along with all pending statuses (ie., replies and boosts) targeting it.
+	// The statuses are NOT deleted, it's up to the caller to wipe them.
+	DeletePendingInteractionsForStatus(ctx context.Context, statusID string) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error)
+
+	// DeletePendingInteractionsFromDomains deletes all faves targeting statuses
+	// owned by the given target account ID that are still pending approval, and
+	// were made by accounts on one of the given domains, returning the (barebones)
+	// deleted faves, along with all such pending statuses (ie., replies and boosts).
+	// The statuses are NOT deleted, it's up to the caller to wipe them.
+	DeletePendingInteractionsFromDomains(ctx context.Context, targetAccountID string, domains []string) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error)
+
+	// GetPendingInteractionsForThread gets all interactions (faves, replies
+	// and boosts) targeting statuses in the thread with the given ID that
+	// are still pending approval, oldest first, returning the faves and
+	// statuses (ie., replies and boosts) separately.
+	GetPendingInteractionsForThread(ctx context.Context, threadID string) ([]*gtsmodel.StatusFave, []*gtsmodel.Status, error)
+
+	// CountPendingInteractions counts interactions (replies, boosts and faves)
+	// by the given (interacting) account ID that target statuses owned by the
+	// given target account ID, and which are still pending approval.
+	CountPendingInteractions(ctx context.Context, accountID string, targetAccountID string) (int, error)
+
+	// CountPendingInteractionsForAccount counts all interactions (replies, boosts
+	// and faves) targeting statuses owned by the given target account ID, which
+	// are still pending approval.
+	CountPendingInteractionsForAccount(ctx context.Context, targetAccountID string) (int, error)
+
+	// GetAccountIDsWithPendingInteractions gets the IDs of all accounts
+	// owning statuses targeted by interactions (replies, boosts and
+	// faves) which are still pending approval.
+	GetAccountIDsWithPendingInteractions(ctx context.Context) ([]string, error)
 }
@@ -27,6 +27,10 @@ type Marker interface {
 	// GetMarker gets one marker with the given timeline name.
 	GetMarker(ctx context.Context, accountID string, name gtsmodel.MarkerName) (*gtsmodel.Marker, error)
 
+	// GetMarkersByLastReadID gets all markers with the given timeline
+	// name whose last read ID is the given ID, for any account.
+	GetMarkersByLastReadID(ctx context.Context, name gtsmodel.MarkerName, lastReadID string) ([]*gtsmodel.Marker, error)
+
 	// UpdateMarker updates the given marker.
 	UpdateMarker(ctx context.Context, marker *gtsmodel.Marker) error
 }
@@ -39,6 +39,21 @@ type Media interface {
 	// UpdateAttachment will update the given attachment in the database.
 	UpdateAttachment(ctx context.Context, media *gtsmodel.MediaAttachment, columns ...string) error
 
+	// UnattachAttachmentsForStatus unattaches all media attachments owned by the status
+	// author from the given status, making them available for reattachment again. The
+	// unattached attachments are returned in the order of the status's AttachmentIDs.
+	UnattachAttachmentsForStatus(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.MediaAttachment, error)
+
+	// GetAttachmentIDsForStatus gets the IDs of all
+	// media attachments with the given status ID set.
+	GetAttachmentIDsForStatus(ctx context.Context, statusID string) ([]string, error)
+
+	// DeleteAttachmentsForStatus deletes all media attachments with the given status
+	// ID set from the database in one transaction, returning the deleted attachments
+	// so that their files can be removed from storage. The status itself is left as
+	// is, as this is intended for use when the status is being deleted too.
+	DeleteAttachmentsForStatus(ctx context.Context, statusID string) ([]*gtsmodel.MediaAttachment, error)
+
 	// DeleteAttachment deletes the attachment with given ID from the database.
 	DeleteAttachment(ctx context.Context, id string) error
 
@@ -31,6 +31,10 @@ type Mention interface {
 	// GetMentions gets multiple mentions.
 	GetMentions(ctx context.Context, ids []string) ([]*gtsmodel.Mention, error)
 
+	// GetMentionIDsForStatus gets the IDs of all
+	// mentions with the given status ID set.
+	GetMentionIDsForStatus(ctx context.Context, statusID string) ([]string, error)
+
 	// PopulateMention ensures that all sub-models of a mention are populated (e.g. accounts).
 	PopulateMention(ctx context.Context, mention *gtsmodel.Mention) error
 
@@ -39,4 +43,8 @@ type Mention interface {
 
 	// DeleteMentionByID will delete mention with given ID from the database.
 	DeleteMentionByID(ctx context.Context, id string) error
+
+	// DeleteMentionsForStatus deletes all mentions with the given status ID set
+	// from the database in one go. Having no mentions to delete is not an error.
+	DeleteMentionsForStatus(ctx context.Context, statusID string) error
 }
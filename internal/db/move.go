@@ -54,6 +54,14 @@ type Move interface {
 	// Updates specific columns if provided, all columns if not.
 	UpdateMove(ctx context.Context, move *gtsmodel.Move, columns ...string) error
 
-	// DeleteMoveByID deletes a move with the given internal ID.
+	// DeleteMoveByID deletes a move with the given internal ID,
+	// along with any events recorded for that move.
 	DeleteMoveByID(ctx context.Context, id string) error
+
+	// GetMoveEvents gets all events recorded
+	// for the given Move ID, oldest first.
+	GetMoveEvents(ctx context.Context, moveID string) ([]*gtsmodel.MoveEvent, error)
+
+	// PutMoveEvent puts the given Move event in the database.
+	PutMoveEvent(ctx context.Context, event *gtsmodel.MoveEvent) error
 }
@@ -70,4 +70,10 @@ type Notification interface {
 	// the given statusID. This function is useful when a status has been deleted,
 	// and so notifications relating to that status must also be deleted.
 	DeleteNotificationsForStatus(ctx context.Context, statusID string) error
+
+	// DeleteNotificationsForStatuses deletes all notifications that relate to
+	// any of the given statusIDs, returning the (deduplicated) IDs of accounts
+	// targeted by the deleted notifications. This function is useful when many
+	// statuses have been deleted at once, eg., when wiping an account's statuses.
+	DeleteNotificationsForStatuses(ctx context.Context, statusIDs []string) ([]string, error)
 }
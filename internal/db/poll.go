@@ -42,6 +42,12 @@ type Poll interface {
 	// DeletePollByID deletes the Poll with given ID from the database.
 	DeletePollByID(ctx context.Context, id string) error
 
+	// PutPollArchive puts the given final poll tallies in the database.
+	PutPollArchive(ctx context.Context, archive *gtsmodel.PollArchive) error
+
+	// GetPollArchiveByPollID fetches the archived final tallies of the (deleted) poll with given ID.
+	GetPollArchiveByPollID(ctx context.Context, pollID string) (*gtsmodel.PollArchive, error)
+
 	// GetPollVoteByID gets the PollVote with given ID from the database.
 	GetPollVoteByID(ctx context.Context, id string) (*gtsmodel.PollVote, error)
 
@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
 )
 
 // Status contains functions for getting statuses, creating statuses, and checking various other fields on statuses.
@@ -49,27 +50,54 @@ type Status interface {
 	// UpdateStatus updates one status in the database.
 	UpdateStatus(ctx context.Context, status *gtsmodel.Status, columns ...string) error
 
+	// SwapStatusAttachments replaces the attachments of the given status with
+	// those of the given IDs in one transaction, attaching added attachments to
+	// the status and unattaching removed ones, returning the unattached IDs. If an
+	// added attachment does not exist, an error is returned and nothing changes.
+	SwapStatusAttachments(ctx context.Context, status *gtsmodel.Status, attachmentIDs []string) ([]string, error)
+
 	// DeleteStatusByID deletes one status from the database.
 	DeleteStatusByID(ctx context.Context, id string) error
 
+	// GetBoostIDsByAccount gets the IDs of all
+	// boosts authored by the given account ID.
+	GetBoostIDsByAccount(ctx context.Context, accountID string) ([]string, error)
+
 	// GetStatuses gets a slice of statuses corresponding to the given status IDs.
 	GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Status, error)
 
+	// GetStatuses fetches a page of all statuses
+	// in the database, both local and remote.
+	GetStatuses(ctx context.Context, page *paging.Page) ([]*gtsmodel.Status, error)
+
 	// GetStatusesUsingEmoji fetches all status models using emoji with given ID stored in their 'emojis' column.
 	GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error)
 
+	// GetExpiringStatuses gets all local
+	// statuses that have an expiry time set.
+	GetExpiringStatuses(ctx context.Context) ([]*gtsmodel.Status, error)
+
 	// GetStatusReplies returns the *direct* (i.e. in_reply_to_id column) replies to this status ID, ordered DESC by ID.
 	GetStatusReplies(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
 
 	// CountStatusReplies returns the number of stored *direct* (i.e. in_reply_to_id column) replies to this status ID.
 	CountStatusReplies(ctx context.Context, statusID string) (int, error)
 
+	// ReparentStatusReplies updates all *direct* replies to the status with given ID
+	// so that they instead reply to the given new parent status, returning the IDs
+	// of the statuses that were updated. Note the in_reply_to_uri column is left as-is.
+	ReparentStatusReplies(ctx context.Context, statusID string, parent *gtsmodel.Status) ([]string, error)
+
 	// GetStatusBoosts returns all statuses whose boost_of_id column refer to given status ID.
 	GetStatusBoosts(ctx context.Context, statusID string) ([]*gtsmodel.Status, error)
 
 	// CountStatusBoosts returns the number of stored boosts for status ID.
 	CountStatusBoosts(ctx context.Context, statusID string) (int, error)
 
+	// GetStatusInteractionCounts returns the numbers of stored faves, bookmarks,
+	// boosts and *direct* replies for status ID, counted together in one query.
+	GetStatusInteractionCounts(ctx context.Context, statusID string) (gtsmodel.StatusInteractionCounts, error)
+
 	// IsStatusBoostedBy checks whether the given status ID is boosted by account ID.
 	IsStatusBoostedBy(ctx context.Context, statusID string, accountID string) (bool, error)
 
@@ -90,4 +118,9 @@ type Status interface {
 	// MaxDirectStatusID, and expects to eventually return the status with that ID.
 	// It is used only by the conversation advanced migration.
 	GetDirectStatusIDsBatch(ctx context.Context, minID string, maxIDInclusive string, count int) ([]string, error)
+
+	// GetAccountDirectStatusIDsBatch returns up to count IDs, strictly greater than minID
+	// and in ascending order, of DM statuses authored by or mentioning the given account.
+	// It is used when rebuilding the account's conversations.
+	GetAccountDirectStatusIDsBatch(ctx context.Context, accountID string, minID string, count int) ([]string, error)
 }
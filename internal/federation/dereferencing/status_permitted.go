@@ -19,9 +19,12 @@ package dereferencing
 
 import (
 	"context"
+	"errors"
 	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -62,6 +65,7 @@ func (d *Dereferencer) isPermittedStatus(
 		// Status is a reply, check permissivity.
 		permitted, err = d.isPermittedReply(ctx,
 			requestUser,
+			existing,
 			status,
 		)
 		if err != nil {
@@ -72,6 +76,7 @@ func (d *Dereferencer) isPermittedStatus(
 		// Status is a boost, check permissivity.
 		permitted, err = d.isPermittedBoost(ctx,
 			requestUser,
+			existing,
 			status,
 		)
 		if err != nil {
@@ -99,17 +104,28 @@ func (d *Dereferencer) isPermittedStatus(
 func (d *Dereferencer) isPermittedReply(
 	ctx context.Context,
 	requestUser string,
+	existing *gtsmodel.Status,
 	status *gtsmodel.Status,
 ) (bool, error) {
 	// Extract reply from status.
 	inReplyTo := status.InReplyTo
 
 	if inReplyTo.BoostOfID != "" {
-		// We do not permit replies to
-		// boost wrapper statuses. (this
-		// shouldn't be able to happen).
-		log.Info(ctx, "rejecting reply to boost wrapper status")
-		return false, nil
+		// Status replies to a boost wrapper,
+		// so unwrap it: the reply is really to
+		// the boosted status, and so the author
+		// of that status must be the approver.
+		var err error
+		inReplyTo, err = d.unwrapReplyToBoost(ctx, status)
+		if err != nil {
+			return false, err
+		}
+
+		if inReplyTo == nil {
+			// Boosted status
+			// no longer exists.
+			return false, nil
+		}
 	}
 
 	// Check visibility of local
@@ -131,10 +147,25 @@ func (d *Dereferencer) isPermittedReply(
 		}
 	}
 
+	if carryOverApproval(existing, status) {
+		// This is an edit of a reply that
+		// was already approved, which stands
+		// regardless of the current policy.
+		return true, nil
+	}
+
+	// Gather names of hashtags used by the
+	// reply, which may pre-approve it.
+	tagNames := make([]string, 0, len(status.Tags))
+	for _, tag := range status.Tags {
+		tagNames = append(tagNames, tag.Name)
+	}
+
 	// Check interaction policy of inReplyTo.
-	replyable, err := d.intFilter.StatusReplyable(ctx,
+	replyable, err := d.intFilter.StatusReplyableWithTags(ctx,
 		status.Account,
 		inReplyTo,
+		tagNames,
 	)
 	if err != nil {
 		err := gtserror.Newf("error checking status replyability: %w", err)
@@ -180,7 +211,19 @@ func (d *Dereferencer) isPermittedReply(
 		if inReplyTo.IsLocal() {
 			status.PendingApproval = util.Ptr(true)
 			status.PreApproved = replyable.MatchedOnCollection()
-			return true, nil
+
+			if status.PreApproved {
+				// Won't sit in approval queue.
+				return true, nil
+			}
+
+			// Ensure replier hasn't already flooded
+			// the reply-ee's queue of pending approvals.
+			return d.withinPendingLimit(ctx,
+				existing,
+				status.Account,
+				inReplyTo.AccountID,
+			)
 		}
 
 		return false, nil
@@ -209,9 +252,87 @@ func (d *Dereferencer) isPermittedReply(
 	return true, nil
 }
 
+// carryOverApproval carries over the approval of the existing
+// version of a reply to the latest version of it, returning
+// whether it did so. This is only done if the existing version
+// was approved, the latest version still replies to the same
+// status, and it doesn't claim a different approval. Remote
+// edits tend not to include the approval we stored ourselves,
+// and shouldn't put an approved reply back pending approval.
+func carryOverApproval(existing *gtsmodel.Status, status *gtsmodel.Status) bool {
+	if existing == nil ||
+		existing.ApprovedByURI == "" ||
+		util.PtrOrValue(existing.PendingApproval, false) {
+		// Existing
+		// not approved.
+		return false
+	}
+
+	if existing.InReplyToURI != status.InReplyToURI {
+		// Reply has been moved
+		// to another status.
+		return false
+	}
+
+	if status.ApprovedByURI != "" &&
+		status.ApprovedByURI != existing.ApprovedByURI {
+		// Claims another approval,
+		// which needs checking.
+		return false
+	}
+
+	status.ApprovedByURI = existing.ApprovedByURI
+	status.PendingApproval = util.Ptr(false)
+	return true
+}
+
+// unwrapReplyToBoost updates the given reply status, which
+// replies to a boost wrapper status, to instead reply to the
+// original boosted status, returning the boosted status. The
+// boost wrapper replied to is kept as InReplyToBoostID. If
+// the boosted status can't be found, nil is returned.
+func (d *Dereferencer) unwrapReplyToBoost(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) (*gtsmodel.Status, error) {
+	boost := status.InReplyTo
+
+	boostOf := boost.BoostOf
+	if boostOf == nil {
+		// Boosted status not set, fetch from the db.
+		var err error
+		boostOf, err = d.state.DB.GetStatusByID(ctx, boost.BoostOfID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("db error getting boosted status %s: %w", boost.BoostOfID, err)
+		}
+
+		if boostOf == nil {
+			return nil, nil
+		}
+	}
+
+	log.Debugf(ctx,
+		"reply %s to boost %s routed to boosted status %s",
+		status.URI, boost.URI, boostOf.URI,
+	)
+
+	// Point the reply at the boosted
+	// status instead of the boost,
+	// keeping note of the boost.
+	status.InReplyToBoostID = boost.ID
+	status.InReplyTo = boostOf
+	status.InReplyToID = boostOf.ID
+	status.InReplyToURI = boostOf.URI
+	status.InReplyToAccountID = boostOf.AccountID
+	status.InReplyToAccount = boostOf.Account
+
+	return boostOf, nil
+}
+
 func (d *Dereferencer) isPermittedBoost(
 	ctx context.Context,
 	requestUser string,
+	existing *gtsmodel.Status,
 	status *gtsmodel.Status,
 ) (bool, error) {
 
@@ -293,7 +414,19 @@ func (d *Dereferencer) isPermittedBoost(
 		if boostOf.IsLocal() {
 			status.PendingApproval = util.Ptr(true)
 			status.PreApproved = boostable.MatchedOnCollection()
-			return true, nil
+
+			if status.PreApproved {
+				// Won't sit in approval queue.
+				return true, nil
+			}
+
+			// Ensure booster hasn't already flooded
+			// the boost-ee's queue of pending approvals.
+			return d.withinPendingLimit(ctx,
+				existing,
+				status.Account,
+				boostOf.AccountID,
+			)
 		}
 
 		return false, nil
@@ -322,6 +455,53 @@ func (d *Dereferencer) isPermittedBoost(
 	return true, nil
 }
 
+// withinPendingLimit returns whether the given account is
+// permitted to create another interaction pending approval
+// by the target account, ie., it doesn't already have the
+// configured maximum number of pending interactions with it.
+//
+// An existing version of the interaction that is itself still
+// pending is already part of that count, so a refetch or edit
+// of it is always permitted rather than judged to exceed it.
+func (d *Dereferencer) withinPendingLimit(
+	ctx context.Context,
+	existing *gtsmodel.Status,
+	account *gtsmodel.Account,
+	targetAccountID string,
+) (bool, error) {
+	limit := config.GetInstanceInteractionPendingLimit()
+	if limit <= 0 {
+		// No limit set.
+		return true, nil
+	}
+
+	if existing != nil &&
+		util.PtrOrValue(existing.PendingApproval, false) {
+		// Already stored and counted
+		// as pending, not a new one.
+		return true, nil
+	}
+
+	pending, err := d.state.DB.CountPendingInteractions(ctx,
+		account.ID,
+		targetAccountID,
+	)
+	if err != nil {
+		err := gtserror.Newf("db error counting pending interactions: %w", err)
+		return false, err
+	}
+
+	if pending >= limit {
+		log.Infof(ctx,
+			"rejecting interaction from %s: already has %d interactions pending approval",
+			account.URI, pending,
+		)
+		return false, nil
+	}
+
+	return true, nil
+}
+
 // validateApprovedBy dereferences the activitystreams Accept at
 // the specified IRI, and checks the Accept for validity against
 // the provided expectedObject and expectedActor.
@@ -20,12 +20,18 @@ package dereferencing_test
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -229,6 +235,256 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithNonMatchingURI() {
 	suite.Nil(fetchedStatus)
 }
 
+// putRemoteReply puts a public reply by brand_new_person
+// with the given URI, replying to the given inReplyToURI,
+// into the mock http client so that it can be dereferenced.
+func (suite *StatusTestSuite) putRemoteReply(uri string, inReplyToURI string) {
+	note := testrig.NewAPNote(
+		testrig.URLMustParse(uri),
+		testrig.URLMustParse(uri),
+		testrig.TimeMustParse("2022-07-13T12:13:12+02:00"),
+		"please approve me!",
+		"",
+		testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person"),
+		[]*url.URL{testrig.URLMustParse(pub.PublicActivityPubIRI)},
+		nil,
+		false,
+		nil,
+		nil,
+		nil,
+	)
+	inReplyToProp := streams.NewActivityStreamsInReplyToProperty()
+	inReplyToProp.AppendIRI(testrig.URLMustParse(inReplyToURI))
+	note.SetActivityStreamsInReplyTo(inReplyToProp)
+	suite.client.TestRemoteStatuses[uri] = note
+}
+
+func (suite *StatusTestSuite) TestDereferenceStatusReplyToBoost() {
+	var (
+		ctx             = context.Background()
+		fetchingAccount = suite.testAccounts["local_account_1"]
+
+		// This status requires approval for
+		// replies from anyone but the author.
+		boostOf = testrig.NewTestStatuses()["local_account_2_status_3"]
+	)
+
+	// Admin boosts the status.
+	boost := testrig.NewTestStatuses()["admin_account_status_4"]
+	boost.ID = "01JA9WM2N1J6T7Q6D9AJ1V4K2B"
+	boost.URI = "http://localhost:8080/users/admin/statuses/01JA9WM2N1J6T7Q6D9AJ1V4K2B"
+	boost.URL = "http://localhost:8080/@admin/statuses/01JA9WM2N1J6T7Q6D9AJ1V4K2B"
+	boost.BoostOfID = boostOf.ID
+	boost.BoostOfAccountID = boostOf.AccountID
+	if err := suite.db.PutStatus(ctx, boost); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Remote account replies to the boost.
+	const replyURI = "https://unknown-instance.com/users/brand_new_person/statuses/01JA9WMCWGQ4X4AK0T7XPHQK4A"
+	suite.putRemoteReply(replyURI, boost.URI)
+
+	status, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(replyURI),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should be routed to the boosted
+	// status, making its author the approver.
+	suite.Equal(boostOf.ID, status.InReplyToID)
+	suite.Equal(boostOf.URI, status.InReplyToURI)
+	suite.Equal(boostOf.AccountID, status.InReplyToAccountID)
+	suite.Equal(boost.ID, status.InReplyToBoostID)
+	suite.True(*status.PendingApproval)
+
+	// Same should go for the stored version.
+	dbStatus, err := suite.db.GetStatusByURI(ctx, replyURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(boostOf.ID, dbStatus.InReplyToID)
+	suite.Equal(boostOf.AccountID, dbStatus.InReplyToAccountID)
+	suite.Equal(boost.ID, dbStatus.InReplyToBoostID)
+	suite.True(*dbStatus.PendingApproval)
+}
+
+func (suite *StatusTestSuite) TestDereferenceStatusPendingLimit() {
+	var (
+		ctx             = context.Background()
+		fetchingAccount = suite.testAccounts["local_account_1"]
+
+		// This status requires approval for
+		// replies from anyone but the author.
+		inReplyTo = testrig.NewTestStatuses()["local_account_2_status_3"]
+	)
+
+	// Allow only one pending
+	// interaction per account.
+	config.SetInstanceInteractionPendingLimit(1)
+
+	// Put two replies to inReplyTo from the
+	// same remote account in the mock client.
+	replyURIs := []string{
+		"https://unknown-instance.com/users/brand_new_person/statuses/01JA8F8JGBW7J8XWQ9TSKV0P6C",
+		"https://unknown-instance.com/users/brand_new_person/statuses/01JA8F8SGT1Z2S3HVS5G2M3Y7N",
+	}
+	for _, uri := range replyURIs {
+		suite.putRemoteReply(uri, inReplyTo.URI)
+	}
+
+	// First reply should be
+	// stored pending approval.
+	status, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(replyURIs[0]),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*status.PendingApproval)
+
+	// Second reply should be rejected, as the
+	// account already has 1 pending interaction.
+	status, _, err = suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(replyURIs[1]),
+	)
+	suite.True(gtserror.NotPermitted(err))
+	suite.Nil(status)
+
+	// Second reply should not be in the db.
+	_, err = suite.db.GetStatusByURI(ctx, replyURIs[1])
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *StatusTestSuite) TestDereferenceStatusPendingLimitEdit() {
+	var (
+		ctx             = context.Background()
+		fetchingAccount = suite.testAccounts["local_account_1"]
+
+		// This status requires approval for
+		// replies from anyone but the author.
+		inReplyTo = testrig.NewTestStatuses()["local_account_2_status_3"]
+	)
+
+	// Allow only one pending
+	// interaction per account.
+	config.SetInstanceInteractionPendingLimit(1)
+
+	// Remote account replies, which
+	// gets stored pending approval.
+	const replyURI = "https://unknown-instance.com/users/brand_new_person/statuses/01JAB6R3E1M8N5V0P9QK2TZ4WC"
+	suite.putRemoteReply(replyURI, inReplyTo.URI)
+
+	reply, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(replyURI),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*reply.PendingApproval)
+
+	// Remote account edits the reply. It's
+	// already the one pending interaction,
+	// so shouldn't be counted against itself.
+	note := suite.client.TestRemoteStatuses[replyURI]
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("please approve me, edited!")
+	note.SetActivityStreamsContent(content)
+
+	reply, _, err = suite.dereferencer.RefreshStatus(ctx,
+		fetchingAccount.Username,
+		reply,
+		note,
+		nil,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("please approve me, edited!", reply.Content)
+	suite.True(*reply.PendingApproval)
+
+	// Edited reply should still be in the db.
+	dbReply, err := suite.db.GetStatusByURI(ctx, replyURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("please approve me, edited!", dbReply.Content)
+}
+
+func (suite *StatusTestSuite) TestDereferenceStatusApprovedReplyEdit() {
+	var (
+		ctx             = context.Background()
+		fetchingAccount = suite.testAccounts["local_account_1"]
+
+		// This status requires approval for
+		// replies from anyone but the author.
+		inReplyTo = testrig.NewTestStatuses()["local_account_2_status_3"]
+	)
+
+	// Remote account replies, which
+	// gets stored pending approval.
+	const replyURI = "https://unknown-instance.com/users/brand_new_person/statuses/01JAB3ZK6N7G1S2W1D5Q0X8VHT"
+	suite.putRemoteReply(replyURI, inReplyTo.URI)
+
+	reply, _, err := suite.dereferencer.GetStatusByURI(ctx,
+		fetchingAccount.Username,
+		testrig.URLMustParse(replyURI),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*reply.PendingApproval)
+
+	// Approve the reply, as
+	// approveReply would do.
+	const approvedByURI = "http://localhost:8080/users/1happyturtle/accepts/01JAB40C6W3PZ5K0E0CZ2HF9XS"
+	reply.PendingApproval = util.Ptr(false)
+	reply.ApprovedByURI = approvedByURI
+	if err := suite.db.UpdateStatus(ctx, reply,
+		"pending_approval",
+		"approved_by_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Remote account edits the reply,
+	// without including the approval.
+	note := suite.client.TestRemoteStatuses[replyURI]
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("please keep approving me!")
+	note.SetActivityStreamsContent(content)
+
+	reply, _, err = suite.dereferencer.RefreshStatus(ctx,
+		fetchingAccount.Username,
+		reply,
+		note,
+		nil,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Edit should have gone through,
+	// with the approval still in place.
+	suite.Equal("please keep approving me!", reply.Content)
+	suite.False(*reply.PendingApproval)
+	suite.Equal(approvedByURI, reply.ApprovedByURI)
+
+	// Same should go for the stored version.
+	dbReply, err := suite.db.GetStatusByURI(ctx, replyURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("please keep approving me!", dbReply.Content)
+	suite.False(*dbReply.PendingApproval)
+	suite.Equal(approvedByURI, dbReply.ApprovedByURI)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
@@ -38,13 +38,51 @@ func (f *federatingDB) GetAccept(
 	ctx context.Context,
 	acceptIRI *url.URL,
 ) (vocab.ActivityStreamsAccept, error) {
-	approval, err := f.state.DB.GetInteractionApprovalByURI(ctx, acceptIRI.String())
+	approval, err := f.getApprovalByAcceptIRI(ctx, acceptIRI)
 	if err != nil {
 		return nil, err
 	}
 	return f.converter.InteractionApprovalToASAccept(ctx, approval)
 }
 
+// getApprovalByAcceptIRI resolves the interaction approval
+// at the given (local) Accept IRI. IRIs keyed on account ID
+// are resolved using the approval ID, so that they remain
+// valid regardless of the accepting account's username.
+func (f *federatingDB) getApprovalByAcceptIRI(
+	ctx context.Context,
+	acceptIRI *url.URL,
+) (*gtsmodel.InteractionApproval, error) {
+	accountID, approvalID, byID, err := uris.ParseAcceptsPath(acceptIRI)
+	if err != nil {
+		return nil, gtserror.Newf("error parsing accept iri %s: %w", acceptIRI, err)
+	}
+
+	if !byID {
+		// Older username-based IRI,
+		// just look up by exact URI.
+		return f.state.DB.GetInteractionApprovalByURI(ctx, acceptIRI.String())
+	}
+
+	approval, err := f.state.DB.GetInteractionApprovalByID(ctx, approvalID)
+	if errors.Is(err, db.ErrNoEntries) {
+		// May be a pre-approval, which aren't
+		// stored, so look up by full URI instead.
+		approval, err = f.state.DB.GetInteractionApprovalByURI(ctx, acceptIRI.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if approval.AccountID != accountID {
+		// Approval doesn't belong
+		// to account given in IRI.
+		return nil, db.ErrNoEntries
+	}
+
+	return approval, nil
+}
+
 func (f *federatingDB) Accept(ctx context.Context, accept vocab.ActivityStreamsAccept) error {
 	if log.Level() >= level.DEBUG {
 		i, err := marshalItem(accept)
@@ -0,0 +1,152 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AcceptTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *AcceptTestSuite) putApproval(
+	ctx context.Context,
+	approvalID string,
+	uri string,
+) *gtsmodel.InteractionApproval {
+	var (
+		account    = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+	)
+
+	approval := &gtsmodel.InteractionApproval{
+		ID:                   approvalID,
+		AccountID:            account.ID,
+		InteractingAccountID: interacter.ID,
+		InteractionURI:       interacter.URI + "/statuses/" + approvalID,
+		InteractionType:      gtsmodel.InteractionReply,
+		URI:                  uri,
+	}
+
+	if err := suite.db.PutInteractionApproval(ctx, approval); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return approval
+}
+
+func (suite *AcceptTestSuite) TestGetAcceptByAccountIDURI() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		approvalID = "01JA9Q6B0S2N6Q3DX0X6HN6Y4Z"
+		approval   = suite.putApproval(ctx,
+			approvalID,
+			uris.GenerateURIForAcceptByAccountID(account.ID, approvalID),
+		)
+	)
+
+	// ID-based URI should be used, not username.
+	suite.Equal("http://localhost:8080/users/01F8MH1H7YV1Z7D2C8K2730QBF/accepts/01JA9Q6B0S2N6Q3DX0X6HN6Y4Z", approval.URI)
+
+	accept, err := suite.federatingDB.GetAccept(ctx, testrig.URLMustParse(approval.URI))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Accept should be the stored approval.
+	suite.Equal(approval.URI, ap.GetJSONLDId(accept).String())
+
+	// Same approval ID keyed on the
+	// wrong account should not resolve.
+	wrongURI := uris.GenerateURIForAcceptByAccountID(suite.testAccounts["local_account_2"].ID, approvalID)
+	_, err = suite.federatingDB.GetAccept(ctx, testrig.URLMustParse(wrongURI))
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *AcceptTestSuite) TestGetAcceptByUsernameURI() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		approvalID = "01JA9Q8W4M3QH7A3AFH7AVJ9BT"
+		approval   = suite.putApproval(ctx,
+			approvalID,
+			uris.GenerateURIForAccept(account.Username, approvalID),
+		)
+	)
+
+	// Older username-based URIs should still resolve.
+	accept, err := suite.federatingDB.GetAccept(ctx, testrig.URLMustParse(approval.URI))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(approval.URI, ap.GetJSONLDId(accept).String())
+}
+
+func (suite *AcceptTestSuite) TestGetAcceptPreApproved() {
+	var (
+		ctx        = context.Background()
+		account    = suite.testAccounts["local_account_1"]
+		replied    = suite.testStatuses["local_account_1_status_1"]
+		approvalID = "01JAB2M5V7F0R6C4P1XKQ8T3NW"
+		acceptURI  = uris.GenerateURIForAcceptByAccountID(account.ID, approvalID)
+	)
+
+	// Put a reply approved by an
+	// Accept with no stored approval,
+	// as for a pre-approved reply.
+	reply := new(gtsmodel.Status)
+	*reply = *suite.testStatuses["remote_account_1_status_1"]
+	reply.ID = id.NewULID()
+	reply.URI = reply.AccountURI + "/statuses/" + reply.ID
+	reply.InReplyToID = replied.ID
+	reply.InReplyToURI = replied.URI
+	reply.InReplyToAccountID = account.ID
+	reply.AttachmentIDs = nil
+	reply.ApprovedByURI = acceptURI
+	if err := suite.db.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The Accept should still resolve,
+	// synthesized from the reply.
+	accept, err := suite.federatingDB.GetAccept(ctx, testrig.URLMustParse(acceptURI))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(acceptURI, ap.GetJSONLDId(accept).String())
+
+	objects := ap.GetObjectIRIs(accept)
+	if suite.Len(objects, 1) {
+		suite.Equal(reply.URI, objects[0].String())
+	}
+}
+
+func TestAcceptTestSuite(t *testing.T) {
+	suite.Run(t, new(AcceptTestSuite))
+}
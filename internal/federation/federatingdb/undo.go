@@ -30,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
 )
 
 func (f *federatingDB) Undo(ctx context.Context, undo vocab.ActivityStreamsUndo) error {
@@ -123,12 +124,38 @@ func (f *federatingDB) undoFollow(
 		return fmt.Errorf("undoFollow: db error removing follow: %w", err)
 	}
 
-	// Delete any existing follow request with this URI.
-	if err := f.state.DB.DeleteFollowRequestByURI(ctx, follow.URI); err != nil && !errors.Is(err, db.ErrNoEntries) {
+	// Get any existing follow request with this URI.
+	followReq, err := f.state.DB.GetFollowRequestByURI(
+		gtscontext.SetBarebones(ctx),
+		follow.URI,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("undoFollow: db error getting follow request: %w", err)
+	}
+
+	if followReq == nil {
+		// No follow request
+		// to withdraw, done.
+		log.Debug(ctx, "Follow undone")
+		return nil
+	}
+
+	// Delete the follow request.
+	if err := f.state.DB.DeleteFollowRequestByID(ctx, followReq.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return fmt.Errorf("undoFollow: db error removing follow request: %w", err)
 	}
 
-	log.Debug(ctx, "Follow undone")
+	// Send the withdrawn follow request through
+	// the processor to do side effects (stats).
+	f.state.Workers.Federator.Queue.Push(&messages.FromFediAPI{
+		APObjectType:   ap.ActivityFollow,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       followReq,
+		Receiving:      receivingAccount,
+		Requesting:     requestingAccount,
+	})
+
+	log.Debug(ctx, "Follow request undone")
 	return nil
 }
 
@@ -19,10 +19,13 @@ package interaction
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -60,8 +63,40 @@ func (f *Filter) startedThread(
 	return parents[0].AccountID == requester.ID, nil
 }
 
+// policyStatus returns the status whose interaction
+// policy governs interactions with the given status.
+// For a boost of the booster's own status (eg., a
+// self-boost of one of their threads), that's the
+// original status, so the boost can't be used to
+// sidestep the original's policy. For anything
+// else, it's the given status itself.
+func (f *Filter) policyStatus(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) (*gtsmodel.Status, error) {
+	if status.BoostOfID == "" ||
+		status.BoostOfAccountID != status.AccountID {
+		// Not a self-boost.
+		return status, nil
+	}
+
+	if status.BoostOf != nil {
+		// Already populated.
+		return status.BoostOf, nil
+	}
+
+	boostOf, err := f.state.DB.GetStatusByID(ctx, status.BoostOfID)
+	if err != nil {
+		return nil, fmt.Errorf("db error getting boosted status %s: %w", status.BoostOfID, err)
+	}
+
+	return boostOf, nil
+}
+
 // StatusLikeable checks if the given status
 // is likeable by the requester account.
+// Self-boosts are checked against the
+// policy of the boosted status.
 //
 // Callers to this function should have already
 // checked the visibility of status to requester,
@@ -73,6 +108,12 @@ func (f *Filter) StatusLikeable(
 	requester *gtsmodel.Account,
 	status *gtsmodel.Status,
 ) (*gtsmodel.PolicyCheckResult, error) {
+	status, err := f.policyStatus(ctx, status)
+	if err != nil {
+		err := gtserror.Newf("error getting policy status: %w", err)
+		return nil, err
+	}
+
 	if requester.ID == status.AccountID {
 		// Status author themself can
 		// always like their own status,
@@ -91,6 +132,7 @@ func (f *Filter) StatusLikeable(
 			requester,
 			status,
 			status.InteractionPolicy.CanLike,
+			nil,
 		)
 
 	// If status is local and has no policy set,
@@ -103,6 +145,7 @@ func (f *Filter) StatusLikeable(
 			requester,
 			status,
 			policy.CanLike,
+			nil,
 		)
 
 	// Otherwise, assume the status is from an
@@ -117,6 +160,8 @@ func (f *Filter) StatusLikeable(
 
 // StatusReplyable checks if the given status
 // is replyable by the requester account.
+// Self-boosts are checked against the
+// policy of the boosted status.
 //
 // Callers to this function should have already
 // checked the visibility of status to requester,
@@ -128,6 +173,27 @@ func (f *Filter) StatusReplyable(
 	requester *gtsmodel.Account,
 	status *gtsmodel.Status,
 ) (*gtsmodel.PolicyCheckResult, error) {
+	return f.StatusReplyableWithTags(ctx, requester, status, nil)
+}
+
+// StatusReplyableWithTags is like StatusReplyable,
+// but also takes the (lowercase) names of hashtags
+// used by the reply. If the reply would require
+// approval, and uses the hashtag with which the
+// status author approves replies automatically,
+// the reply is permitted, matched on approval tag.
+func (f *Filter) StatusReplyableWithTags(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+	tagNames []string,
+) (*gtsmodel.PolicyCheckResult, error) {
+	status, err := f.policyStatus(ctx, status)
+	if err != nil {
+		err := gtserror.Newf("error getting policy status: %w", err)
+		return nil, err
+	}
+
 	if util.PtrOrValue(status.PendingApproval, false) {
 		// Target status is pending approval,
 		// check who started this thread.
@@ -242,6 +308,7 @@ func (f *Filter) StatusReplyable(
 			requester,
 			status,
 			status.InteractionPolicy.CanReply,
+			tagNames,
 		)
 
 	// If status is local and has no policy set,
@@ -254,6 +321,7 @@ func (f *Filter) StatusReplyable(
 			requester,
 			status,
 			policy.CanReply,
+			tagNames,
 		)
 
 	// Otherwise, assume the status is from an
@@ -268,6 +336,8 @@ func (f *Filter) StatusReplyable(
 
 // StatusBoostable checks if the given status
 // is boostable by the requester account.
+// Self-boosts are checked against the
+// policy of the boosted status.
 //
 // Callers to this function should have already
 // checked the visibility of status to requester,
@@ -279,6 +349,12 @@ func (f *Filter) StatusBoostable(
 	requester *gtsmodel.Account,
 	status *gtsmodel.Status,
 ) (*gtsmodel.PolicyCheckResult, error) {
+	status, err := f.policyStatus(ctx, status)
+	if err != nil {
+		err := gtserror.Newf("error getting policy status: %w", err)
+		return nil, err
+	}
+
 	if status.Visibility == gtsmodel.VisibilityDirect {
 		log.Trace(ctx, "direct statuses are not boostable")
 		return &gtsmodel.PolicyCheckResult{
@@ -296,6 +372,24 @@ func (f *Filter) StatusBoostable(
 		}, nil
 	}
 
+	if !config.GetInstanceInteractionApprovedBoostable() {
+		// Replies approved by our accounts aren't
+		// to be boosted into wider circulation by
+		// anyone but their author, whatever the
+		// policy of the reply says.
+		approved, err := f.isLocallyApprovedReply(ctx, status)
+		if err != nil {
+			return nil, err
+		}
+
+		if approved {
+			log.Trace(ctx, "approved replies are not boostable")
+			return &gtsmodel.PolicyCheckResult{
+				Permission: gtsmodel.PolicyPermissionForbidden,
+			}, nil
+		}
+	}
+
 	switch {
 	// If status has policy set, check against that.
 	case status.InteractionPolicy != nil:
@@ -304,6 +398,7 @@ func (f *Filter) StatusBoostable(
 			requester,
 			status,
 			status.InteractionPolicy.CanAnnounce,
+			nil,
 		)
 
 	// If status is local and has no policy set,
@@ -316,6 +411,7 @@ func (f *Filter) StatusBoostable(
 			requester,
 			status,
 			policy.CanAnnounce,
+			nil,
 		)
 
 	// Otherwise, assume the status is from an
@@ -328,11 +424,42 @@ func (f *Filter) StatusBoostable(
 	}
 }
 
+// isLocallyApprovedReply returns true if the given
+// status is a reply which was approved by the replied-to
+// account, where that account is on this instance.
+func (f *Filter) isLocallyApprovedReply(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) (bool, error) {
+	if status.InReplyToAccountID == "" ||
+		status.ApprovedByURI == "" {
+		// Not an
+		// approved reply.
+		return false, nil
+	}
+
+	inReplyToAccount := status.InReplyToAccount
+	if inReplyToAccount == nil {
+		var err error
+		inReplyToAccount, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			status.InReplyToAccountID,
+		)
+		if err != nil {
+			err := gtserror.Newf("db error getting replied-to account: %w", err)
+			return false, err
+		}
+	}
+
+	return inReplyToAccount.IsLocal(), nil
+}
+
 func (f *Filter) checkPolicy(
 	ctx context.Context,
 	requester *gtsmodel.Account,
 	status *gtsmodel.Status,
 	rules gtsmodel.PolicyRules,
+	tagNames []string,
 ) (*gtsmodel.PolicyCheckResult, error) {
 
 	// Wrap context to be able to
@@ -373,9 +500,7 @@ func (f *Filter) checkPolicy(
 		}, nil
 
 	case matchWithApproval == explicit:
-		return &gtsmodel.PolicyCheckResult{
-			Permission: gtsmodel.PolicyPermissionWithApproval,
-		}, nil
+		return f.withApproval(fctx, requester, status, tagNames)
 
 	// Then try implicit match,
 	// prioritizing "always".
@@ -386,9 +511,7 @@ func (f *Filter) checkPolicy(
 		}, nil
 
 	case matchWithApproval == implicit:
-		return &gtsmodel.PolicyCheckResult{
-			Permission: gtsmodel.PolicyPermissionWithApproval,
-		}, nil
+		return f.withApproval(fctx, requester, status, tagNames)
 	}
 
 	// No match.
@@ -397,6 +520,53 @@ func (f *Filter) checkPolicy(
 	}, nil
 }
 
+// withApproval returns the result for a requester who
+// matched a policy value requiring approval. If the
+// requester is on the status author's trusted list,
+// or the interaction uses the status author's approval
+// tag (given its tagNames), the interaction is permitted
+// without manual approval.
+func (f *Filter) withApproval(
+	ctx *filterctx,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+	tagNames []string,
+) (*gtsmodel.PolicyCheckResult, error) {
+	inTrustedList, err := f.inTrustedList(ctx,
+		requester,
+		status,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking trusted list: %w", err)
+	}
+
+	if inTrustedList {
+		return &gtsmodel.PolicyCheckResult{
+			Permission:         gtsmodel.PolicyPermissionPermitted,
+			PermittedMatchedOn: util.Ptr(gtsmodel.PolicyValueTrustedList),
+		}, nil
+	}
+
+	usesApprovalTag, err := f.usesApprovalTag(ctx,
+		status,
+		tagNames,
+	)
+	if err != nil {
+		return nil, gtserror.Newf("error checking approval tag: %w", err)
+	}
+
+	if usesApprovalTag {
+		return &gtsmodel.PolicyCheckResult{
+			Permission:         gtsmodel.PolicyPermissionPermitted,
+			PermittedMatchedOn: util.Ptr(gtsmodel.PolicyValueApprovalTag),
+		}, nil
+	}
+
+	return &gtsmodel.PolicyCheckResult{
+		Permission: gtsmodel.PolicyPermissionWithApproval,
+	}, nil
+}
+
 // matchPolicy returns whether requesting account
 // matches any of the policy values for given status,
 // returning the policy it matches on and match type.
@@ -546,6 +716,79 @@ func (f *Filter) inFollowing(
 	return ctx.inFollowing, nil
 }
 
+// inTrustedList returns whether requesting account is a member
+// of the (local) status author's designated trusted list.
+func (f *Filter) inTrustedList(
+	ctx *filterctx,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+) (
+	bool,
+	error,
+) {
+	if !status.IsLocal() {
+		// Only local accounts
+		// have account settings.
+		return false, nil
+	}
+
+	settings, err := f.state.DB.GetAccountSettings(ctx, status.AccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting account settings: %w", err)
+	}
+
+	if settings == nil || settings.InteractionTrustedListID == "" {
+		// No trusted list set.
+		return false, nil
+	}
+
+	inList, err := f.state.DB.ListIncludesAccount(ctx,
+		settings.InteractionTrustedListID,
+		requester.ID,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error checking list membership: %w", err)
+	}
+
+	return inList, nil
+}
+
+// usesApprovalTag returns whether the given tagNames
+// of an interaction include the hashtag with which the
+// author of status approves interactions automatically.
+func (f *Filter) usesApprovalTag(
+	ctx *filterctx,
+	status *gtsmodel.Status,
+	tagNames []string,
+) (
+	bool,
+	error,
+) {
+	if len(tagNames) == 0 {
+		// No tags to
+		// check against.
+		return false, nil
+	}
+
+	if !status.IsLocal() {
+		// Only local accounts
+		// have account settings.
+		return false, nil
+	}
+
+	settings, err := f.state.DB.GetAccountSettings(ctx, status.AccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting account settings: %w", err)
+	}
+
+	if settings == nil || settings.InteractionApprovalTag == "" {
+		// No approval tag set.
+		return false, nil
+	}
+
+	return slices.Contains(tagNames, settings.InteractionApprovalTag), nil
+}
+
 // filterctx wraps a context.Context to also
 // store loadable data relevant to a fillter
 // operation from the database, such that it
@@ -0,0 +1,220 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package interaction_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type InteractableTestSuite struct {
+	FilterStandardTestSuite
+}
+
+// replyableStatus returns a copy of zork's first status
+// with a policy requiring approval for replies from
+// anyone who isn't the author or mentioned.
+func (suite *InteractableTestSuite) replyableStatus() *gtsmodel.Status {
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.InteractionPolicy = &gtsmodel.InteractionPolicy{
+		CanLike: gtsmodel.PolicyRules{Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic}},
+		CanReply: gtsmodel.PolicyRules{
+			Always:       gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor, gtsmodel.PolicyValueMentioned},
+			WithApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		},
+		CanAnnounce: gtsmodel.PolicyRules{Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic}},
+	}
+	return status
+}
+
+func (suite *InteractableTestSuite) setTrustedList(accountID string, listID string) {
+	ctx := context.Background()
+
+	settings, err := suite.db.GetAccountSettings(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings.InteractionTrustedListID = listID
+	if err := suite.db.UpdateAccountSettings(ctx,
+		settings,
+		"interaction_trusted_list_id",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InteractableTestSuite) TestReplyableNoTrustedList() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["admin_account"]
+		status    = suite.replyableStatus()
+	)
+
+	result, err := suite.filter.StatusReplyable(ctx, requester, status)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(gtsmodel.PolicyPermissionWithApproval, result.Permission)
+}
+
+func (suite *InteractableTestSuite) TestReplyableTrustedListMember() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["admin_account"]
+		status    = suite.replyableStatus()
+		list      = suite.testLists["local_account_1_list_1"]
+	)
+
+	// Zork's list includes admin.
+	suite.setTrustedList(status.AccountID, list.ID)
+
+	result, err := suite.filter.StatusReplyable(ctx, requester, status)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should be permitted + pre-approved.
+	suite.True(result.Permitted())
+	suite.True(result.MatchedOnCollection())
+	suite.Equal(gtsmodel.PolicyValueTrustedList, *result.PermittedMatchedOn)
+}
+
+func (suite *InteractableTestSuite) TestReplyableTrustedListNonMember() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["remote_account_1"]
+		status    = suite.replyableStatus()
+		list      = suite.testLists["local_account_1_list_1"]
+	)
+
+	// Zork's list doesn't include remote_account_1.
+	suite.setTrustedList(status.AccountID, list.ID)
+
+	result, err := suite.filter.StatusReplyable(ctx, requester, status)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should still need approval.
+	suite.Equal(gtsmodel.PolicyPermissionWithApproval, result.Permission)
+}
+
+func (suite *InteractableTestSuite) setApprovalTag(accountID string, tag string) {
+	ctx := context.Background()
+
+	settings, err := suite.db.GetAccountSettings(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	settings.InteractionApprovalTag = tag
+	if err := suite.db.UpdateAccountSettings(ctx,
+		settings,
+		"interaction_approval_tag",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InteractableTestSuite) TestReplyableApprovalTag() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["remote_account_1"]
+		status    = suite.replyableStatus()
+	)
+
+	suite.setApprovalTag(status.AccountID, "askzork")
+
+	result, err := suite.filter.StatusReplyableWithTags(ctx,
+		requester,
+		status,
+		[]string{"welcome", "askzork"},
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should be permitted + pre-approved.
+	suite.True(result.Permitted())
+	suite.True(result.MatchedOnCollection())
+	suite.Equal(gtsmodel.PolicyValueApprovalTag, *result.PermittedMatchedOn)
+}
+
+func (suite *InteractableTestSuite) TestReplyableApprovalTagNotUsed() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["remote_account_1"]
+		status    = suite.replyableStatus()
+	)
+
+	suite.setApprovalTag(status.AccountID, "askzork")
+
+	result, err := suite.filter.StatusReplyableWithTags(ctx,
+		requester,
+		status,
+		[]string{"welcome"},
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should still need approval.
+	suite.Equal(gtsmodel.PolicyPermissionWithApproval, result.Permission)
+}
+
+func (suite *InteractableTestSuite) TestReplyableSelfBoost() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["admin_account"]
+		status    = suite.replyableStatus()
+	)
+
+	// Zork boosts their own status. The boost
+	// wrapper has no policy of its own, so would
+	// otherwise fall back to the public default.
+	boost := &gtsmodel.Status{
+		ID:               "01J9X6M3XK4CZ2NBPQ3QW6FE3J",
+		AccountID:        status.AccountID,
+		Account:          status.Account,
+		BoostOfID:        status.ID,
+		BoostOfAccountID: status.AccountID,
+		BoostOf:          status,
+		Visibility:       gtsmodel.VisibilityPublic,
+		Local:            util.Ptr(true),
+	}
+
+	// Reply to the boost should be gated
+	// per the original status' policy.
+	result, err := suite.filter.StatusReplyable(ctx, requester, boost)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(gtsmodel.PolicyPermissionWithApproval, result.Permission)
+}
+
+func TestInteractableTestSuite(t *testing.T) {
+	suite.Run(t, new(InteractableTestSuite))
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // StatusHomeTimelineable checks if given status should be included on owner's home timeline. Primarily relying on status visibility to owner and the AP visibility setting, but also taking into account thread replies etc.
@@ -77,6 +78,12 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 		return false, nil
 	}
 
+	// Placeholders for deleted statuses
+	// only belong in thread views.
+	if util.PtrOrZero(status.Placeholder) {
+		return false, nil
+	}
+
 	// Check whether status is visible to timeline owner.
 	visible, err := f.StatusVisible(ctx, owner, status)
 	if err != nil {
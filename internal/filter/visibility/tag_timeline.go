@@ -23,6 +23,7 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 // StatusHomeTimelineable checks if given status should be included
@@ -44,6 +45,12 @@ func (f *Filter) StatusTagTimelineable(
 		return false, nil
 	}
 
+	// Placeholders for deleted statuses
+	// only belong in thread views.
+	if util.PtrOrZero(status.Placeholder) {
+		return false, nil
+	}
+
 	// Check whether status is visible to requesting account.
 	visible, err := f.StatusVisible(ctx, requester, status)
 	if err != nil {
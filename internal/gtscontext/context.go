@@ -44,6 +44,7 @@ const (
 	httpSigPubKeyIDKey
 	dryRunKey
 	httpClientSignFnKey
+	statsOpIDKey
 )
 
 // DryRun returns whether the "dryrun" context key has been set. This can be
@@ -60,6 +61,21 @@ func SetDryRun(ctx context.Context) context.Context {
 	return context.WithValue(ctx, dryRunKey, struct{}{})
 }
 
+// StatsOpID returns the operation ID associated with context, if any. When set,
+// account stats updates performed by the processing workers are only applied
+// once per operation ID, allowing a reprocessed (e.g. retried) message to be
+// handled again without double-counting.
+func StatsOpID(ctx context.Context) string {
+	id, _ := ctx.Value(statsOpIDKey).(string)
+	return id
+}
+
+// SetStatsOpID stores the given stats operation ID value and returns the wrapped
+// context. See StatsOpID() for further information on the stats operation ID.
+func SetStatsOpID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, statsOpIDKey, id)
+}
+
 // RequestID returns the request ID associated with context. This value will usually
 // be set by the request ID middleware handler, either pulling an existing supplied
 // value from request headers, or generating a unique new entry. This is useful for
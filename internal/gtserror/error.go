@@ -42,6 +42,53 @@ const (
 	notPermittedKey
 )
 
+// Type checks error for a stored ErrorType value, returning
+// the empty ErrorType if none is set. Note that for errors
+// joined together, e.g. by MultiError.Combine(), this will
+// only return one of the stored types. See HasType().
+func Type(err error) ErrorType {
+	t, _ := errors.Value(err, errorTypeKey).(ErrorType)
+	return t
+}
+
+// WithType will wrap the given error to store provided ErrorType,
+// returning wrapped error. See Type() for example use-cases.
+func WithType(err error, errType ErrorType) error {
+	return errors.WithValue(err, errorTypeKey, errType)
+}
+
+// HasType checks whether error, or any error wrapped or joined
+// within it (e.g. by MultiError.Combine()), has a stored ErrorType
+// value equal to the given type. Unlike Type(), this checks ALL
+// errors in the tree, not just the first with a stored type.
+func HasType(err error, errType ErrorType) bool {
+	switch e := err.(type) {
+	case nil:
+		return false
+
+	case interface{ Value(any) any }:
+		// Check value stored on this
+		// particular error in the tree.
+		if t, _ := e.Value(errorTypeKey).(ErrorType); t == errType {
+			return true
+		}
+	}
+
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		return HasType(e.Unwrap(), errType)
+
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			if HasType(err, errType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // IsUnretrievable indicates that a call to retrieve a resource
 // (account, status, attachment, etc) could not be fulfilled, either
 // because it was not found locally, or because some prerequisite
@@ -55,6 +55,16 @@ func (m *MultiError) Appendf(format string, args ...any) {
 	(*m) = append((*m), err)
 }
 
+// AppendTypef appends the given format string to the
+// MultiError, storing the provided ErrorType on it.
+//
+// It is valid to use %w in the format string
+// to wrap any other errors.
+func (m *MultiError) AppendTypef(errType ErrorType, format string, args ...any) {
+	err := newfAt(3, format, args...)
+	(*m) = append((*m), WithType(err, errType))
+}
+
 // Combine the MultiError into a single error.
 //
 // Unwrap will work on the returned error as expected.
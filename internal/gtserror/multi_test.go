@@ -58,3 +58,67 @@ func TestMultiErrorEmpty(t *testing.T) {
 		t.Errorf("should be nil")
 	}
 }
+
+func TestMultiErrorTypes(t *testing.T) {
+	const (
+		mediaType    = gtserror.ErrorType("media")
+		mentionsType = gtserror.ErrorType("mentions")
+		timelineType = gtserror.ErrorType("timeline")
+	)
+
+	// checkTypes checks the expected types are found
+	// on the combined and wrapped-combined errors.
+	checkTypes := func(errs gtserror.MultiError, expect ...gtserror.ErrorType) {
+		combined := errs.Combine()
+		wrapped := gtserror.Newf("error wiping: %w", combined)
+
+		for _, err := range []error{combined, wrapped} {
+			for _, errType := range []gtserror.ErrorType{
+				mediaType,
+				mentionsType,
+				timelineType,
+			} {
+				has := gtserror.HasType(err, errType)
+				want := false
+				for _, e := range expect {
+					want = want || (e == errType)
+				}
+				if has != want {
+					t.Errorf("HasType(%q) should be %v on '%v'", errType, want, err)
+				}
+			}
+		}
+	}
+
+	// Media only failure.
+	var mediaErrs gtserror.MultiError
+	mediaErrs.AppendTypef(mediaType, "error deleting media: %w", db.ErrNoEntries)
+	checkTypes(mediaErrs, mediaType)
+
+	// Timeline only failure.
+	var timelineErrs gtserror.MultiError
+	timelineErrs.AppendTypef(timelineType, "error deleting from timelines: %w", db.ErrNoEntries)
+	checkTypes(timelineErrs, timelineType)
+
+	// Multiple failures, including untyped.
+	var errs gtserror.MultiError
+	errs.AppendTypef(mediaType, "error deleting media: %w", db.ErrNoEntries)
+	errs.Appendf("untyped error")
+	errs.AppendTypef(timelineType, "error deleting from timelines: %w", db.ErrAlreadyExists)
+	checkTypes(errs, mediaType, timelineType)
+
+	// Wrapped errors should still be unwrappable.
+	if err := errs.Combine(); !errors.Is(err, db.ErrAlreadyExists) {
+		t.Error("should be db.ErrAlreadyExists")
+	}
+
+	// Type() only returns one of the stored types.
+	if errType := gtserror.Type(errs.Combine()); errType != mediaType && errType != timelineType {
+		t.Errorf("Type() should be %q or %q, was %q", mediaType, timelineType, errType)
+	}
+
+	// No errors, no types.
+	if gtserror.HasType(nil, mediaType) {
+		t.Error("nil error should have no type")
+	}
+}
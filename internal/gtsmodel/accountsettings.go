@@ -37,4 +37,9 @@ type AccountSettings struct {
 	InteractionPolicyFollowersOnly *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new followers only visibility statuses. If null, assume default policy.
 	InteractionPolicyUnlocked      *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new unlocked visibility statuses. If null, assume default policy.
 	InteractionPolicyPublic        *InteractionPolicy `bun:""`                                                            // Interaction policy to use for new public visibility statuses. If null, assume default policy.
+	InteractionMinAccountAgeDays   int                `bun:",notnull,default:0"`                                          // Auto-reject interactions requiring approval from accounts younger than this many days. 0 to disable.
+	InteractionTrustedListID       string             `bun:"type:CHAR(26),nullzero"`                                      // ID of list whose members' interactions requiring approval are approved automatically. Empty to disable.
+	InteractionApprovalTag         string             `bun:",nullzero"`                                                   // Name of hashtag (lowercase, without #) with which replies requiring approval are approved automatically. Empty to disable.
+	InteractionPendingReminders    *bool              `bun:",nullzero,notnull,default:false"`                             // Periodically send a notification reminding this account of interactions pending its approval.
+	InteractionApprovalExpiryDays  int                `bun:",notnull,default:0"`                                          // Approvals of interactions with this account's statuses expire after this many days, hiding the interactions again. 0 to disable.
 }
@@ -17,17 +17,87 @@
 
 package gtsmodel
 
-import "time"
+import (
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
 
 // AccountStats models statistics
 // for a remote or local account.
 type AccountStats struct {
-	AccountID           string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"` // AccountID of this AccountStats.
-	RegeneratedAt       time.Time `bun:"type:timestamptz,nullzero"`                // Time this stats model was last regenerated (ie., created from scratch using COUNTs).
-	FollowersCount      *int      `bun:",nullzero,notnull"`                        // Number of accounts following AccountID.
-	FollowingCount      *int      `bun:",nullzero,notnull"`                        // Number of accounts followed by AccountID.
-	FollowRequestsCount *int      `bun:",nullzero,notnull"`                        // Number of pending follow requests aimed at AccountID.
-	StatusesCount       *int      `bun:",nullzero,notnull"`                        // Number of statuses created by AccountID.
-	StatusesPinnedCount *int      `bun:",nullzero,notnull"`                        // Number of statuses pinned by AccountID.
-	LastStatusAt        time.Time `bun:"type:timestamptz,nullzero"`                // Time of most recent status created by AccountID.
+	AccountID            string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"` // AccountID of this AccountStats.
+	RegeneratedAt        time.Time `bun:"type:timestamptz,nullzero"`                // Time this stats model was last regenerated (ie., created from scratch using COUNTs).
+	FollowersCount       *int      `bun:",nullzero,notnull"`                        // Number of accounts following AccountID.
+	LocalFollowersCount  *int      `bun:",nullzero,notnull"`                        // Number of local accounts following AccountID.
+	RemoteFollowersCount *int      `bun:",nullzero,notnull"`                        // Number of remote accounts known to be following AccountID.
+	FollowingCount       *int      `bun:",nullzero,notnull"`                        // Number of accounts followed by AccountID.
+	FollowRequestsCount  *int      `bun:",nullzero,notnull"`                        // Number of pending follow requests aimed at AccountID.
+	StatusesCount        *int      `bun:",nullzero,notnull"`                        // Number of statuses created by AccountID.
+	StatusesPinnedCount  *int      `bun:",nullzero,notnull"`                        // Number of statuses pinned by AccountID.
+	LastStatusAt         time.Time `bun:"type:timestamptz,nullzero"`                // Time of most recent status created by AccountID.
+	UpdatedBy            string    `bun:",nullzero"`                                // Configured node-name of the process that last wrote this stats model, if any.
+}
+
+// Snapshot returns a value copy of AccountStats,
+// including copies of pointer fields, such that
+// it isn't affected by later changes to stats.
+func (s *AccountStats) Snapshot() AccountStats {
+	snapshot := *s
+	snapshot.FollowersCount = copyIntPtr(s.FollowersCount)
+	snapshot.LocalFollowersCount = copyIntPtr(s.LocalFollowersCount)
+	snapshot.RemoteFollowersCount = copyIntPtr(s.RemoteFollowersCount)
+	snapshot.FollowingCount = copyIntPtr(s.FollowingCount)
+	snapshot.FollowRequestsCount = copyIntPtr(s.FollowRequestsCount)
+	snapshot.StatusesCount = copyIntPtr(s.StatusesCount)
+	snapshot.StatusesPinnedCount = copyIntPtr(s.StatusesPinnedCount)
+	return snapshot
+}
+
+// Delta returns the difference between AccountStats
+// and the given (earlier) stats, ie., stats - before.
+// Unset (nil) counts are treated as zero.
+func (s *AccountStats) Delta(before *AccountStats) AccountStatsDelta {
+	return AccountStatsDelta{
+		FollowersCount:       util.PtrOrZero(s.FollowersCount) - util.PtrOrZero(before.FollowersCount),
+		LocalFollowersCount:  util.PtrOrZero(s.LocalFollowersCount) - util.PtrOrZero(before.LocalFollowersCount),
+		RemoteFollowersCount: util.PtrOrZero(s.RemoteFollowersCount) - util.PtrOrZero(before.RemoteFollowersCount),
+		FollowingCount:       util.PtrOrZero(s.FollowingCount) - util.PtrOrZero(before.FollowingCount),
+		FollowRequestsCount:  util.PtrOrZero(s.FollowRequestsCount) - util.PtrOrZero(before.FollowRequestsCount),
+		StatusesCount:        util.PtrOrZero(s.StatusesCount) - util.PtrOrZero(before.StatusesCount),
+		StatusesPinnedCount:  util.PtrOrZero(s.StatusesPinnedCount) - util.PtrOrZero(before.StatusesPinnedCount),
+	}
+}
+
+// AccountStatsDelta models the difference
+// in counts between two AccountStats.
+type AccountStatsDelta struct {
+	FollowersCount       int
+	LocalFollowersCount  int
+	RemoteFollowersCount int
+	FollowingCount       int
+	FollowRequestsCount  int
+	StatusesCount        int
+	StatusesPinnedCount  int
+}
+
+// Add returns the sum of AccountStatsDelta
+// and the given delta, ie., delta + other.
+func (d AccountStatsDelta) Add(other AccountStatsDelta) AccountStatsDelta {
+	return AccountStatsDelta{
+		FollowersCount:       d.FollowersCount + other.FollowersCount,
+		LocalFollowersCount:  d.LocalFollowersCount + other.LocalFollowersCount,
+		RemoteFollowersCount: d.RemoteFollowersCount + other.RemoteFollowersCount,
+		FollowingCount:       d.FollowingCount + other.FollowingCount,
+		FollowRequestsCount:  d.FollowRequestsCount + other.FollowRequestsCount,
+		StatusesCount:        d.StatusesCount + other.StatusesCount,
+		StatusesPinnedCount:  d.StatusesPinnedCount + other.StatusesPinnedCount,
+	}
+}
+
+func copyIntPtr(i *int) *int {
+	if i == nil {
+		return nil
+	}
+	return util.Ptr(*i)
 }
@@ -73,6 +73,8 @@ const (
 	AdminActionSuspend
 	AdminActionUnsuspend
 	AdminActionExpireKeys
+	AdminActionRebuildConversations
+	AdminActionRecalculateStats
 )
 
 func (t AdminActionType) String() string {
@@ -91,6 +93,10 @@ func (t AdminActionType) String() string {
 		return "unsuspend"
 	case AdminActionExpireKeys:
 		return "expire-keys"
+	case AdminActionRebuildConversations:
+		return "rebuild-conversations"
+	case AdminActionRecalculateStats:
+		return "recalculate-stats"
 	default:
 		return "unknown"
 	}
@@ -112,6 +118,10 @@ func NewAdminActionType(in string) AdminActionType {
 		return AdminActionUnsuspend
 	case "expire-keys":
 		return AdminActionExpireKeys
+	case "rebuild-conversations":
+		return AdminActionRebuildConversations
+	case "recalculate-stats":
+		return AdminActionRecalculateStats
 	default:
 		return AdminActionUnknown
 	}
@@ -27,16 +27,31 @@ import "time"
 // using this format; the URI of the remote Accept is instead
 // just added to the *gtsmodel.StatusFave or *gtsmodel.Status.
 type InteractionApproval struct {
-	ID                   string          `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt            time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt            time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	AccountID            string          `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that owns this accept/approval
-	Account              *Account        `bun:"-"`                                                           // account corresponding to accountID
-	InteractingAccountID string          `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that did the interaction that this Accept targets.
-	InteractingAccount   *Account        `bun:"-"`                                                           // account corresponding to targetAccountID
-	InteractionURI       string          `bun:",nullzero,notnull"`                                           // URI of the target like, reply, or announce
-	InteractionType      InteractionType `bun:",notnull"`                                                    // One of Like, Reply, or Announce.
-	URI                  string          `bun:",nullzero,notnull,unique"`                                    // ActivityPub URI of the Accept.
+	ID                   string            `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt            time.Time         `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt            time.Time         `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID            string            `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that owns this accept/approval
+	Account              *Account          `bun:"-"`                                                           // account corresponding to accountID
+	InteractingAccountID string            `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that did the interaction that this Accept targets.
+	InteractingAccount   *Account          `bun:"-"`                                                           // account corresponding to targetAccountID
+	InteractionURI       string            `bun:",nullzero,notnull"`                                           // URI of the target like, reply, or announce
+	InteractionType      InteractionType   `bun:",notnull"`                                                    // One of Like, Reply, or Announce.
+	URI                  string            `bun:",nullzero,notnull,unique"`                                    // ActivityPub URI of the Accept.
+	ExpiresAt            time.Time         `bun:"type:timestamptz,nullzero"`                                   // Optional time at which this approval expires, after which the interaction is hidden again + a Reject is sent.
+	Reason               InteractionReason `bun:",nullzero"`                                                   // Optional reason code given for this approval, for analytics.
+}
+
+// Expires returns true if this approval
+// has an expiry time set (ie., it is not
+// a permanent approval of the interaction).
+func (a *InteractionApproval) Expires() bool {
+	return !a.ExpiresAt.IsZero()
+}
+
+// ApprovalExpiryID returns the scheduler task ID
+// used for expiring the approval with given ID.
+func ApprovalExpiryID(approvalID string) string {
+	return "approval_expiry:" + approvalID
 }
 
 // Like / Reply / Announce
@@ -53,3 +68,17 @@ const (
 	InteractionReply
 	InteractionAnnounce
 )
+
+// InteractionReason is an optional, structured reason
+// code recorded when approving or rejecting an interaction.
+type InteractionReason string
+
+const (
+	InteractionReasonTrusted  InteractionReason = "trusted"   // Interacting account is trusted.
+	InteractionReasonOnTopic  InteractionReason = "on-topic"  // Interaction is on-topic.
+	InteractionReasonOffTopic InteractionReason = "off-topic" // Interaction is off-topic.
+	InteractionReasonSpam     InteractionReason = "spam"      // Interaction is spam.
+	InteractionReasonAbusive  InteractionReason = "abusive"   // Interaction is abusive.
+	InteractionReasonOther    InteractionReason = "other"     // Some other reason.
+	InteractionReasonTooNew   InteractionReason = "too-new"   // Interacting account too new; only set automatically.
+)
@@ -62,6 +62,18 @@ const (
 	PolicyValueMentioned PolicyValue = "mentioned"
 	// Stand-in for the Actor URI of the item owner.
 	PolicyValueAuthor PolicyValue = "author"
+	// Stand-in for members of the item owner's
+	// trusted list, see AccountSettings.
+	//
+	// Only used as a matched-on value in
+	// PolicyCheckResult, never federated.
+	PolicyValueTrustedList PolicyValue = "trusted_list"
+	// Stand-in for interactions using the item
+	// owner's approval hashtag, see AccountSettings.
+	//
+	// Only used as a matched-on value in
+	// PolicyCheckResult, never federated.
+	PolicyValueApprovalTag PolicyValue = "approval_tag"
 )
 
 // FeasibleForVisibility returns true if the PolicyValue could feasibly
@@ -144,7 +156,9 @@ type PolicyCheckResult struct {
 
 // MatchedOnCollection returns true if this policy check
 // result turned up Permitted, and matched based on the
-// requester's presence in a followers or following collection.
+// requester's presence in a followers or following collection,
+// or in the item owner's trusted list, or on the interaction
+// using the item owner's approval hashtag.
 func (pcr *PolicyCheckResult) MatchedOnCollection() bool {
 	if !pcr.Permitted() {
 		// Not permitted at all
@@ -158,7 +172,9 @@ func (pcr *PolicyCheckResult) MatchedOnCollection() bool {
 	}
 
 	return *pcr.PermittedMatchedOn == PolicyValueFollowers ||
-		*pcr.PermittedMatchedOn == PolicyValueFollowing
+		*pcr.PermittedMatchedOn == PolicyValueFollowing ||
+		*pcr.PermittedMatchedOn == PolicyValueTrustedList ||
+		*pcr.PermittedMatchedOn == PolicyValueApprovalTag
 }
 
 // Permitted returns true if this policy
@@ -0,0 +1,36 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// InteractionRejection records the rejection, by a local
+// account, of an interaction which was pending approval,
+// along with the reason code given for it, if any.
+//
+// Rejected interactions themselves are deleted, so these
+// are only kept around for reason code analytics.
+type InteractionRejection struct {
+	ID                   string            `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt            time.Time         `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	AccountID            string            `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that rejected the interaction
+	InteractingAccountID string            `bun:"type:CHAR(26),nullzero,notnull"`                              // id of the account that did the rejected interaction
+	InteractionURI       string            `bun:",nullzero,notnull"`                                           // URI of the rejected like, reply, or announce
+	InteractionType      InteractionType   `bun:",notnull"`                                                    // One of Like, Reply, or Announce.
+	Reason               InteractionReason `bun:",nullzero"`                                                   // Optional reason code given for this rejection.
+}
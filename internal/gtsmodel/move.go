@@ -25,14 +25,41 @@ import (
 // Move represents an ActivityPub "Move" activity
 // received (or created) by this instance.
 type Move struct {
-	ID          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
-	CreatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was item created.
-	UpdatedAt   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was item last updated.
-	AttemptedAt time.Time `bun:"type:timestamptz,nullzero"`                                   // When was processing of the Move to TargetURI last attempted by our instance (zero if not yet attempted).
-	SucceededAt time.Time `bun:"type:timestamptz,nullzero"`                                   // When did the processing of the Move to TargetURI succeed according to our criteria (zero if not yet complete).
-	OriginURI   string    `bun:",nullzero,notnull,unique:moveorigintarget"`                   // OriginURI of the Move. Ie., the Move Object.
-	Origin      *url.URL  `bun:"-"`                                                           // URL corresponding to OriginURI. Not stored in the database.
-	TargetURI   string    `bun:",nullzero,notnull,unique:moveorigintarget"`                   // TargetURI of the Move. Ie., the Move Target.
-	Target      *url.URL  `bun:"-"`                                                           // URL corresponding to TargetURI. Not stored in the database.
-	URI         string    `bun:",nullzero,notnull,unique"`                                    // ActivityPub ID/URI of the Move Activity itself.
+	ID           string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt    time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was item created.
+	UpdatedAt    time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was item last updated.
+	AttemptedAt  time.Time `bun:"type:timestamptz,nullzero"`                                   // When was processing of the Move to TargetURI last attempted by our instance (zero if not yet attempted).
+	SucceededAt  time.Time `bun:"type:timestamptz,nullzero"`                                   // When did the processing of the Move to TargetURI succeed according to our criteria (zero if not yet complete).
+	OriginURI    string    `bun:",nullzero,notnull,unique:moveorigintarget"`                   // OriginURI of the Move. Ie., the Move Object.
+	Origin       *url.URL  `bun:"-"`                                                           // URL corresponding to OriginURI. Not stored in the database.
+	TargetURI    string    `bun:",nullzero,notnull,unique:moveorigintarget"`                   // TargetURI of the Move. Ie., the Move Target.
+	Target       *url.URL  `bun:"-"`                                                           // URL corresponding to TargetURI. Not stored in the database.
+	URI          string    `bun:",nullzero,notnull,unique"`                                    // ActivityPub ID/URI of the Move Activity itself.
+	LastFollowID string    `bun:"type:CHAR(26),nullzero"`                                      // ID of the last follow of the origin processed while redirecting followers, if a pass is in progress.
 }
+
+// MoveEvent represents one step in the processing
+// of a Move by this instance, stored so that admins
+// can follow along with what happened to a Move.
+type MoveEvent struct {
+	ID        string         `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // ID of this item in the database.
+	CreatedAt time.Time      `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // When was item created.
+	MoveID    string         `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the Move this event belongs to.
+	Phase     MoveEventPhase `bun:",nullzero,notnull"`                                           // Phase of Move processing that this event records.
+	Details   string         `bun:""`                                                            // Optional details, eg., the migrated follower URI or the reason for failure.
+}
+
+// MoveEventPhase describes
+// a phase of Move processing.
+type MoveEventPhase string
+
+const (
+	MoveEventValidated        MoveEventPhase = "validated"         // Move was stored and passed initial checks.
+	MoveEventTargetDereffed   MoveEventPhase = "target_dereffed"   // Move target account was dereferenced.
+	MoveEventRedirectStarted  MoveEventPhase = "redirect_started"  // Redirection of local followers started.
+	MoveEventFollowerMigrated MoveEventPhase = "follower_migrated" // One local follower was migrated to the target.
+	MoveEventFollowerFailed   MoveEventPhase = "follower_failed"   // One local follower could not be migrated to the target.
+	MoveEventCompleted        MoveEventPhase = "completed"         // Move processing completed successfully.
+	MoveEventFailed           MoveEventPhase = "failed"            // Move processing stopped without completing.
+	MoveEventCancelled        MoveEventPhase = "cancelled"         // Move was cancelled by the moving account before completing.
+)
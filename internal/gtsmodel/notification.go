@@ -31,6 +31,7 @@ type Notification struct {
 	OriginAccount    *Account         `bun:"-"`                                                           // Account corresponding to OriginAccountID. Can be nil, always check first + select using ID if necessary.
 	StatusID         string           `bun:"type:CHAR(26),nullzero"`                                      // If the notification pertains to a status, what is the database ID of that status?
 	Status           *Status          `bun:"-"`                                                           // Status corresponding to StatusID. Can be nil, always check first + select using ID if necessary.
+	ApprovalURI      string           `bun:",nullzero"`                                                   // If the notification is of an approved interaction, what is the URI of the approval (Accept)?
 	Read             *bool            `bun:",nullzero,notnull,default:false"`                             // Notification has been seen/read
 }
 
@@ -39,15 +40,17 @@ type NotificationType string
 
 // Notification Types
 const (
-	NotificationFollow        NotificationType = "follow"            // NotificationFollow -- someone followed you
-	NotificationFollowRequest NotificationType = "follow_request"    // NotificationFollowRequest -- someone requested to follow you
-	NotificationMention       NotificationType = "mention"           // NotificationMention -- someone mentioned you in their status
-	NotificationReblog        NotificationType = "reblog"            // NotificationReblog -- someone boosted one of your statuses
-	NotificationFave          NotificationType = "favourite"         // NotificationFave -- someone faved/liked one of your statuses
-	NotificationPoll          NotificationType = "poll"              // NotificationPoll -- a poll you voted in or created has ended
-	NotificationStatus        NotificationType = "status"            // NotificationStatus -- someone you enabled notifications for has posted a status.
-	NotificationSignup        NotificationType = "admin.sign_up"     // NotificationSignup -- someone has submitted a new account sign-up to the instance.
-	NotificationPendingFave   NotificationType = "pending.favourite" // Someone has faved a status of yours, which requires approval by you.
-	NotificationPendingReply  NotificationType = "pending.reply"     // Someone has replied to a status of yours, which requires approval by you.
-	NotificationPendingReblog NotificationType = "pending.reblog"    // Someone has boosted a status of yours, which requires approval by you.
+	NotificationFollow              NotificationType = "follow"               // NotificationFollow -- someone followed you
+	NotificationFollowRequest       NotificationType = "follow_request"       // NotificationFollowRequest -- someone requested to follow you
+	NotificationMention             NotificationType = "mention"              // NotificationMention -- someone mentioned you in their status
+	NotificationReblog              NotificationType = "reblog"               // NotificationReblog -- someone boosted one of your statuses
+	NotificationFave                NotificationType = "favourite"            // NotificationFave -- someone faved/liked one of your statuses
+	NotificationPoll                NotificationType = "poll"                 // NotificationPoll -- a poll you voted in or created has ended
+	NotificationStatus              NotificationType = "status"               // NotificationStatus -- someone you enabled notifications for has posted a status.
+	NotificationSignup              NotificationType = "admin.sign_up"        // NotificationSignup -- someone has submitted a new account sign-up to the instance.
+	NotificationPendingFave         NotificationType = "pending.favourite"    // Someone has faved a status of yours, which requires approval by you.
+	NotificationPendingReply        NotificationType = "pending.reply"        // Someone has replied to a status of yours, which requires approval by you.
+	NotificationPendingReblog       NotificationType = "pending.reblog"       // Someone has boosted a status of yours, which requires approval by you.
+	NotificationPendingRemind       NotificationType = "pending.reminder"     // You have interactions awaiting your approval.
+	NotificationInteractionApproved NotificationType = "interaction.approved" // Your interaction which required approval has been approved.
 )
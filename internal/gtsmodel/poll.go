@@ -119,3 +119,19 @@ type PollVote struct {
 	Poll      *Poll     `bun:"-"`                                                           // The related Poll for PollID (not always set).
 	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // The creation date of this PollVote.
 }
+
+// PollArchive represents the final tallies of a Poll, retained
+// after the Poll (and its attached Status) has been deleted.
+type PollArchive struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // Unique identity string.
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // The creation date of this PollArchive, i.e. when the Poll was deleted.
+	PollID    string    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // ID of the (now deleted) Poll.
+	StatusID  string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the (now deleted) Status the Poll was attached to.
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the Account that authored the Poll.
+	Multiple  *bool     `bun:",nullzero,notnull,default:false"`                             // Was this a multiple choice poll?
+	Options   []string  `bun:",nullzero,notnull"`                                           // The available options of the Poll.
+	Votes     []int     `bun:",nullzero,notnull"`                                           // Final vote counts per choice.
+	Voters    *int      `bun:",nullzero,notnull"`                                           // Final total no. voters count.
+	ExpiresAt time.Time `bun:"type:timestamptz,nullzero"`                                   // The expiry date of the Poll, if set.
+	ClosedAt  time.Time `bun:"type:timestamptz,nullzero"`                                   // The closure date of the Poll, if closed.
+}
@@ -26,22 +26,47 @@ import "time"
 // or another instance, OR a report that was created remotely (on another instance)
 // about a user on this instance, and received via the federated (s2s) API.
 type Report struct {
-	ID                     string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt              time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	URI                    string    `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this report
-	AccountID              string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this report
-	Account                *Account  `bun:"-"`                                                           // account corresponding to AccountID
-	TargetAccountID        string    `bun:"type:CHAR(26),nullzero,notnull"`                              // which account is targeted by this report
-	TargetAccount          *Account  `bun:"-"`                                                           // account corresponding to TargetAccountID
-	Comment                string    `bun:",nullzero"`                                                   // comment / explanation for this report, by the reporter
-	StatusIDs              []string  `bun:"statuses,array"`                                              // database IDs of any statuses referenced by this report
-	Statuses               []*Status `bun:"-"`                                                           // statuses corresponding to StatusIDs
-	RuleIDs                []string  `bun:"rules,array"`                                                 // database IDs of any rules referenced by this report
-	Rules                  []*Rule   `bun:"-"`                                                           // rules corresponding to RuleIDs
-	Forwarded              *bool     `bun:",nullzero,notnull,default:false"`                             // flag to indicate report should be forwarded to remote instance
-	ActionTaken            string    `bun:",nullzero"`                                                   // string description of what action was taken in response to this report
-	ActionTakenAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // time at which action was taken, if any
-	ActionTakenByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // database ID of account which took action, if any
-	ActionTakenByAccount   *Account  `bun:"-"`                                                           // account corresponding to ActionTakenByID, if any
+	ID                     string                  `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt              time.Time               `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt              time.Time               `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	URI                    string                  `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this report
+	AccountID              string                  `bun:"type:CHAR(26),nullzero,notnull"`                              // which account created this report
+	Account                *Account                `bun:"-"`                                                           // account corresponding to AccountID
+	TargetAccountID        string                  `bun:"type:CHAR(26),nullzero,notnull"`                              // which account is targeted by this report
+	TargetAccount          *Account                `bun:"-"`                                                           // account corresponding to TargetAccountID
+	Comment                string                  `bun:",nullzero"`                                                   // comment / explanation for this report, by the reporter
+	StatusIDs              []string                `bun:"statuses,array"`                                              // database IDs of any statuses referenced by this report
+	Statuses               []*Status               `bun:"-"`                                                           // statuses corresponding to StatusIDs
+	RuleIDs                []string                `bun:"rules,array"`                                                 // database IDs of any rules referenced by this report
+	Rules                  []*Rule                 `bun:"-"`                                                           // rules corresponding to RuleIDs
+	Forwarded              *bool                   `bun:",nullzero,notnull,default:false"`                             // flag to indicate report should be forwarded to remote instance
+	ActionTaken            string                  `bun:",nullzero"`                                                   // string description of what action was taken in response to this report
+	ActionTakenAt          time.Time               `bun:"type:timestamptz,nullzero"`                                   // time at which action was taken, if any
+	ActionTakenByAccountID string                  `bun:"type:CHAR(26),nullzero"`                                      // database ID of account which took action, if any
+	ActionTakenByAccount   *Account                `bun:"-"`                                                           // account corresponding to ActionTakenByID, if any
+	StatusSnapshots        []*ReportStatusSnapshot `bun:",nullzero"`                                                   // copies of reported statuses taken when they were deleted, if enabled
+}
+
+// ReportStatusSnapshot is a copy of a reported status,
+// taken just before the status was deleted, so that
+// moderators can still see what was reported when
+// handling the report (or an appeal) later on.
+type ReportStatusSnapshot struct {
+	StatusID       string                      `json:"status_id"`                 // database ID of the deleted status
+	URI            string                      `json:"uri"`                       // activitypub URI of the deleted status
+	CreatedAt      time.Time                   `json:"created_at"`                // when the deleted status was created
+	DeletedAt      time.Time                   `json:"deleted_at"`                // when the status was deleted (ie., when this snapshot was taken)
+	ContentWarning string                      `json:"content_warning,omitempty"` // content warning of the deleted status
+	Content        string                      `json:"content"`                   // (html) content of the deleted status
+	Attachments    []*ReportAttachmentSnapshot `json:"attachments,omitempty"`     // metadata of media attached to the deleted status
+}
+
+// ReportAttachmentSnapshot is a copy of the metadata
+// of a media attachment of a deleted reported status.
+type ReportAttachmentSnapshot struct {
+	ID          string `json:"id"`                    // database ID of the attachment
+	Type        string `json:"type"`                  // type of the attachment (image, video, etc)
+	URL         string `json:"url,omitempty"`         // where the attachment was served on this instance
+	RemoteURL   string `json:"remote_url,omitempty"`  // where the attachment was served on a remote instance
+	Description string `json:"description,omitempty"` // description of the attachment
 }
@@ -29,6 +29,7 @@ type Status struct {
 	UpdatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time.
+	ExpiresAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // Optional time at which this (local) status will be automatically deleted.
 	URI                      string             `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                   // web url for viewing this status
 	Content                  string             `bun:""`                                                            // content of this status; likely html-formatted but not guaranteed
@@ -49,6 +50,7 @@ type Status struct {
 	InReplyToAccountID       string             `bun:"type:CHAR(26),nullzero"`                                      // id of the account that this status replies to
 	InReplyTo                *Status            `bun:"-"`                                                           // status corresponding to inReplyToID
 	InReplyToAccount         *Account           `bun:"rel:belongs-to"`                                              // account corresponding to inReplyToAccountID
+	InReplyToBoostID         string             `bun:"type:CHAR(26),nullzero"`                                      // id of the boost wrapper this status was a reply to, before being routed to the boosted status (inReplyToID)
 	BoostOfID                string             `bun:"type:CHAR(26),nullzero"`                                      // id of the status this status is a boost of
 	BoostOfURI               string             `bun:"-"`                                                           // URI of the status this status is a boost of; field not inserted in the db, just for dereferencing purposes.
 	BoostOfAccountID         string             `bun:"type:CHAR(26),nullzero"`                                      // id of the account that owns the boosted status
@@ -70,6 +72,7 @@ type Status struct {
 	PendingApproval          *bool              `bun:",nullzero,notnull,default:false"`                             // If true then status is a reply or boost wrapper that must be Approved by the reply-ee or boost-ee before being fully distributed.
 	PreApproved              bool               `bun:"-"`                                                           // If true, then status is a reply to or boost wrapper of a status on our instance, has permission to do the interaction, and an Accept should be sent out for it immediately. Field not stored in the DB.
 	ApprovedByURI            string             `bun:",nullzero"`                                                   // URI of an Accept Activity that approves the Announce or Create Activity that this status was/will be attached to.
+	Placeholder              *bool              `bun:",nullzero,notnull,default:false"`                             // If true then status is a content-less stand-in for a deleted status with replies, only to be shown in thread views, never on timelines or profiles.
 }
 
 // GetID implements timeline.Timelineable{}.
@@ -212,6 +215,13 @@ func (s *Status) IsLocal() bool {
 	return s.Local != nil && *s.Local
 }
 
+// Expires returns true if this status has
+// an expiry time set, after which it will
+// be automatically deleted.
+func (s *Status) Expires() bool {
+	return !s.ExpiresAt.IsZero()
+}
+
 // StatusToTag is an intermediate struct to facilitate the many2many relationship between a status and one or more tags.
 type StatusToTag struct {
 	StatusID string  `bun:"type:CHAR(26),unique:statustag,nullzero,notnull"`
@@ -228,6 +238,16 @@ type StatusToEmoji struct {
 	Emoji    *Emoji  `bun:"rel:belongs-to"`
 }
 
+// StatusInteractionCounts holds the numbers of
+// stored interactions with a status, as counted
+// together in one database query.
+type StatusInteractionCounts struct {
+	Faves     int // Number of faves of the status.
+	Bookmarks int // Number of bookmarks of the status.
+	Boosts    int // Number of boosts of the status.
+	Replies   int // Number of *direct* replies to the status.
+}
+
 // Visibility represents the visibility granularity of a status.
 type Visibility string
 
@@ -54,6 +54,13 @@ type FromClientAPI struct {
 	// Target is the account that
 	// this message is targeting.
 	Target *gtsmodel.Account
+
+	// OpID identifies the processing
+	// of this message, set when first
+	// processed, so that it's kept when
+	// the message is retried and stats
+	// aren't updated twice.
+	OpID string
 }
 
 // fromClientAPI is an internal type
@@ -68,6 +75,7 @@ type fromClientAPI struct {
 	TargetURI      string          `json:"target_uri,omitempty"`
 	OriginID       string          `json:"origin_id,omitempty"`
 	TargetID       string          `json:"target_id,omitempty"`
+	OpID           string          `json:"op_id,omitempty"`
 }
 
 // Serialize will serialize the worker data as data blob for storage,
@@ -109,6 +117,7 @@ func (msg *FromClientAPI) Serialize() ([]byte, error) {
 		TargetURI:      msg.TargetURI,
 		OriginID:       originID,
 		TargetID:       targetID,
+		OpID:           msg.OpID,
 	})
 }
 
@@ -128,6 +137,7 @@ func (msg *FromClientAPI) Deserialize(data []byte) error {
 	msg.APObjectType = imsg.APObjectType
 	msg.APActivityType = imsg.APActivityType
 	msg.TargetURI = imsg.TargetURI
+	msg.OpID = imsg.OpID
 
 	// Resolve Go type from JSON data.
 	msg.GTSModel, err = resolveGTSModel(
@@ -194,6 +204,13 @@ type FromFediAPI struct {
 	// Local account which owns the inbox
 	// that this Activity was posted to.
 	Receiving *gtsmodel.Account
+
+	// OpID identifies the processing
+	// of this message, set when first
+	// processed, so that it's kept when
+	// the message is retried and stats
+	// aren't updated twice.
+	OpID string
 }
 
 // fromFediAPI is an internal type
@@ -210,6 +227,7 @@ type fromFediAPI struct {
 	TargetURI      string                 `json:"target_uri,omitempty"`
 	RequestingID   string                 `json:"requesting_id,omitempty"`
 	ReceivingID    string                 `json:"receiving_id,omitempty"`
+	OpID           string                 `json:"op_id,omitempty"`
 }
 
 // Serialize will serialize the worker data as data blob for storage,
@@ -269,6 +287,7 @@ func (msg *FromFediAPI) Serialize() ([]byte, error) {
 		TargetURI:      msg.TargetURI,
 		RequestingID:   requestingID,
 		ReceivingID:    receivingID,
+		OpID:           msg.OpID,
 	})
 }
 
@@ -288,6 +307,7 @@ func (msg *FromFediAPI) Deserialize(data []byte) error {
 	msg.APObjectType = imsg.APObjectType
 	msg.APActivityType = imsg.APActivityType
 	msg.TargetURI = imsg.TargetURI
+	msg.OpID = imsg.OpID
 
 	// Resolve AP object from JSON data.
 	msg.APObject, err = resolveAPObject(
@@ -66,6 +66,7 @@ var fromClientAPICases = []struct {
 			TargetURI:      "https://uk-queen-is-dead.org",
 			Origin:         &gtsmodel.Account{ID: "123456"},
 			Target:         &gtsmodel.Account{ID: "654321"},
+			OpID:           "01JA5C5HB0YVQTRF2WZ6F6N5XA",
 		},
 		data: toJSON(map[string]any{
 			"ap_object_type":   ap.ObjectProfile,
@@ -75,6 +76,7 @@ var fromClientAPICases = []struct {
 			"target_uri":       "https://uk-queen-is-dead.org",
 			"origin_id":        "123456",
 			"target_id":        "654321",
+			"op_id":            "01JA5C5HB0YVQTRF2WZ6F6N5XA",
 		}),
 	},
 }
@@ -110,6 +112,7 @@ var fromFediAPICases = []struct {
 			TargetURI:      "https://uk-queen-is-dead.org",
 			Requesting:     &gtsmodel.Account{ID: "123456"},
 			Receiving:      &gtsmodel.Account{ID: "654321"},
+			OpID:           "01JA5C6W5N4R9Q8V7X0ZKJ2M3P",
 		},
 		data: toJSON(map[string]any{
 			"ap_object_type":   ap.ObjectProfile,
@@ -119,6 +122,7 @@ var fromFediAPICases = []struct {
 			"target_uri":       "https://uk-queen-is-dead.org",
 			"requesting_id":    "123456",
 			"receiving_id":     "654321",
+			"op_id":            "01JA5C6W5N4R9Q8V7X0ZKJ2M3P",
 		}),
 	},
 }
@@ -153,6 +157,7 @@ func TestDeserializeFromClientAPI(t *testing.T) {
 		assertEqual(t, test.msg.TargetURI, msg.TargetURI)
 		assertEqual(t, accountID(test.msg.Origin), accountID(msg.Origin))
 		assertEqual(t, accountID(test.msg.Target), accountID(msg.Target))
+		assertEqual(t, test.msg.OpID, msg.OpID)
 
 		// Perform final check to ensure
 		// account model keys deserialized.
@@ -192,6 +197,7 @@ func TestDeserializeFromFediAPI(t *testing.T) {
 		assertEqual(t, test.msg.TargetURI, msg.TargetURI)
 		assertEqual(t, accountID(test.msg.Receiving), accountID(msg.Receiving))
 		assertEqual(t, accountID(test.msg.Requesting), accountID(msg.Requesting))
+		assertEqual(t, test.msg.OpID, msg.OpID)
 
 		// Perform final check to ensure
 		// account model keys deserialized.
@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package metrics
+
+import "sync/atomic"
+
+var (
+	// Counts of interactions approved by this
+	// instance since startup, split by whether
+	// they were pre-approved automatically (eg.,
+	// due to matching a following/followers
+	// collection), or manually approved.
+	autoApprovedInteractions   atomic.Int64
+	manualApprovedInteractions atomic.Int64
+)
+
+// IncAutoApprovedInteractions increments the
+// count of automatically approved interactions.
+func IncAutoApprovedInteractions() {
+	autoApprovedInteractions.Add(1)
+}
+
+// IncManualApprovedInteractions increments the
+// count of manually approved interactions.
+func IncManualApprovedInteractions() {
+	manualApprovedInteractions.Add(1)
+}
+
+// AutoApprovedInteractions returns the count
+// of automatically approved interactions.
+func AutoApprovedInteractions() int64 {
+	return autoApprovedInteractions.Load()
+}
+
+// ManualApprovedInteractions returns the count
+// of manually approved interactions.
+func ManualApprovedInteractions() int64 {
+	return manualApprovedInteractions.Load()
+}
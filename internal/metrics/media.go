@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package metrics
+
+import "sync/atomic"
+
+var (
+	// Count of media attachments since startup
+	// whose database row was deleted, but whose
+	// file(s) could not be removed from storage,
+	// ie., files now leaked in storage.
+	orphanedMedia atomic.Int64
+)
+
+// IncOrphanedMedia increments the count of
+// attachments left with files in storage.
+func IncOrphanedMedia() {
+	orphanedMedia.Add(1)
+}
+
+// OrphanedMedia returns the count of
+// attachments left with files in storage.
+func OrphanedMedia() int64 {
+	return orphanedMedia.Load()
+}
@@ -124,6 +124,54 @@ func Initialize(db db.DB) error {
 		return err
 	}
 
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.instance.auto_approved_interactions",
+		metric.WithDescription("Number of interactions automatically approved by this instance since startup"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(AutoApprovedInteractions())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.instance.manual_approved_interactions",
+		metric.WithDescription("Number of interactions manually approved by this instance since startup"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(ManualApprovedInteractions())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.media.orphaned_attachments",
+		metric.WithDescription("Number of media attachments deleted since startup whose files could not be removed from storage"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(OrphanedMedia())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Int64ObservableCounter(
+		"gotosocial.moves.self_follows_skipped",
+		metric.WithDescription("Number of followers skipped since startup when redirecting a Move, because they were the Move target itself"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(MoveSelfFollowsSkipped())
+			return nil
+		}),
+	)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,84 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !nometrics
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RecordMove records the total time taken to
+// migrate followers for one account Move, as
+// well as the average time taken per follower,
+// labeled by a bucket of the follower count.
+//
+// Instruments are fetched from the global meter
+// provider on each call, so this is a no-op
+// unless metrics have been initialized.
+func RecordMove(ctx context.Context, followers int, elapsed time.Duration) {
+	meter := otel.Meter(serviceName)
+	attrs := metric.WithAttributes(
+		attribute.String("followers", followersBucket(followers)),
+	)
+
+	total, err := meter.Float64Histogram(
+		"gotosocial.moves.duration",
+		metric.WithDescription("Time taken to migrate all followers for one account Move"),
+		metric.WithUnit("s"),
+	)
+	if err == nil {
+		total.Record(ctx, elapsed.Seconds(), attrs)
+	}
+
+	if followers == 0 {
+		// Nothing to average.
+		return
+	}
+
+	perFollower, err := meter.Float64Histogram(
+		"gotosocial.moves.follower_duration",
+		metric.WithDescription("Average time taken to migrate one follower during an account Move"),
+		metric.WithUnit("s"),
+	)
+	if err == nil {
+		perFollower.Record(ctx, elapsed.Seconds()/float64(followers), attrs)
+	}
+}
+
+// followersBucket returns a coarse, low
+// cardinality label for a follower count.
+func followersBucket(followers int) string {
+	switch {
+	case followers == 0:
+		return "0"
+	case followers <= 10:
+		return "1-10"
+	case followers <= 100:
+		return "11-100"
+	case followers <= 1000:
+		return "101-1000"
+	default:
+		return "1000+"
+	}
+}
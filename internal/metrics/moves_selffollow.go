@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package metrics
+
+import "sync/atomic"
+
+var (
+	// Count of followers skipped since startup
+	// when redirecting followers for an account
+	// Move, because the follower was the Move
+	// target itself. A high count may indicate
+	// data issues in stored follows.
+	moveSelfFollowsSkipped atomic.Int64
+)
+
+// IncMoveSelfFollowsSkipped increments the count of
+// Move target self-follows skipped when redirecting.
+func IncMoveSelfFollowsSkipped() {
+	moveSelfFollowsSkipped.Add(1)
+}
+
+// MoveSelfFollowsSkipped returns the count of Move
+// target self-follows skipped when redirecting.
+func MoveSelfFollowsSkipped() int64 {
+	return moveSelfFollowsSkipped.Load()
+}
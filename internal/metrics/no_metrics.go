@@ -20,7 +20,9 @@
 package metrics
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -42,3 +44,5 @@ func InstrumentGin() gin.HandlerFunc {
 func InstrumentBun() bun.QueryHook {
 	return nil
 }
+
+func RecordMove(ctx context.Context, followers int, elapsed time.Duration) {}
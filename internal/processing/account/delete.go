@@ -51,6 +51,12 @@ func (p *Processor) Delete(
 	}...)
 	l.Trace("beginning account delete process")
 
+	// Clear boosts of others' statuses out of the way first,
+	// so they disappear from timelines as soon as possible.
+	if err := p.deleteAccountBoosts(ctx, account); err != nil {
+		l.Errorf("continuing after error during account delete: %v", err)
+	}
+
 	// Delete statuses *before* follows to ensure correct addressing
 	// of any outgoing fedi messages generated by deleting statuses.
 	if err := p.deleteAccountStatuses(ctx, account); err != nil {
@@ -300,6 +306,44 @@ func (p *Processor) deleteAccountBlocks(ctx context.Context, account *gtsmodel.A
 	return nil
 }
 
+// deleteAccountBoosts passes all boosts authored by the
+// given account to the processor workers to be undone,
+// which wipes them and federates an Undo of local ones.
+func (p *Processor) deleteAccountBoosts(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) error {
+	boostIDs, err := p.state.DB.GetBoostIDsByAccount(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting boosts: %w", err)
+	}
+
+	for _, boostID := range boostIDs {
+		boost, err := p.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			boostID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "db error getting boost %s: %v", boostID, err)
+			continue
+		}
+
+		// Process in serial, so all boosts are
+		// gone before the account's statuses
+		// are paged through for deletion.
+		if err := p.state.Workers.Client.Process(ctx, &messages.FromClientAPI{
+			APObjectType:   ap.ActivityAnnounce,
+			APActivityType: ap.ActivityUndo,
+			GTSModel:       boost,
+			Origin:         account,
+		}); err != nil {
+			log.Errorf(ctx, "error processing Undo of boost %s: %v", boostID, err)
+		}
+	}
+
+	return nil
+}
+
 // deleteAccountStatuses iterates through all statuses owned by
 // the given account, passing each discovered status (and boosts
 // thereof) to the processor workers for further processing.
@@ -347,6 +391,21 @@ statusLoop:
 		// Update next maxID from last status.
 		maxID = statuses[len(statuses)-1].ID
 
+		// Clear notifications relating to this page of
+		// statuses in one go, rather than leaving it to
+		// the Delete of each status to do one at a time.
+		statusIDs := util.Gather(nil, statuses, func(s *gtsmodel.Status) string { return s.ID })
+		notifiedIDs, err := p.state.DB.DeleteNotificationsForStatuses(ctx, statusIDs)
+		if err != nil {
+			return gtserror.Newf("error deleting notifications for statuses: %w", err)
+		}
+
+		// Notified accounts may have read up
+		// to one of the deleted notifications,
+		// so make sure their unread position
+		// still points at a notification.
+		p.rollbackNotificationMarkers(ctx, notifiedIDs)
+
 		for _, status := range statuses {
 			// Ensure account is set.
 			status.Account = account
@@ -388,7 +447,7 @@ statusLoop:
 				msgs = append(msgs, &messages.FromClientAPI{
 					APObjectType:   ap.ActivityAnnounce,
 					APActivityType: ap.ActivityUndo,
-					GTSModel:       status,
+					GTSModel:       boost,
 					Origin:         boost.Account,
 					Target:         account,
 				})
@@ -419,6 +478,71 @@ statusLoop:
 	return nil
 }
 
+// rollbackNotificationMarkers updates the notifications marker
+// of each of the given accounts, if its last read notification
+// has been deleted, to point at the newest notification preceding
+// it instead, so unread notifications are still counted from a
+// notification the account can actually see.
+func (p *Processor) rollbackNotificationMarkers(
+	ctx context.Context,
+	accountIDs []string,
+) {
+	for _, accountID := range accountIDs {
+		marker, err := p.state.DB.GetMarker(ctx,
+			accountID,
+			gtsmodel.MarkerNameNotifications,
+		)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "db error getting notifications marker for account %s: %v", accountID, err)
+			}
+			continue
+		}
+
+		_, err = p.state.DB.GetNotificationByID(
+			gtscontext.SetBarebones(ctx),
+			marker.LastReadID,
+		)
+		if err == nil {
+			// Last read notification
+			// still exists, leave as-is.
+			continue
+		} else if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting notification %s: %v", marker.LastReadID, err)
+			continue
+		}
+
+		// Get the notification directly
+		// before the deleted last read one.
+		prev, err := p.state.DB.GetAccountNotifications(
+			gtscontext.SetBarebones(ctx),
+			accountID,
+			marker.LastReadID, // maxID
+			"",                // sinceID
+			"",                // minID
+			1,                 // limit
+			nil,               // types
+			nil,               // excludeTypes
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting notifications for account %s: %v", accountID, err)
+			continue
+		}
+
+		if len(prev) == 0 {
+			// Nothing
+			// to roll
+			// back to.
+			continue
+		}
+
+		marker.LastReadID = prev[0].ID
+		if err := p.state.DB.UpdateMarker(ctx, marker); err != nil {
+			log.Errorf(ctx, "db error updating notifications marker for account %s: %v", accountID, err)
+		}
+	}
+}
+
 func (p *Processor) deleteAccountNotifications(ctx context.Context, account *gtsmodel.Account) error {
 	// Delete all notifications of all types targeting given account.
 	if err := p.state.DB.DeleteNotifications(ctx, nil, account.ID, ""); err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -480,6 +604,25 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return gtserror.Newf("error deleting followed tags by account: %w", err)
 	}
 
+	// Delete all interaction approvals of interactions by given
+	// account, since the interactions themselves are now gone.
+	approvalIDs, err := p.state.DB.DeleteInteractionApprovalsByInteractingAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting interaction approvals by account: %w", err)
+	}
+
+	// Cancel any scheduled expiries
+	// of the deleted approvals.
+	for _, approvalID := range approvalIDs {
+		_ = p.state.Workers.Scheduler.Cancel(gtsmodel.ApprovalExpiryID(approvalID))
+	}
+
+	// Delete all interaction rejections recorded by given account.
+	if err := p.state.DB.DeleteInteractionRejectionsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error deleting interaction rejections by account: %w", err)
+	}
+
 	// Delete account stats model.
 	if err := p.state.DB.DeleteAccountStats(ctx, account.ID); err != nil {
 		return gtserror.Newf("error deleting stats for account: %w", err)
@@ -24,7 +24,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type AccountDeleteTestSuite struct {
@@ -87,6 +89,104 @@ func (suite *AccountDeleteTestSuite) TestAccountDeleteLocal() {
 	suite.Zero(updatedUser.ResetPasswordSentAt)
 }
 
+func (suite *AccountDeleteTestSuite) TestAccountDeletePrunesInteractionApprovals() {
+	ctx := context.Background()
+
+	var (
+		// Account whose interaction was approved.
+		ogAccount = suite.testAccounts["local_account_1"]
+
+		// Account that approved the interaction.
+		approver = suite.testAccounts["admin_account"]
+	)
+
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *ogAccount
+
+	// Put an approval of an interaction by the account.
+	approval := &gtsmodel.InteractionApproval{
+		ID:                   "01J9ZHQ1N2SV6JTBSGKFRBWS2C",
+		AccountID:            approver.ID,
+		InteractingAccountID: testAccount.ID,
+		InteractionURI:       testAccount.URI + "/statuses/01J9ZHQ1N2SV6JTBSGKFRBWS2C",
+		InteractionType:      gtsmodel.InteractionReply,
+		URI:                  approver.URI + "/accepts/01J9ZHQ1N2SV6JTBSGKFRBWS2C",
+	}
+	if err := suite.db.PutInteractionApproval(ctx, approval); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suspensionOrigin := "01GWVP2A8J38Q2J2FDZ6TS8AQG"
+	if err := suite.accountProcessor.Delete(ctx, testAccount, suspensionOrigin); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Approval should now be gone.
+	_, err := suite.db.GetInteractionApprovalByID(ctx, approval.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *AccountDeleteTestSuite) TestAccountDeleteRollsBackNotificationMarkers() {
+	ctx := context.Background()
+
+	var (
+		// Account being deleted.
+		ogAccount = suite.testAccounts["local_account_2"]
+
+		// Account that was notified about one of its statuses.
+		notified = suite.testAccounts["local_account_1"]
+
+		// Last read notification before the new one.
+		prevMarker = testrig.NewTestMarkers()["local_account_1_notification_marker"]
+	)
+
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *ogAccount
+
+	// Notify zork of a mention in turtle's
+	// status, and mark it as read by zork.
+	notif := &gtsmodel.Notification{
+		ID:               "01J5QJ1A3HQ2FX0Z8W6J1TSE7R",
+		NotificationType: gtsmodel.NotificationMention,
+		TargetAccountID:  notified.ID,
+		OriginAccountID:  suite.testAccounts["admin_account"].ID,
+		StatusID:         suite.testStatuses["local_account_2_status_1"].ID,
+	}
+	if err := suite.db.PutNotification(ctx, notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	marker, err := suite.db.GetMarker(ctx, notified.ID, gtsmodel.MarkerNameNotifications)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	marker.LastReadID = notif.ID
+	if err := suite.db.UpdateMarker(ctx, marker); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suspensionOrigin := "01GWVP2A8J38Q2J2FDZ6TS8AQG"
+	if err := suite.accountProcessor.Delete(ctx, testAccount, suspensionOrigin); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Notification should be gone.
+	_, err = suite.db.GetNotificationByID(ctx, notif.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// And zork's marker should be back
+	// to a notification that still exists.
+	marker, err = suite.db.GetMarker(ctx, notified.ID, gtsmodel.MarkerNameNotifications)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEqual(notif.ID, marker.LastReadID)
+	suite.GreaterOrEqual(marker.LastReadID, prevMarker.LastReadID)
+
+	_, err = suite.db.GetNotificationByID(ctx, marker.LastReadID)
+	suite.NoError(err)
+}
+
 func TestAccountDeleteTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountDeleteTestSuite))
 }
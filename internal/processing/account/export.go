@@ -157,3 +157,29 @@ func (p *Processor) ExportMutes(
 
 	return records, nil
 }
+
+// ExportInteractionApprovals returns the history of
+// approvals sent by the requester in response to
+// interaction requests, oldest first.
+//
+// Rejected interactions are removed rather than
+// stored, so only approvals can be exported.
+func (p *Processor) ExportInteractionApprovals(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) ([]*apimodel.InteractionApprovalExport, gtserror.WithCode) {
+	approvals, err := p.state.DB.GetInteractionApprovalsByAccountID(ctx, requester.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting interaction approvals: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Convert approvals to privacy-respecting export models.
+	exports, err := p.converter.InteractionApprovalsToExport(ctx, approvals)
+	if err != nil {
+		err = gtserror.Newf("error converting interaction approvals: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return exports, nil
+}
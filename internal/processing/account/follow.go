@@ -290,17 +290,17 @@ func (p *Processor) unfollow(ctx context.Context, requestingAccount *gtsmodel.Ac
 			return msgs, nil
 		}
 
-		// Follow status changed, process side effects.
+		// Follow request withdrawn, process side effects.
+		//
+		// Pass the follow request itself (rather than a
+		// follow) so that the worker knows to adjust the
+		// target's follow requests count, not followers.
 		msgs = append(msgs, &messages.FromClientAPI{
 			APObjectType:   ap.ActivityFollow,
 			APActivityType: ap.ActivityUndo,
-			GTSModel: &gtsmodel.Follow{
-				AccountID:       requestingAccount.ID,
-				TargetAccountID: targetAccount.ID,
-				URI:             followReq.URI,
-			},
-			Origin: requestingAccount,
-			Target: targetAccount,
+			GTSModel:       followReq,
+			Origin:         requestingAccount,
+			Target:         targetAccount,
 		})
 	}
 
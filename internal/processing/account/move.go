@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/url"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -33,7 +34,9 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"golang.org/x/crypto/bcrypt"
@@ -339,6 +342,583 @@ func (p *Processor) MoveSelf(
 	return nil
 }
 
+// MoveSelfRetry retries redirection of the requesting
+// account's local followers to the target of its Move.
+//
+// This is useful where a Move has completed but some
+// followers failed to be migrated to the target.
+func (p *Processor) MoveSelfRetry(
+	ctx context.Context,
+	authed *oauth.Auth,
+) (*apimodel.AccountMoveRetryResponse, gtserror.WithCode) {
+	originAcct := authed.Account
+
+	// Only Moves that have been previously
+	// processed can be retried, so ensure
+	// there's actually a Move stored.
+	if originAcct.MoveID == "" || originAcct.MovedToURI == "" {
+		const text = "your account has not Moved; nothing to retry"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Get a lock on this account so we're
+	// not racing with another Move (retry).
+	lockKey := originAcct.URI
+	unlock := p.state.ProcessingLocks.Lock(lockKey)
+	defer unlock()
+
+	move := originAcct.Move
+	if move == nil {
+		var err error
+		move, err = p.state.DB.GetMoveByID(ctx, originAcct.MoveID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting move %s: %w", originAcct.MoveID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if move == nil {
+			const text = "your account has not Moved; nothing to retry"
+			return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+	}
+
+	if move.OriginURI != originAcct.URI ||
+		move.TargetURI != originAcct.MovedToURI {
+		const text = "existing stored Move contains invalid fields"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if move.SucceededAt.IsZero() {
+		const text = "your account Move has not yet completed; " +
+			"please wait for it to complete before retrying"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Fetch the target account from the
+	// db, we should already have it stored.
+	targetAcct, err := p.state.DB.GetAccountByURI(ctx, move.TargetURI)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting target account %s: %w", move.TargetURI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAcct == nil {
+		text := fmt.Sprintf("target account %s could not be found", move.TargetURI)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	if !targetAcct.SuspendedAt.IsZero() {
+		text := fmt.Sprintf(
+			"target account %s is suspended from this instance; "+
+				"your followers cannot be migrated to that account",
+			targetAcct.URI,
+		)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Target account must still be aliased
+	// back to this account for the Move to
+	// be considered valid.
+	if !targetAcct.IsAliasedTo(originAcct.URI) {
+		text := fmt.Sprintf(
+			"target account %s is no longer aliased to this account via alsoKnownAs",
+			targetAcct.URI,
+		)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Redirect any local followers
+	// that still follow this account.
+	result, err := p.RedirectFollowers(ctx, move, originAcct, targetAcct, nil)
+	if err != nil {
+		if result.Failed == 0 {
+			// Couldn't even get
+			// started redirecting.
+			err := gtserror.Newf("error redirecting followers: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Some followers failed, the caller
+		// can see how many and retry again.
+		log.Errorf(ctx, "error(s) redirecting followers: %v", err)
+	}
+
+	return &apimodel.AccountMoveRetryResponse{
+		Migrated: result.Migrated,
+		Failed:   result.Failed,
+	}, nil
+}
+
+// MoveSelfCancel cancels the requesting account's Move,
+// provided it hasn't completed yet, ie., the Move hasn't
+// been sent out to remote followers. Redirection of local
+// followers stops at the next follower; followers already
+// migrated to the target can't be migrated back, though.
+func (p *Processor) MoveSelfCancel(
+	ctx context.Context,
+	authed *oauth.Auth,
+) gtserror.WithCode {
+	originAcct := authed.Account
+
+	if originAcct.MoveID == "" || originAcct.MovedToURI == "" {
+		const text = "your account is not Moving; nothing to cancel"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Get a lock on this account so we're
+	// not racing with another Move (retry).
+	lockKey := originAcct.URI
+	unlock := p.state.ProcessingLocks.Lock(lockKey)
+	defer unlock()
+
+	move := originAcct.Move
+	if move == nil {
+		var err error
+		move, err = p.state.DB.GetMoveByID(ctx, originAcct.MoveID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting move %s: %w", originAcct.MoveID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if move != nil && !move.SucceededAt.IsZero() {
+		const text = "your account Move has already completed; " +
+			"it can no longer be cancelled"
+		return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Clear the Move from the account. Any
+	// in-progress processing of the Move
+	// checks for this, and stops when seen.
+	originAcct.MoveID = ""
+	originAcct.Move = nil
+	originAcct.MovedToURI = ""
+	originAcct.MovedTo = nil
+	if err := p.state.DB.UpdateAccount(
+		ctx,
+		originAcct,
+		"move_id",
+		"moved_to_uri",
+	); err != nil {
+		err := gtserror.Newf("db error updating account: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if move != nil {
+		// Keep the Move itself + its events
+		// around for admins, noting it was
+		// cancelled rather than completed.
+		if err := p.state.DB.PutMoveEvent(ctx, &gtsmodel.MoveEvent{
+			ID:        id.NewULID(),
+			CreatedAt: time.Now(),
+			MoveID:    move.ID,
+			Phase:     gtsmodel.MoveEventCancelled,
+		}); err != nil {
+			log.Errorf(ctx, "db error storing cancelled event for move %s: %v", move.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// MoveCancelled returns whether the given Move of the given
+// local account was cancelled since it was started, ie., the
+// account's stored Move is no longer the given Move.
+func (p *Processor) MoveCancelled(
+	ctx context.Context,
+	originAcct *gtsmodel.Account,
+	move *gtsmodel.Move,
+) (bool, error) {
+	if !originAcct.IsLocal() {
+		// Only local Moves
+		// can be cancelled.
+		return false, nil
+	}
+
+	// Get a fresh copy of the account,
+	// passed model might be out of date.
+	current, err := p.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		originAcct.ID,
+	)
+	if err != nil {
+		return false, gtserror.Newf("db error getting account %s: %w", originAcct.ID, err)
+	}
+
+	return current.MoveID != move.ID, nil
+}
+
+// RedirectResult summarizes the outcome
+// of a call to RedirectFollowers.
+type RedirectResult struct {
+	Migrated int // Number of followers redirected to the target.
+	Failed   int // Number of followers that couldn't be redirected.
+}
+
+// RedirectFollowers redirects all local
+// followers of originAcct to targetAcct,
+// returning how many followers were and
+// weren't successfully redirected.
+//
+// Both accounts must be fully dereferenced
+// already, and the Move must be valid.
+//
+// Since only followers still following
+// originAcct are selected, this can be
+// called again to resume a partial Move,
+// picking up only those followers that
+// weren't redirected yet. A follower who
+// already has a follow (request) of the
+// target isn't sent a new one.
+//
+// An error redirecting one follower does
+// not stop the others being redirected;
+// such errors are all returned together.
+//
+// If onRedirected is not nil, it will be
+// called with each old follow once it's
+// been processed, along with the error
+// redirecting it, if any.
+//
+// If move is not nil, the ID of each follow
+// processed is stored on it as LastFollowID,
+// so that a pass interrupted part way, eg.,
+// by a restart or by cancelling the Move,
+// resumes after that follow when called
+// again. The cursor is cleared once a pass
+// completes, so followers that failed get
+// another go on the next pass.
+//
+// If move is cancelled while redirecting,
+// this stops before the next follower,
+// returning the result so far.
+func (p *Processor) RedirectFollowers(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	originAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	onRedirected func(*gtsmodel.Follow, error),
+) (RedirectResult, error) {
+	var result RedirectResult
+
+	// Any local followers of originAcct should
+	// send follow requests to targetAcct instead,
+	// and have followers of originAcct removed.
+	//
+	// Select local followers with barebones, since
+	// we only need follow.Account and we can get
+	// that ourselves.
+	followers, err := p.state.DB.GetAccountLocalFollowers(
+		gtscontext.SetBarebones(ctx),
+		originAcct.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return result, gtserror.Newf("db error getting follows targeting originAcct: %w", err)
+	}
+
+	// Go through follows in ID order,
+	// so the stored cursor makes sense.
+	slices.SortFunc(followers, func(a, b *gtsmodel.Follow) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	if move != nil && move.LastFollowID != "" {
+		// Resume after the last follow processed
+		// by an interrupted pass. Any follows up
+		// to it that are still there failed, and
+		// will be picked up on the next pass.
+		followers = slices.DeleteFunc(followers, func(follow *gtsmodel.Follow) bool {
+			return follow.ID <= move.LastFollowID
+		})
+	}
+
+	// Fetch the local accounts that own the
+	// follows in one go, rather than one by one.
+	if err := p.populateFollowAccounts(ctx, followers); err != nil {
+		return result, err
+	}
+
+	var errs gtserror.MultiError
+	for _, follow := range followers {
+		if move != nil {
+			// Stop if the Move was cancelled
+			// since we started redirecting.
+			cancelled, err := p.MoveCancelled(ctx, originAcct, move)
+			if err != nil {
+				errs.Append(err)
+				return result, errs.Combine()
+			}
+
+			if cancelled {
+				// Leave the cursor
+				// where it is.
+				return result, errs.Combine()
+			}
+		}
+
+		err := p.redirectFollower(ctx, follow, targetAcct)
+		if err != nil {
+			// Note the error and
+			// carry on with the rest.
+			errs.Append(err)
+			result.Failed++
+		} else {
+			result.Migrated++
+		}
+
+		if onRedirected != nil {
+			onRedirected(follow, err)
+		}
+
+		if move != nil {
+			// Store progress so an
+			// interrupted pass resumes.
+			if err := p.setMoveCursor(ctx, move, follow.ID); err != nil {
+				errs.Append(err)
+			}
+		}
+	}
+
+	if move != nil && move.LastFollowID != "" {
+		// Pass completed,
+		// clear the cursor.
+		if err := p.setMoveCursor(ctx, move, ""); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return result, errs.Combine()
+}
+
+// setMoveCursor stores the given follow ID
+// as the last one processed while redirecting
+// followers for move, or clears it if empty.
+func (p *Processor) setMoveCursor(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	followID string,
+) error {
+	move.LastFollowID = followID
+	if err := p.state.DB.UpdateMove(ctx, move, "last_follow_id"); err != nil {
+		return gtserror.Newf("db error updating move %s: %w", move.ID, err)
+	}
+	return nil
+}
+
+// populateFollowAccounts sets barebones follow.Account
+// on each of the given follows, fetching the accounts
+// with a single batch call. Follows whose account
+// couldn't be found are left with a nil Account.
+func (p *Processor) populateFollowAccounts(
+	ctx context.Context,
+	follows []*gtsmodel.Follow,
+) error {
+	if len(follows) == 0 {
+		// Nothing
+		// to do.
+		return nil
+	}
+
+	accountIDs := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		accountIDs = append(accountIDs, follow.AccountID)
+	}
+
+	accounts, err := p.state.DB.GetAccountsByIDs(
+		gtscontext.SetBarebones(ctx),
+		accountIDs,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting follow accounts: %w", err)
+	}
+
+	accountsByID := make(map[string]*gtsmodel.Account, len(accounts))
+	for _, account := range accounts {
+		accountsByID[account.ID] = account
+	}
+
+	for _, follow := range follows {
+		follow.Account = accountsByID[follow.AccountID]
+	}
+
+	return nil
+}
+
+// RedirectPreview summarizes what a call to
+// RedirectFollowers would do, without doing it.
+type RedirectPreview struct {
+	Recreated       int                // Number of followers that would follow (or request to follow) the target.
+	Removed         int                // Number of old follows of the origin that would be removed.
+	Failed          int                // Number of followers that couldn't be redirected.
+	SkippedSelf     []*gtsmodel.Follow // Follows of the origin by the target itself, which wouldn't be recreated.
+	SkippedExisting []*gtsmodel.Follow // Follows by accounts already following (or requesting to follow) the target.
+}
+
+// RedirectFollowersDryRun previews redirecting all
+// local followers of originAcct to targetAcct, as
+// RedirectFollowers would, returning a summary of
+// what would happen, eg., to preview a Move.
+//
+// Each follower is validated as for a real redirect,
+// but no follows are created or removed, and nothing
+// is federated. Followers that would fail validation
+// are counted as failed, rather than returned as
+// errors; only database errors are returned.
+func (p *Processor) RedirectFollowersDryRun(
+	ctx context.Context,
+	originAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+) (RedirectPreview, error) {
+	var preview RedirectPreview
+
+	// Select local followers as RedirectFollowers does.
+	followers, err := p.state.DB.GetAccountLocalFollowers(
+		gtscontext.SetBarebones(ctx),
+		originAcct.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return preview, gtserror.Newf("db error getting follows targeting originAcct: %w", err)
+	}
+
+	if err := p.populateFollowAccounts(ctx, followers); err != nil {
+		return preview, err
+	}
+
+	for _, follow := range followers {
+		if follow.Account == nil {
+			// Would fail
+			// to redirect.
+			preview.Failed++
+			continue
+		}
+
+		if follow.AccountID == targetAcct.ID {
+			// Self-follow: not recreated,
+			// but the old follow is removed.
+			preview.SkippedSelf = append(preview.SkippedSelf, follow)
+			preview.Removed++
+			continue
+		}
+
+		// Validate the new follow as FollowCreate
+		// would, eg., checking for blocks.
+		if _, errWithCode := p.getFollowTarget(
+			ctx,
+			follow.Account,
+			targetAcct.ID,
+		); errWithCode != nil {
+			// Would fail
+			// to redirect.
+			preview.Failed++
+			continue
+		}
+
+		existing, err := p.followsOrRequested(ctx, follow.AccountID, targetAcct.ID)
+		if err != nil {
+			return preview, err
+		}
+
+		if existing {
+			// Existing follow (request) is
+			// just updated, not recreated.
+			preview.SkippedExisting = append(preview.SkippedExisting, follow)
+		} else {
+			preview.Recreated++
+		}
+		preview.Removed++
+	}
+
+	return preview, nil
+}
+
+// followsOrRequested returns whether the account with
+// sourceID already follows, or has requested to follow,
+// the account with targetID.
+func (p *Processor) followsOrRequested(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+) (bool, error) {
+	following, err := p.state.DB.IsFollowing(ctx, sourceID, targetID)
+	if err != nil {
+		return false, gtserror.Newf("db error checking follow: %w", err)
+	}
+
+	if following {
+		return true, nil
+	}
+
+	requested, err := p.state.DB.IsFollowRequested(ctx, sourceID, targetID)
+	if err != nil {
+		return false, gtserror.Newf("db error checking follow request: %w", err)
+	}
+
+	return requested, nil
+}
+
+// redirectFollower redirects the given local
+// follow of a Move origin to targetAcct,
+// following targetAcct with the follow's
+// account and removing the old follow.
+func (p *Processor) redirectFollower(
+	ctx context.Context,
+	follow *gtsmodel.Follow,
+	targetAcct *gtsmodel.Account,
+) error {
+	// The local account that owns the follow
+	// targeting originAcct should've been set.
+	if follow.Account == nil {
+		return gtserror.Newf("follow account %s not found", follow.AccountID)
+	}
+
+	// Use the FollowCreate function to send
+	// off the new follow, carrying over the
+	// Reblogs and Notify values from the old
+	// follow to the new.
+	//
+	// This will also handle cases where our
+	// account has already followed the target
+	// account, (eg., during an earlier attempt
+	// of this Move), by just updating the
+	// existing follow of target account.
+	//
+	// Also, ensure new follow wouldn't be a
+	// self follow, since that will error.
+	if follow.AccountID == targetAcct.ID {
+		// The target itself followed the origin
+		// account. Not an error, but worth keeping
+		// count of as it may point to bad data.
+		log.Debugf(ctx,
+			"skipping self-follow of move target %s (follow %s of %s)",
+			targetAcct.ID, follow.ID, follow.TargetAccountID,
+		)
+		metrics.IncMoveSelfFollowsSkipped()
+	} else if _, errWithCode := p.FollowCreate(
+		ctx,
+		follow.Account,
+		&apimodel.AccountFollowRequest{
+			ID:      targetAcct.ID,
+			Reblogs: follow.ShowReblogs,
+			Notify:  follow.Notify,
+		},
+	); errWithCode != nil {
+		return gtserror.Newf("error creating new follow for account %s: %w", follow.AccountID, errWithCode)
+	}
+
+	// New follow is in the process of
+	// sending, remove the existing follow.
+	// This will send out an Undo Activity for each Follow.
+	if _, errWithCode := p.FollowRemove(
+		ctx,
+		follow.Account,
+		follow.TargetAccountID,
+	); errWithCode != nil {
+		return gtserror.Newf("error removing old follow for account %s: %w", follow.AccountID, errWithCode)
+	}
+
+	return nil
+}
+
 // checkMoveRecursion checks that a move from origin to target would
 // not cause a loop of account moved_from_uris pointing in a loop.
 func (p *Processor) checkMoveRecursion(
@@ -19,12 +19,15 @@ package account_test
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -164,6 +167,523 @@ func (suite *MoveTestSuite) TestMoveAccountBadPassword() {
 	suite.EqualError(err, "invalid password provided in Move request")
 }
 
+func (suite *MoveTestSuite) TestMoveAccountRetry() {
+	ctx := context.Background()
+
+	// Copy zork.
+	requestingAcct := new(gtsmodel.Account)
+	*requestingAcct = *suite.testAccounts["local_account_1"]
+
+	// Copy admin.
+	targetAcct := new(gtsmodel.Account)
+	*targetAcct = *suite.testAccounts["admin_account"]
+
+	// Update admin to alias back to zork.
+	targetAcct.AlsoKnownAsURIs = []string{requestingAcct.URI}
+	if err := suite.state.DB.UpdateAccount(
+		ctx,
+		targetAcct,
+		"also_known_as_uris",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Store a completed Move from zork to admin.
+	now := time.Now()
+	move := &gtsmodel.Move{
+		ID:          "01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+		AttemptedAt: now,
+		SucceededAt: now,
+		OriginURI:   requestingAcct.URI,
+		TargetURI:   targetAcct.URI,
+		URI:         requestingAcct.URI + "/moves/01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+	}
+	if err := suite.state.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestingAcct.MoveID = move.ID
+	requestingAcct.MovedToURI = targetAcct.URI
+	if err := suite.state.DB.UpdateAccount(
+		ctx,
+		requestingAcct,
+		"move_id",
+		"moved_to_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The local followers of zork (1happyturtle
+	// and admin) were never migrated, as if the
+	// Move partially failed. Retry the Move.
+	resp, errWithCode := suite.accountProcessor.MoveSelfRetry(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(2, resp.Migrated)
+
+	// 1happyturtle should no longer follow zork,
+	// and should have requested to follow admin.
+	follower := suite.testAccounts["local_account_2"]
+	following, err := suite.state.DB.IsFollowing(ctx, follower.ID, requestingAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(following)
+
+	requested, err := suite.state.DB.IsFollowRequested(ctx, follower.ID, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(requested)
+
+	// Retrying again should be
+	// a no-op now all migrated.
+	resp, errWithCode = suite.accountProcessor.MoveSelfRetry(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Zero(resp.Migrated)
+}
+
+func (suite *MoveTestSuite) TestMoveAccountRetryPartialFailure() {
+	ctx := context.Background()
+
+	// Copy zork.
+	requestingAcct := new(gtsmodel.Account)
+	*requestingAcct = *suite.testAccounts["local_account_1"]
+
+	// Copy admin.
+	targetAcct := new(gtsmodel.Account)
+	*targetAcct = *suite.testAccounts["admin_account"]
+
+	// Update admin to alias back to zork.
+	targetAcct.AlsoKnownAsURIs = []string{requestingAcct.URI}
+	if err := suite.state.DB.UpdateAccount(
+		ctx,
+		targetAcct,
+		"also_known_as_uris",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Store a completed Move from zork to admin.
+	now := time.Now()
+	move := &gtsmodel.Move{
+		ID:          "01JA9ZK5S1C2Q8R7WJ5P5B4D3E",
+		AttemptedAt: now,
+		SucceededAt: now,
+		OriginURI:   requestingAcct.URI,
+		TargetURI:   targetAcct.URI,
+		URI:         requestingAcct.URI + "/moves/01JA9ZK5S1C2Q8R7WJ5P5B4D3E",
+	}
+	if err := suite.state.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestingAcct.MoveID = move.ID
+	requestingAcct.MovedToURI = targetAcct.URI
+	if err := suite.state.DB.UpdateAccount(
+		ctx,
+		requestingAcct,
+		"move_id",
+		"moved_to_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Have admin block 1happyturtle,
+	// so that redirecting them fails.
+	follower := suite.testAccounts["local_account_2"]
+	block := &gtsmodel.Block{
+		ID:              "01JA9ZMB1V8C4X2T6N0G7H5K9Q",
+		URI:             targetAcct.URI + "/blocks/01JA9ZMB1V8C4X2T6N0G7H5K9Q",
+		AccountID:       targetAcct.ID,
+		TargetAccountID: follower.ID,
+	}
+	if err := suite.state.DB.PutBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Retry the Move. Admin's own follow
+	// of zork should still be removed,
+	// despite 1happyturtle failing.
+	resp, errWithCode := suite.accountProcessor.MoveSelfRetry(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(1, resp.Migrated)
+	suite.Equal(1, resp.Failed)
+
+	following, err := suite.state.DB.IsFollowing(ctx, targetAcct.ID, requestingAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(following)
+
+	// 1happyturtle should still follow zork.
+	following, err = suite.state.DB.IsFollowing(ctx, follower.ID, requestingAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(following)
+
+	// Remove the block and retry again;
+	// only 1happyturtle should be left
+	// to migrate this time.
+	if err := suite.state.DB.DeleteBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	resp, errWithCode = suite.accountProcessor.MoveSelfRetry(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(1, resp.Migrated)
+	suite.Zero(resp.Failed)
+
+	requested, err := suite.state.DB.IsFollowRequested(ctx, follower.ID, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(requested)
+}
+
+func (suite *MoveTestSuite) TestRedirectFollowersSelfFollow() {
+	ctx := context.Background()
+
+	// Zork's local followers are
+	// 1happyturtle and admin.
+	originAcct := suite.testAccounts["local_account_1"]
+
+	// Redirect them to admin, so
+	// admin's follow is a self-follow.
+	targetAcct := suite.testAccounts["admin_account"]
+
+	skippedBefore := metrics.MoveSelfFollowsSkipped()
+
+	result, err := suite.accountProcessor.RedirectFollowers(ctx, nil, originAcct, targetAcct, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, result.Migrated)
+	suite.Zero(result.Failed)
+
+	// The self-follow should have been
+	// skipped, and counted as such.
+	suite.Equal(skippedBefore+1, metrics.MoveSelfFollowsSkipped())
+
+	requested, err := suite.state.DB.IsFollowRequested(ctx, targetAcct.ID, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(requested)
+
+	// Admin's old follow of zork
+	// should still be removed.
+	following, err := suite.state.DB.IsFollowing(ctx, targetAcct.ID, originAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(following)
+}
+
+func (suite *MoveTestSuite) TestRedirectFollowersResume() {
+	ctx := context.Background()
+
+	// Copy zork, whose local
+	// followers are 1happyturtle
+	// and admin.
+	originAcct := new(gtsmodel.Account)
+	*originAcct = *suite.testAccounts["local_account_1"]
+	targetAcct := suite.testAccounts["admin_account"]
+
+	followers, err := suite.state.DB.GetAccountLocalFollowers(ctx, originAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(followers, 2)
+	slices.SortFunc(followers, func(a, b *gtsmodel.Follow) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	// Store a Move from zork to admin whose
+	// redirect pass was interrupted after
+	// the first follower was processed.
+	move := &gtsmodel.Move{
+		ID:           "01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+		AttemptedAt:  time.Now(),
+		OriginURI:    originAcct.URI,
+		TargetURI:    targetAcct.URI,
+		URI:          originAcct.URI + "/moves/01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+		LastFollowID: followers[0].ID,
+	}
+	if err := suite.state.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	originAcct.MoveID = move.ID
+	originAcct.Move = move
+	originAcct.MovedToURI = targetAcct.URI
+	if err := suite.state.DB.UpdateAccount(
+		ctx,
+		originAcct,
+		"move_id",
+		"moved_to_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	result, err := suite.accountProcessor.RedirectFollowers(ctx, move, originAcct, targetAcct, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the follower after
+	// the cursor is processed.
+	suite.Equal(1, result.Migrated)
+	suite.Zero(result.Failed)
+
+	for i, follow := range followers {
+		following, err := suite.state.DB.IsFollowing(ctx, follow.AccountID, originAcct.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(i == 0, following)
+	}
+
+	// The pass completed, so
+	// the cursor is cleared.
+	dbMove, err := suite.state.DB.GetMoveByID(ctx, move.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbMove.LastFollowID)
+}
+
+func (suite *MoveTestSuite) TestRedirectFollowersDryRun() {
+	ctx := context.Background()
+
+	// Zork's local followers are
+	// 1happyturtle and admin.
+	originAcct := suite.testAccounts["local_account_1"]
+
+	// Preview redirecting them to admin,
+	// so admin's follow is a self-follow.
+	targetAcct := suite.testAccounts["admin_account"]
+
+	skippedBefore := metrics.MoveSelfFollowsSkipped()
+
+	preview, err := suite.accountProcessor.RedirectFollowersDryRun(ctx, originAcct, targetAcct)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// 1happyturtle would request to follow
+	// admin, admin's own follow is skipped,
+	// and both old follows would be removed.
+	suite.Equal(1, preview.Recreated)
+	suite.Equal(2, preview.Removed)
+	suite.Zero(preview.Failed)
+	suite.Empty(preview.SkippedExisting)
+	if suite.Len(preview.SkippedSelf, 1) {
+		suite.Equal(targetAcct.ID, preview.SkippedSelf[0].AccountID)
+	}
+
+	// Nothing should actually have been done.
+	suite.Equal(skippedBefore, metrics.MoveSelfFollowsSkipped())
+
+	follower := suite.testAccounts["local_account_2"]
+	requested, err := suite.state.DB.IsFollowRequested(ctx, follower.ID, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(requested)
+
+	for _, acct := range []*gtsmodel.Account{follower, targetAcct} {
+		following, err := suite.state.DB.IsFollowing(ctx, acct.ID, originAcct.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.True(following)
+	}
+}
+
+func (suite *MoveTestSuite) TestMoveAccountRetryNotMoved() {
+	ctx := context.Background()
+
+	// Zork has not moved, so
+	// there's nothing to retry.
+	_, errWithCode := suite.accountProcessor.MoveSelfRetry(
+		ctx,
+		&oauth.Auth{Account: suite.testAccounts["local_account_1"]},
+	)
+	suite.EqualError(errWithCode, "your account has not Moved; nothing to retry")
+}
+
+func (suite *MoveTestSuite) TestMoveAccountRetryIncomplete() {
+	ctx := context.Background()
+
+	// Copy zork.
+	requestingAcct := new(gtsmodel.Account)
+	*requestingAcct = *suite.testAccounts["local_account_1"]
+	targetAcct := suite.testAccounts["admin_account"]
+
+	// Store a Move from zork to
+	// admin that hasn't succeeded.
+	move := &gtsmodel.Move{
+		ID:          "01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+		AttemptedAt: time.Now(),
+		OriginURI:   requestingAcct.URI,
+		TargetURI:   targetAcct.URI,
+		URI:         requestingAcct.URI + "/moves/01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+	}
+	if err := suite.state.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestingAcct.MoveID = move.ID
+	requestingAcct.MovedToURI = targetAcct.URI
+
+	_, errWithCode := suite.accountProcessor.MoveSelfRetry(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	suite.EqualError(errWithCode, "your account Move has not yet completed; please wait for it to complete before retrying")
+}
+
+func (suite *MoveTestSuite) TestMoveAccountCancel() {
+	ctx := context.Background()
+
+	// Copy zork.
+	requestingAcct := new(gtsmodel.Account)
+	*requestingAcct = *suite.testAccounts["local_account_1"]
+	targetAcct := suite.testAccounts["admin_account"]
+
+	// Store a Move from zork to admin
+	// that hasn't been processed yet.
+	move := &gtsmodel.Move{
+		ID:          "01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+		AttemptedAt: time.Now(),
+		OriginURI:   requestingAcct.URI,
+		TargetURI:   targetAcct.URI,
+		URI:         requestingAcct.URI + "/moves/01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+	}
+	if err := suite.state.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestingAcct.MoveID = move.ID
+	requestingAcct.Move = move
+	requestingAcct.MovedToURI = targetAcct.URI
+	if err := suite.state.DB.UpdateAccount(
+		ctx,
+		requestingAcct,
+		"move_id",
+		"moved_to_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Keep a copy of zork as it was when
+	// the Move was queued for processing.
+	queuedAcct := new(gtsmodel.Account)
+	*queuedAcct = *requestingAcct
+
+	// Cancel the Move.
+	errWithCode := suite.accountProcessor.MoveSelfCancel(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Move should be cleared from zork.
+	dbAcct, err := suite.state.DB.GetAccountByID(ctx, requestingAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbAcct.MoveID)
+	suite.Empty(dbAcct.MovedToURI)
+
+	// Processing the queued Move now
+	// shouldn't migrate any followers.
+	result, err := suite.accountProcessor.RedirectFollowers(ctx, queuedAcct.Move, queuedAcct, targetAcct, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(result.Migrated)
+	suite.Zero(result.Failed)
+
+	// 1happyturtle should still follow zork,
+	// and not have requested to follow admin.
+	follower := suite.testAccounts["local_account_2"]
+	following, err := suite.state.DB.IsFollowing(ctx, follower.ID, requestingAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(following)
+
+	requested, err := suite.state.DB.IsFollowRequested(ctx, follower.ID, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(requested)
+
+	// Cancelling again should fail,
+	// as there's no Move any more.
+	errWithCode = suite.accountProcessor.MoveSelfCancel(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	suite.EqualError(errWithCode, "your account is not Moving; nothing to cancel")
+}
+
+func (suite *MoveTestSuite) TestMoveAccountCancelCompleted() {
+	ctx := context.Background()
+
+	// Copy zork.
+	requestingAcct := new(gtsmodel.Account)
+	*requestingAcct = *suite.testAccounts["local_account_1"]
+	targetAcct := suite.testAccounts["admin_account"]
+
+	// Store a completed Move from zork to admin.
+	now := time.Now()
+	move := &gtsmodel.Move{
+		ID:          "01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+		AttemptedAt: now,
+		SucceededAt: now,
+		OriginURI:   requestingAcct.URI,
+		TargetURI:   targetAcct.URI,
+		URI:         requestingAcct.URI + "/moves/01JA7QTJ8Q2RDZ3WJ0V3Q0Y7XN",
+	}
+	if err := suite.state.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestingAcct.MoveID = move.ID
+	requestingAcct.MovedToURI = targetAcct.URI
+
+	errWithCode := suite.accountProcessor.MoveSelfCancel(
+		ctx,
+		&oauth.Auth{Account: requestingAcct},
+	)
+	suite.EqualError(errWithCode, "your account Move has already completed; it can no longer be cancelled")
+}
+
 func TestMoveTestSuite(t *testing.T) {
 	suite.Run(t, new(MoveTestSuite))
 }
@@ -0,0 +1,175 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// RejectPendingInteractionsFromDomains rejects all interactions
+// with the requester's statuses still pending approval, which
+// were made by accounts on any of the given domains, eg., to
+// clear out a spam wave. Faves are deleted here, replies and
+// boosts are wiped by the client API worker when it sends out
+// the Reject of each interaction to the interacting account.
+//
+// If a reason code is given, a rejection with that reason is
+// recorded for each interaction by the client API worker, for
+// reason code analytics.
+func (p *Processor) RejectPendingInteractionsFromDomains(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	domains []string,
+	reason gtsmodel.InteractionReason,
+) (*apimodel.PendingInteractionsRejected, gtserror.WithCode) {
+	if err := validate.InteractionReason(reason); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	punyDomains := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		if domain == "" {
+			continue
+		}
+
+		punyDomain, err := util.Punify(domain)
+		if err != nil {
+			err := gtserror.Newf("invalid domain %s: %w", domain, err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		punyDomains = append(punyDomains, punyDomain)
+	}
+
+	if len(punyDomains) == 0 {
+		const text = "no domains provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	faves, statuses, err := p.state.DB.DeletePendingInteractionsFromDomains(ctx,
+		requester.ID,
+		util.Deduplicate(punyDomains),
+	)
+	if err != nil {
+		err := gtserror.Newf("db error deleting pending interactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Enqueue a reject of each interaction to the
+	// client API worker, which wipes pending statuses,
+	// sends out Rejects to the interacting accounts
+	// and updates pending counts.
+	for _, fave := range faves {
+		p.rejectDeletedInteraction(ctx, requester,
+			ap.ActivityLike,
+			gtsmodel.InteractionLike,
+			fave.AccountID,
+			fave.URI,
+			reason,
+		)
+	}
+
+	for _, status := range statuses {
+		objectType, interactionType := ap.ObjectNote, gtsmodel.InteractionReply
+		if status.BoostOfID != "" {
+			objectType, interactionType = ap.ActivityAnnounce, gtsmodel.InteractionAnnounce
+		}
+
+		p.rejectDeletedInteraction(ctx, requester,
+			objectType,
+			interactionType,
+			status.AccountID,
+			status.URI,
+			reason,
+		)
+	}
+
+	return &apimodel.PendingInteractionsRejected{
+		Rejected: len(faves) + len(statuses),
+	}, nil
+}
+
+// rejectDeletedInteraction enqueues a reject of the pending
+// interaction with given type, account and URI. The approval
+// model passed along is never stored, it just carries the
+// interaction details for the Reject; its lack of an ID tells
+// the worker to wipe the interaction if it's a status.
+//
+// If reason is set, the worker records the rejection with it,
+// once the interaction is wiped.
+func (p *Processor) rejectDeletedInteraction(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	objectType string,
+	interactionType gtsmodel.InteractionType,
+	interactingAccountID string,
+	interactionURI string,
+	reason gtsmodel.InteractionReason,
+) {
+	p.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+		APActivityType: ap.ActivityReject,
+		APObjectType:   objectType,
+		GTSModel: &gtsmodel.InteractionApproval{
+			AccountID:            requester.ID,
+			Account:              requester,
+			InteractingAccountID: interactingAccountID,
+			InteractionURI:       interactionURI,
+			InteractionType:      interactionType,
+			Reason:               reason,
+		},
+		Origin: requester,
+	})
+}
+
+// InteractionReasonsGet returns counts of the reason
+// codes given by the requester on approvals and
+// rejections of interactions with their statuses.
+func (p *Processor) InteractionReasonsGet(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+) (*apimodel.InteractionReasons, gtserror.WithCode) {
+	approved, rejected, err := p.state.DB.CountInteractionReasons(ctx, requester.ID)
+	if err != nil {
+		err := gtserror.Newf("db error counting interaction reasons: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	resp := &apimodel.InteractionReasons{
+		Approved: make(map[string]int, len(approved)),
+		Rejected: make(map[string]int, len(rejected)),
+	}
+
+	for reason, count := range approved {
+		resp.Approved[string(reason)] = count
+	}
+
+	for reason, count := range rejected {
+		resp.Rejected[string(reason)] = count
+	}
+
+	return resp, nil
+}
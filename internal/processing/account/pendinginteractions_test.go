@@ -0,0 +1,195 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type PendingInteractionsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+// pendingReply stores and returns a reply by
+// account to status which is pending approval.
+func (suite *PendingInteractionsTestSuite) pendingReply(
+	account *gtsmodel.Account,
+	status *gtsmodel.Status,
+) *gtsmodel.Status {
+	replyID := id.NewULID()
+	reply := &gtsmodel.Status{
+		ID:                  replyID,
+		URI:                 account.URI + "/statuses/" + replyID,
+		AccountID:           account.ID,
+		AccountURI:          account.URI,
+		InReplyToID:         status.ID,
+		InReplyToURI:        status.URI,
+		InReplyToAccountID:  status.AccountID,
+		Local:               util.Ptr(false),
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           util.Ptr(true),
+		PendingApproval:     util.Ptr(true),
+	}
+	if err := suite.db.PutStatus(context.Background(), reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return reply
+}
+
+func (suite *PendingInteractionsTestSuite) TestRejectPendingInteractionsFromDomains() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_1"]
+		status    = suite.testStatuses["local_account_1_status_1"]
+		spammer   = suite.testAccounts["remote_account_1"]
+		other     = suite.testAccounts["remote_account_2"]
+	)
+
+	// Pending fave + reply from the
+	// spammer's domain, which should
+	// both get rejected.
+	faveID := id.NewULID()
+	if err := suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       spammer.ID,
+		TargetAccountID: requester.ID,
+		StatusID:        status.ID,
+		URI:             spammer.URI + "/likes/" + faveID,
+		PendingApproval: util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+	spamReply := suite.pendingReply(spammer, status)
+
+	// Pending reply from another
+	// domain, which should be kept.
+	otherReply := suite.pendingReply(other, status)
+
+	resp, errWithCode := suite.accountProcessor.RejectPendingInteractionsFromDomains(ctx,
+		requester,
+		[]string{"FOSSBROS-anonymous.io", ""},
+		"",
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(2, resp.Rejected)
+
+	// The spammer's fave should be gone, their
+	// reply is left for the worker to wipe.
+	_, err := suite.db.GetStatusFaveByID(ctx, faveID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	_, err = suite.db.GetStatusByID(ctx, spamReply.ID)
+	suite.NoError(err)
+
+	// The other reply should be untouched.
+	dbOtherReply, err := suite.db.GetStatusByID(ctx, otherReply.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbOtherReply.PendingApproval)
+
+	// A reject of each of the spammer's
+	// interactions should be enqueued.
+	rejected := make(map[string]string)
+	for range 2 {
+		msg, ok := suite.getClientMsg(5 * time.Second)
+		if !suite.True(ok) {
+			suite.FailNow("timed out waiting for reject")
+		}
+		suite.Equal(ap.ActivityReject, msg.APActivityType)
+
+		approval := msg.GTSModel.(*gtsmodel.InteractionApproval)
+		suite.Empty(approval.ID)
+		suite.Equal(requester.ID, approval.AccountID)
+		suite.Equal(spammer.ID, approval.InteractingAccountID)
+		rejected[approval.InteractionURI] = msg.APObjectType
+	}
+	suite.Equal(map[string]string{
+		spammer.URI + "/likes/" + faveID: ap.ActivityLike,
+		spamReply.URI:                    ap.ObjectNote,
+	}, rejected)
+}
+
+func (suite *PendingInteractionsTestSuite) TestRejectPendingInteractionsNoDomains() {
+	_, errWithCode := suite.accountProcessor.RejectPendingInteractionsFromDomains(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+		nil,
+		"",
+	)
+	suite.EqualError(errWithCode, "no domains provided")
+}
+
+func (suite *PendingInteractionsTestSuite) TestRejectPendingInteractionsWithReason() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_1"]
+		status    = suite.testStatuses["local_account_1_status_1"]
+		spammer   = suite.testAccounts["remote_account_1"]
+	)
+
+	suite.pendingReply(spammer, status)
+	suite.pendingReply(spammer, status)
+
+	resp, errWithCode := suite.accountProcessor.RejectPendingInteractionsFromDomains(ctx,
+		requester,
+		[]string{"fossbros-anonymous.io"},
+		gtsmodel.InteractionReasonSpam,
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(2, resp.Rejected)
+
+	// Both enqueued rejects should carry the
+	// reason, for the worker to record.
+	for range 2 {
+		msg, ok := suite.getClientMsg(5 * time.Second)
+		if !ok {
+			suite.FailNow("timed out waiting for reject")
+		}
+
+		approval := msg.GTSModel.(*gtsmodel.InteractionApproval)
+		suite.Equal(gtsmodel.InteractionReasonSpam, approval.Reason)
+	}
+}
+
+func (suite *PendingInteractionsTestSuite) TestRejectPendingInteractionsInvalidReason() {
+	_, errWithCode := suite.accountProcessor.RejectPendingInteractionsFromDomains(
+		context.Background(),
+		suite.testAccounts["local_account_1"],
+		[]string{"fossbros-anonymous.io"},
+		"because",
+	)
+	suite.EqualError(errWithCode, "interaction reason must be either empty or one of 'trusted', 'on-topic', 'off-topic', 'spam', 'abusive', 'other'")
+}
+
+func TestPendingInteractionsTestSuite(t *testing.T) {
+	suite.Run(t, new(PendingInteractionsTestSuite))
+}
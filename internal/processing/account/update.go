@@ -23,11 +23,14 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"strings"
 
 	"codeberg.org/gruf/go-iotools"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -296,6 +299,68 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 			account.Settings.StatusContentType = *form.Source.StatusContentType
 		}
+
+		if form.Source.InteractionMinAccountAgeDays != nil {
+			days := *form.Source.InteractionMinAccountAgeDays
+			if days < 0 {
+				const text = "interaction_min_account_age_days must not be negative"
+				return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+			}
+
+			account.Settings.InteractionMinAccountAgeDays = days
+		}
+
+		if form.Source.InteractionApprovalExpiryDays != nil {
+			days := *form.Source.InteractionApprovalExpiryDays
+			if days < 0 {
+				const text = "interaction_approval_expiry_days must not be negative"
+				return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+			}
+
+			account.Settings.InteractionApprovalExpiryDays = days
+		}
+
+		if form.Source.InteractionTrustedListID != nil {
+			listID := *form.Source.InteractionTrustedListID
+			if listID != "" {
+				list, err := p.state.DB.GetListByID(
+					gtscontext.SetBarebones(ctx),
+					listID,
+				)
+				if err != nil && !errors.Is(err, db.ErrNoEntries) {
+					err := gtserror.Newf("db error getting list %s: %w", listID, err)
+					return nil, gtserror.NewErrorInternalError(err)
+				}
+
+				if list == nil || list.AccountID != account.ID {
+					const text = "interaction_trusted_list_id must be the ID of one of your lists"
+					return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+				}
+			}
+
+			account.Settings.InteractionTrustedListID = listID
+		}
+
+		if form.Source.InteractionApprovalTag != nil {
+			tagName := *form.Source.InteractionApprovalTag
+			if tagName != "" {
+				normalized, ok := text.NormalizeHashtag(tagName)
+				if !ok {
+					const errText = "interaction_approval_tag must be a valid hashtag"
+					return nil, gtserror.NewErrorBadRequest(errors.New(errText), errText)
+				}
+
+				// Tag names are
+				// stored lowercased.
+				tagName = strings.ToLower(normalized)
+			}
+
+			account.Settings.InteractionApprovalTag = tagName
+		}
+
+		if form.Source.InteractionPendingReminders != nil {
+			account.Settings.InteractionPendingReminders = form.Source.InteractionPendingReminders
+		}
 	}
 
 	if form.Theme != nil {
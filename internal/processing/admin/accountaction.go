@@ -44,11 +44,19 @@ func (p *Processor) AccountAction(
 	case gtsmodel.AdminActionSuspend:
 		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
 
+	case gtsmodel.AdminActionRebuildConversations:
+		return p.accountActionRebuildConversations(ctx, adminAcct, targetAcct, request.Text)
+
+	case gtsmodel.AdminActionRecalculateStats:
+		return p.accountActionRecalculateStats(ctx, adminAcct, targetAcct, request.Text)
+
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
 			gtsmodel.AdminActionSuspend.String(),
+			gtsmodel.AdminActionRebuildConversations.String(),
+			gtsmodel.AdminActionRecalculateStats.String(),
 		}
 
 		err := fmt.Errorf(
@@ -100,3 +108,75 @@ func (p *Processor) accountActionSuspend(
 
 	return actionID, errWithCode
 }
+
+func (p *Processor) accountActionRebuildConversations(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	if targetAcct.IsRemote() {
+		const text = "conversations can only be rebuilt for local accounts"
+		err := gtserror.Newf("target account %s is remote", targetAcct.ID)
+		return "", gtserror.NewErrorBadRequest(err, text)
+	}
+
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionRebuildConversations,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			if err := p.conversations.RebuildConversations(ctx, targetAcct); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(err)
+				return errs
+			}
+
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
+
+func (p *Processor) accountActionRecalculateStats(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionRecalculateStats,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			if err := p.workers.RecalculateAccountStats(ctx, targetAcct); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Append(err)
+				return errs
+			}
+
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// AccountMoveEventsGet returns the event log of the
+// latest Move of the account with the given ID, oldest
+// event first. 404 if the account has not Moved.
+func (p *Processor) AccountMoveEventsGet(
+	ctx context.Context,
+	accountID string,
+) ([]*apimodel.AdminMoveEvent, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if account == nil {
+		err := fmt.Errorf("account %s not found", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if account.MoveID == "" {
+		err := fmt.Errorf("account %s has not moved", accountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	events, err := p.state.DB.GetMoveEvents(ctx, account.MoveID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting events for move %s: %w", account.MoveID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiEvents := make([]*apimodel.AdminMoveEvent, 0, len(events))
+	for _, event := range events {
+		apiEvents = append(apiEvents, p.converter.MoveEventToAdminAPIMoveEvent(event))
+	}
+
+	return apiEvents, nil
+}
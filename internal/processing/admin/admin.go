@@ -24,6 +24,8 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
@@ -33,6 +35,14 @@ type Processor struct {
 	// common processor logic
 	c *common.Processor
 
+	// conversations processor, used
+	// for rebuilding conversations
+	conversations *conversations.Processor
+
+	// workers processor, used
+	// for recalculating stats
+	workers *workers.Processor
+
 	state     *state.State
 	cleaner   *cleaner.Cleaner
 	converter *typeutils.Converter
@@ -53,6 +63,8 @@ func (p *Processor) Actions() *Actions {
 // New returns a new admin processor.
 func New(
 	common *common.Processor,
+	conversations *conversations.Processor,
+	workers *workers.Processor,
 	state *state.State,
 	cleaner *cleaner.Cleaner,
 	federator *federation.Federator,
@@ -62,14 +74,16 @@ func New(
 	emailSender email.Sender,
 ) Processor {
 	return Processor{
-		c:         common,
-		state:     state,
-		cleaner:   cleaner,
-		converter: converter,
-		federator: federator,
-		media:     mediaManager,
-		transport: transportController,
-		email:     emailSender,
+		c:             common,
+		conversations: conversations,
+		workers:       workers,
+		state:         state,
+		cleaner:       cleaner,
+		converter:     converter,
+		federator:     federator,
+		media:         mediaManager,
+		transport:     transportController,
+		email:         emailSender,
 		actions: &Actions{
 			r:     make(map[string]*gtsmodel.AdminAction),
 			state: state,
@@ -28,6 +28,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -170,7 +171,8 @@ func (p *Processor) skipBlockSideEffects(
 // domainBlockSideEffects processes the side effects of a domain block:
 //
 //  1. Strip most info away from the instance entry for the domain.
-//  2. Pass each account from the domain to the processor for deletion.
+//  2. Remove follows of local accounts by accounts from the domain.
+//  3. Pass each account from the domain to the processor for deletion.
 //
 // It should be called asynchronously, since it can take a while when
 // there are many accounts present on the given domain.
@@ -197,6 +199,12 @@ func (p *Processor) domainBlockSideEffects(
 		}
 	}
 
+	// Remove follows from this domain first, so
+	// that local accounts' follower counts are
+	// kept accurate. Account deletion below would
+	// otherwise remove them without doing so.
+	errs = append(errs, p.removeDomainFollows(ctx, block.Domain)...)
+
 	// For each account that belongs to this domain,
 	// process an account delete message to remove
 	// that account's posts, media, etc.
@@ -217,6 +225,54 @@ func (p *Processor) domainBlockSideEffects(
 	return errs
 }
 
+// removeDomainFollows removes all follows owned by accounts
+// from the given domain that target local accounts, and
+// decrements the followers count of each local account
+// by the number of its followers that were removed.
+func (p *Processor) removeDomainFollows(
+	ctx context.Context,
+	domain string,
+) gtserror.MultiError {
+	var (
+		errs gtserror.MultiError
+
+		// Followers removed from
+		// affected local accounts.
+		stats = p.c.NewStatDeltaAccumulator()
+	)
+
+	if err := p.rangeDomainAccounts(ctx, domain, func(account *gtsmodel.Account) {
+		follows, err := p.state.DB.GetAccountLocalFollows(
+			gtscontext.SetBarebones(ctx),
+			account.ID,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("db error getting local follows of account %s: %w", account.ID, err)
+			return
+		}
+
+		for _, follow := range follows {
+			if err := p.state.DB.DeleteFollowByID(ctx, follow.ID); err != nil {
+				errs.Appendf("db error deleting follow %s: %w", follow.ID, err)
+				continue
+			}
+			stats.Add(follow.TargetAccountID, gtsmodel.AccountStatsDelta{
+				FollowersCount:       -1,
+				RemoteFollowersCount: -1,
+			})
+		}
+	}); err != nil {
+		errs.Appendf("db error ranging through accounts: %w", err)
+	}
+
+	// Update all follower counts in one go.
+	if err := stats.Flush(ctx); err != nil {
+		errs.Append(err)
+	}
+
+	return errs
+}
+
 func (p *Processor) deleteDomainBlock(
 	ctx context.Context,
 	adminAcct *gtsmodel.Account,
@@ -318,6 +374,14 @@ func (p *Processor) domainUnblockSideEffects(
 
 	// Unsuspend all accounts whose suspension origin was this domain block.
 	if err := p.rangeDomainAccounts(ctx, block.Domain, func(account *gtsmodel.Account) {
+		if config.GetInstanceHideBlockedDomainStats() {
+			// Stats weren't kept while the domain
+			// was blocked, so bring them up to date.
+			if err := p.state.DB.RegenerateAccountStats(ctx, account); err != nil {
+				errs.Appendf("db error regenerating stats of account %s: %w", account.Username, err)
+			}
+		}
+
 		if account.SuspensionOrigin == "" || account.SuspendedAt.IsZero() {
 			// Account wasn't suspended, nothing to do.
 			return
@@ -268,6 +268,52 @@ func (suite *DomainBlockTestSuite) TestBlockAndUnblockDomain() {
 	})
 }
 
+func (suite *DomainBlockTestSuite) TestBlockDomainDecrementsFollowersCount() {
+	const domain = "fossbros-anonymous.io"
+
+	var (
+		ctx            = context.Background()
+		localAccount   = new(gtsmodel.Account)
+		remoteFollower = suite.testAccounts["remote_account_1"]
+	)
+	*localAccount = *suite.testAccounts["local_account_1"]
+
+	// Have the remote account follow our local account.
+	follow := &gtsmodel.Follow{
+		ID:              "01J4Y0M8Q6ZC0K3T2B1W5E9VNA",
+		URI:             remoteFollower.URI + "/follows/01J4Y0M8Q6ZC0K3T2B1W5E9VNA",
+		AccountID:       remoteFollower.ID,
+		TargetAccountID: localAccount.ID,
+	}
+	if err := suite.db.PutFollow(ctx, follow); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Take followers count with the remote follow.
+	if err := suite.db.PopulateAccountStats(ctx, localAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+	followersBefore := *localAccount.Stats.FollowersCount
+
+	// Block the remote account's domain.
+	_, actionID := suite.createDomainPerm(gtsmodel.DomainPermissionBlock, domain)
+	suite.awaitAction(actionID)
+
+	// Follow should be gone.
+	following, err := suite.db.IsFollowing(ctx, remoteFollower.ID, localAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(following)
+
+	// And followers count decremented.
+	stats, err := suite.db.SnapshotAccountStats(ctx, localAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(followersBefore-1, *stats.FollowersCount)
+}
+
 func (suite *DomainBlockTestSuite) TestBlockAndAllowDomain() {
 	const domain = "fossbros-anonymous.io"
 
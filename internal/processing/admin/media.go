@@ -19,8 +19,10 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -49,6 +51,39 @@ func (p *Processor) MediaRefetch(ctx context.Context, requestingAccount *gtsmode
 	return nil
 }
 
+// MediaReclaim reclaims storage from the status with
+// the given ID, by removing the original files of its
+// remote attachments larger than size bytes, keeping
+// their thumbnails. The status itself is left alone.
+// Returns the number of attachments reclaimed.
+func (p *Processor) MediaReclaim(ctx context.Context, statusID string, size int) (int, gtserror.WithCode) {
+	if size < 0 {
+		err := fmt.Errorf("MediaReclaim: invalid value for size: value was %d, cannot be less than 0", size)
+		return 0, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	status, err := p.state.DB.GetStatusByID(gtscontext.SetBarebones(ctx), statusID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting status %s: %w", statusID, err)
+		return 0, gtserror.NewErrorInternalError(err)
+	}
+
+	if status == nil {
+		err := fmt.Errorf("status %s not found", statusID)
+		return 0, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	reclaimed, err := p.workers.WipeStatusAttachmentsBySize(ctx, status, size)
+	if err != nil {
+		// Some may have been reclaimed,
+		// but report this as an error.
+		err := gtserror.Newf("error reclaiming media of status %s: %w", statusID, err)
+		return reclaimed, gtserror.NewErrorInternalError(err)
+	}
+
+	return reclaimed, nil
+}
+
 // MediaPrune triggers a non-blocking prune of unused media, orphaned, uncaching remote and fixing cache states.
 func (p *Processor) MediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode {
 	if mediaRemoteCacheDays < 0 {
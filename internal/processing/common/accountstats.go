@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"slices"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// StatDeltaAccumulator collects account stats deltas
+// across many operations, for example during a bulk
+// wipe or import, to be flushed all at once at the end.
+// This avoids taking the lock of, and updating stats for,
+// an account once per operation. It is not safe for
+// concurrent use.
+type StatDeltaAccumulator struct {
+	state  *state.State
+	deltas map[string]gtsmodel.AccountStatsDelta
+}
+
+// NewStatDeltaAccumulator returns a new, empty StatDeltaAccumulator.
+func (p *Processor) NewStatDeltaAccumulator() *StatDeltaAccumulator {
+	return &StatDeltaAccumulator{
+		state:  p.state,
+		deltas: make(map[string]gtsmodel.AccountStatsDelta),
+	}
+}
+
+// Add adds the given delta to those accumulated for account ID.
+func (a *StatDeltaAccumulator) Add(accountID string, delta gtsmodel.AccountStatsDelta) {
+	a.deltas[accountID] = a.deltas[accountID].Add(delta)
+}
+
+// Len returns the number of accounts with accumulated deltas.
+func (a *StatDeltaAccumulator) Len() int {
+	return len(a.deltas)
+}
+
+// Flush applies all accumulated deltas in one batch update,
+// holding the processing locks of all affected accounts for
+// the duration, then resets the accumulator. Deltas of any
+// account that cannot be fetched are dropped.
+func (a *StatDeltaAccumulator) Flush(ctx context.Context) error {
+	if len(a.deltas) == 0 {
+		// Nothing
+		// to flush.
+		return nil
+	}
+
+	var (
+		errs   gtserror.MultiError
+		deltas = a.deltas
+		uris   = make([]string, 0, len(deltas))
+	)
+
+	// Reset ready for reuse.
+	a.deltas = make(map[string]gtsmodel.AccountStatsDelta)
+
+	for accountID, delta := range deltas {
+		if delta == (gtsmodel.AccountStatsDelta{}) {
+			// Net zero,
+			// skip it.
+			delete(deltas, accountID)
+			continue
+		}
+
+		account, err := a.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			accountID,
+		)
+		if err != nil {
+			errs.Appendf("db error getting account %s: %w", accountID, err)
+			delete(deltas, accountID)
+			continue
+		}
+
+		uris = append(uris, account.URI)
+	}
+
+	// Lock on all accounts since we're changing
+	// stats, in a consistent order to avoid any
+	// deadlock with others doing the same.
+	slices.Sort(uris)
+	for _, uri := range uris {
+		unlock := a.state.ProcessingLocks.Lock(uri)
+		defer unlock()
+	}
+
+	if err := a.state.DB.UpdateAccountStatsBatch(ctx, deltas); err != nil {
+		errs.Appendf("db error updating account stats: %w", err)
+	}
+
+	return errs.Combine()
+}
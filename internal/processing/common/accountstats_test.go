@@ -0,0 +1,125 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatDeltaAccumulatorTestSuite struct {
+	suite.Suite
+	db           db.DB
+	state        state.State
+	testAccounts map[string]*gtsmodel.Account
+
+	common common.Processor
+}
+
+func (suite *StatDeltaAccumulatorTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.common = common.New(&suite.state, nil, nil, nil, nil)
+
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+}
+
+func (suite *StatDeltaAccumulatorTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *StatDeltaAccumulatorTestSuite) TestFlushMixedDeltas() {
+	var (
+		ctx      = context.Background()
+		account1 = suite.testAccounts["local_account_1"]
+		account2 = suite.testAccounts["local_account_2"]
+		stats    = suite.common.NewStatDeltaAccumulator()
+	)
+
+	before1, err := suite.db.SnapshotAccountStats(ctx, account1.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	before2, err := suite.db.SnapshotAccountStats(ctx, account2.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Mix increments + decrements
+	// across the two accounts.
+	stats.Add(account1.ID, gtsmodel.AccountStatsDelta{StatusesCount: 1})
+	stats.Add(account2.ID, gtsmodel.AccountStatsDelta{FollowingCount: 1})
+	stats.Add(account1.ID, gtsmodel.AccountStatsDelta{StatusesCount: 1, FollowersCount: -1})
+	stats.Add(account2.ID, gtsmodel.AccountStatsDelta{FollowingCount: -1, FollowRequestsCount: 2})
+	stats.Add(account1.ID, gtsmodel.AccountStatsDelta{StatusesCount: -1, FollowersCount: 2})
+
+	// Decrement beyond zero.
+	stats.Add(account2.ID, gtsmodel.AccountStatsDelta{
+		StatusesPinnedCount: -(*before2.StatusesPinnedCount + 10),
+	})
+
+	suite.Equal(2, stats.Len())
+
+	if err := stats.Flush(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Accumulator should
+	// be reset by flush.
+	suite.Zero(stats.Len())
+
+	after1, err := suite.db.SnapshotAccountStats(ctx, account1.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	after2, err := suite.db.SnapshotAccountStats(ctx, account2.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(gtsmodel.AccountStatsDelta{
+		StatusesCount:  1,
+		FollowersCount: 1,
+	}, after1.Delta(&before1))
+
+	// Pinned count should be clamped to zero.
+	suite.Equal(gtsmodel.AccountStatsDelta{
+		FollowRequestsCount: 2,
+		StatusesPinnedCount: -*before2.StatusesPinnedCount,
+	}, after2.Delta(&before2))
+	suite.Zero(*after2.StatusesPinnedCount)
+}
+
+func TestStatDeltaAccumulatorTestSuite(t *testing.T) {
+	suite.Run(t, new(StatDeltaAccumulatorTestSuite))
+}
@@ -0,0 +1,72 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// RebuildConversations drops all conversations owned by the given
+// local account, and recomputes them and their last statuses from
+// the DMs authored by or mentioning the account, for when bulk
+// deletions or imports have left them inconsistent. No notifications
+// are generated, and rebuilt conversations are all marked as read.
+func (p *Processor) RebuildConversations(ctx context.Context, account *gtsmodel.Account) error {
+	if err := p.state.DB.DeleteConversationsByOwnerAccountID(ctx, account.ID); err != nil {
+		return gtserror.Newf("couldn't delete conversations for account %s: %w", account.ID, err)
+	}
+
+	log.Infof(ctx, "rebuilding conversations for account %s…", account.ID)
+
+	// In batches, get all of the account's DMs,
+	// and update its conversations for each in order.
+	minID := id.Lowest
+	for {
+		// Get status IDs for this batch.
+		statusIDs, err := p.state.DB.GetAccountDirectStatusIDsBatch(ctx, account.ID, minID, statusBatchSize)
+		if err != nil {
+			return gtserror.Newf("couldn't get DM status ID batch for account %s: %w", account.ID, err)
+		}
+		if len(statusIDs) == 0 {
+			break
+		}
+
+		// Load the batch by IDs.
+		statuses, err := p.state.DB.GetStatusesByIDs(ctx, statusIDs)
+		if err != nil {
+			return gtserror.Newf("couldn't get DM statuses for account %s: %w", account.ID, err)
+		}
+
+		// Update the account's conversations for each status.
+		for _, status := range statuses {
+			if _, err := p.updateConversationsForStatus(ctx, status, account.ID); err != nil {
+				return gtserror.Newf("couldn't update conversations for status %s: %w", status.ID, err)
+			}
+		}
+
+		minID = statusIDs[len(statusIDs)-1]
+	}
+
+	log.Infof(ctx, "finished rebuilding conversations for account %s.", account.ID)
+	return nil
+}
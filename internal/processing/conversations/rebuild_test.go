@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package conversations_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Test that rebuilding conversations fixes
+// deliberately corrupted conversations.
+func (suite *ConversationsTestSuite) TestRebuildConversations() {
+	ctx := context.Background()
+
+	// Create a thread of two DMs, and its conversation.
+	threadID := suite.NewULID(0)
+	firstStatus := suite.NewTestStatus(suite.testAccount, threadID, 0, nil)
+	lastStatus := suite.NewTestStatus(suite.testAccount, threadID, 1*time.Minute, firstStatus)
+	for _, status := range []*gtsmodel.Status{firstStatus, lastStatus} {
+		if _, err := suite.conversationsProcessor.UpdateConversationsForStatus(ctx, status); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	conversation, err := suite.db.GetConversationByThreadAndAccountIDs(ctx, threadID, suite.testAccount.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(lastStatus.ID, conversation.LastStatusID)
+
+	// Corrupt the conversation's last status pointer.
+	conversation.LastStatusID = firstStatus.ID
+	conversation.LastStatus = firstStatus
+	if err := suite.db.UpsertConversation(ctx, conversation, "last_status_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Add a stray conversation whose
+	// last status isn't even a DM.
+	strayStatus := suite.testStatuses["local_account_1_status_1"]
+	stray := &gtsmodel.Conversation{
+		ID:           suite.NewULID(2 * time.Minute),
+		AccountID:    suite.testAccount.ID,
+		ThreadID:     strayStatus.ThreadID,
+		LastStatusID: strayStatus.ID,
+		Read:         util.Ptr(false),
+	}
+	if err := suite.db.UpsertConversation(ctx, stray); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Rebuild the account's conversations.
+	if err := suite.conversationsProcessor.RebuildConversations(ctx, suite.testAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	conversations, err := suite.db.GetConversationsByOwnerAccountID(ctx, suite.testAccount.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var threadConversations []*gtsmodel.Conversation
+	for _, conversation := range conversations {
+		// The stray conversation should be gone.
+		suite.NotEqual(strayStatus.ID, conversation.LastStatusID)
+		suite.Equal(gtsmodel.VisibilityDirect, conversation.LastStatus.Visibility)
+
+		if conversation.ThreadID == threadID {
+			threadConversations = append(threadConversations, conversation)
+		}
+	}
+
+	// The thread should have exactly one conversation,
+	// pointing to its actual last status, marked read.
+	if suite.Len(threadConversations, 1) {
+		suite.Equal(lastStatus.ID, threadConversations[0].LastStatusID)
+		suite.True(*threadConversations[0].Read)
+	}
+}
@@ -42,6 +42,17 @@ type ConversationNotification struct {
 // UpdateConversationsForStatus updates all conversations related to a status,
 // and returns a map from local account IDs to conversation notifications that should be sent to them.
 func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gtsmodel.Status) ([]ConversationNotification, error) {
+	return p.updateConversationsForStatus(ctx, status, "")
+}
+
+// updateConversationsForStatus implements UpdateConversationsForStatus.
+// If rebuildAccountID is set, only conversations owned by that account
+// are updated, without marking them as unread, as done when rebuilding.
+func (p *Processor) updateConversationsForStatus(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	rebuildAccountID string,
+) ([]ConversationNotification, error) {
 	if status.Visibility != gtsmodel.VisibilityDirect {
 		// Only DMs are considered part of conversations.
 		return nil, nil
@@ -75,6 +86,9 @@ func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gt
 		if participant.IsRemote() {
 			continue
 		}
+		if rebuildAccountID != "" && participant.ID != rebuildAccountID {
+			continue
+		}
 		localAccount := participant
 
 		// If the status is not visible to this account, skip processing it for this account.
@@ -174,7 +188,7 @@ func (p *Processor) UpdateConversationsForStatus(ctx context.Context, status *gt
 		}
 		// If the conversation is unread, leave it marked as unread.
 		// If the conversation is read but this status might not have been, mark the conversation as unread.
-		if !statusAuthoredByConversationOwner {
+		if !statusAuthoredByConversationOwner && rebuildAccountID == "" {
 			conversation.Read = util.Ptr(false)
 		}
 
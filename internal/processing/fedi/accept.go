@@ -23,7 +23,10 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // AcceptGet handles the getting of a fedi/activitypub
@@ -36,6 +39,26 @@ func (p *Processor) AcceptGet(
 	requestedUser string,
 	approvalID string,
 ) (interface{}, gtserror.WithCode) {
+	if regexes.ULID.MatchString(requestedUser) {
+		// Accept was requested using its account
+		// ID-based URI, resolve account username.
+		account, err := p.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			requestedUser,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting account %s: %w", requestedUser, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if account == nil || !account.IsLocal() {
+			err := gtserror.Newf("local account %s not found", requestedUser)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		requestedUser = account.Username
+	}
+
 	// Authenticate incoming request, getting related accounts.
 	auth, errWithCode := p.authenticate(ctx, requestedUser)
 	if errWithCode != nil {
@@ -53,21 +76,28 @@ func (p *Processor) AcceptGet(
 	receivingAcct := auth.receivingAcct
 
 	approval, err := p.state.DB.GetInteractionApprovalByID(ctx, approvalID)
+	if errors.Is(err, db.ErrNoEntries) {
+		// May be a pre-approval, which aren't
+		// stored, so look up by full URI instead.
+		approval, err = p.state.DB.GetInteractionApprovalByURI(ctx,
+			uris.GenerateURIForAcceptByAccountID(receivingAcct.ID, approvalID),
+		)
+	}
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err := gtserror.Newf("db error getting approval %s: %w", approvalID, err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
-	if approval.AccountID != receivingAcct.ID {
-		const text = "approval does not belong to receiving account"
-		return nil, gtserror.NewErrorNotFound(errors.New(text))
-	}
-
 	if approval == nil {
 		err := gtserror.Newf("approval %s not found", approvalID)
 		return nil, gtserror.NewErrorNotFound(err)
 	}
 
+	if approval.AccountID != receivingAcct.ID {
+		const text = "approval does not belong to receiving account"
+		return nil, gtserror.NewErrorNotFound(errors.New(text))
+	}
+
 	accept, err := p.converter.InteractionApprovalToASAccept(ctx, approval)
 	if err != nil {
 		err := gtserror.Newf("error converting approval: %w", err)
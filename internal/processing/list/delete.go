@@ -43,5 +43,24 @@ func (p *Processor) Delete(ctx context.Context, account *gtsmodel.Account, id st
 		return gtserror.NewErrorInternalError(err)
 	}
 
+	// Unset the account's trusted list
+	// for interactions if it was this one.
+	settings, err := p.state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting account settings: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if settings.InteractionTrustedListID == id {
+		settings.InteractionTrustedListID = ""
+		if err := p.state.DB.UpdateAccountSettings(ctx,
+			settings,
+			"interaction_trusted_list_id",
+		); err != nil {
+			err := gtserror.Newf("db error updating account settings: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
 	return nil
 }
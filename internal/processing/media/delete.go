@@ -25,6 +25,8 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 )
 
@@ -40,7 +42,56 @@ func (p *Processor) Delete(ctx context.Context, mediaAttachmentID string) gtserr
 		return gtserror.NewErrorInternalError(err)
 	}
 
-	errs := []string{}
+	errs := p.deleteFiles(ctx, attachment)
+	orphaned := len(errs) != 0
+
+	// delete the attachment
+	if err := p.state.DB.DeleteAttachment(ctx, mediaAttachmentID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		errs = append(errs, fmt.Sprintf("remove attachment: %s", err))
+	} else if orphaned {
+		// Row is gone but file(s) remain,
+		// nothing will clean these up now.
+		metrics.IncOrphanedMedia()
+	}
+
+	if len(errs) != 0 {
+		return gtserror.NewErrorInternalError(fmt.Errorf("Delete: one or more errors removing attachment with id %s: %s", mediaAttachmentID, strings.Join(errs, "; ")))
+	}
+
+	return nil
+}
+
+// DeleteForStatus deletes all media attachments of the status with
+// the given ID from the database, then removes their files from
+// storage. Files that are already gone are not treated as errors.
+// A failure to remove files of one attachment doesn't stop removal
+// of others, instead all such failures are returned together.
+func (p *Processor) DeleteForStatus(ctx context.Context, statusID string) gtserror.MultiError {
+	attachments, err := p.state.DB.DeleteAttachmentsForStatus(ctx, statusID)
+	if err != nil {
+		return gtserror.MultiError{
+			gtserror.Newf("db error deleting attachments of status %s: %w", statusID, err),
+		}
+	}
+
+	var errs gtserror.MultiError
+	for _, attachment := range attachments {
+		if fileErrs := p.deleteFiles(ctx, attachment); len(fileErrs) != 0 {
+			// Row is gone but file(s) remain,
+			// nothing will clean these up now.
+			metrics.IncOrphanedMedia()
+			errs.Appendf("error removing files of attachment %s: %s", attachment.ID, strings.Join(fileErrs, "; "))
+		}
+	}
+
+	return errs
+}
+
+// deleteFiles removes the thumbnail and file of the
+// given attachment from storage, returning a description
+// of each failure. Files already gone are skipped.
+func (p *Processor) deleteFiles(ctx context.Context, attachment *gtsmodel.MediaAttachment) []string {
+	var errs []string
 
 	// delete the thumbnail from storage
 	if attachment.Thumbnail.Path != "" {
@@ -56,14 +107,5 @@ func (p *Processor) Delete(ctx context.Context, mediaAttachmentID string) gtserr
 		}
 	}
 
-	// delete the attachment
-	if err := p.state.DB.DeleteAttachment(ctx, mediaAttachmentID); err != nil && !errors.Is(err, db.ErrNoEntries) {
-		errs = append(errs, fmt.Sprintf("remove attachment: %s", err))
-	}
-
-	if len(errs) != 0 {
-		return gtserror.NewErrorInternalError(fmt.Errorf("Delete: one or more errors removing attachment with id %s: %s", mediaAttachmentID, strings.Join(errs, "; ")))
-	}
-
-	return nil
+	return errs
 }
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"codeberg.org/gruf/go-storage"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+)
+
+// failRemoveStorage wraps a storage
+// to make all removals fail.
+type failRemoveStorage struct {
+	storage.Storage
+}
+
+func (failRemoveStorage) Remove(context.Context, string) error {
+	return errors.New("simulated remove failure")
+}
+
+type DeleteTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *DeleteTestSuite) TestDeleteMedia() {
+	ctx := context.Background()
+	testAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+	before := metrics.OrphanedMedia()
+
+	errWithCode := suite.mediaProcessor.Delete(ctx, testAttachment.ID)
+	suite.NoError(errWithCode)
+
+	_, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Files were removed, nothing orphaned.
+	suite.Equal(before, metrics.OrphanedMedia())
+}
+
+func (suite *DeleteTestSuite) TestDeleteMediaFileRemoveFails() {
+	ctx := context.Background()
+	testAttachment := suite.testAttachments["admin_account_status_1_attachment_1"]
+	before := metrics.OrphanedMedia()
+
+	// Make file removal fail.
+	underlying := suite.storage.Storage
+	suite.storage.Storage = failRemoveStorage{underlying}
+	defer func() { suite.storage.Storage = underlying }()
+
+	errWithCode := suite.mediaProcessor.Delete(ctx, testAttachment.ID)
+	suite.Error(errWithCode)
+
+	// Row should still be gone.
+	_, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Leaving the files orphaned.
+	suite.Equal(before+1, metrics.OrphanedMedia())
+}
+
+func (suite *DeleteTestSuite) TestDeleteForStatus() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_4"]
+	before := metrics.OrphanedMedia()
+
+	// Note the files of each attachment.
+	var paths []string
+	for _, id := range testStatus.AttachmentIDs {
+		attachment, err := suite.db.GetAttachmentByID(ctx, id)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		paths = append(paths, attachment.File.Path, attachment.Thumbnail.Path)
+	}
+
+	errs := suite.mediaProcessor.DeleteForStatus(ctx, testStatus.ID)
+	suite.NoError(errs.Combine())
+
+	// Rows should be gone.
+	for _, id := range testStatus.AttachmentIDs {
+		_, err := suite.db.GetAttachmentByID(ctx, id)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	// As should their files.
+	for _, path := range paths {
+		has, err := suite.storage.Has(ctx, path)
+		suite.NoError(err)
+		suite.False(has)
+	}
+	suite.Equal(before, metrics.OrphanedMedia())
+
+	// Deleting again should be a no-op.
+	errs = suite.mediaProcessor.DeleteForStatus(ctx, testStatus.ID)
+	suite.NoError(errs.Combine())
+}
+
+func TestDeleteTestSuite(t *testing.T) {
+	suite.Run(t, &DeleteTestSuite{})
+}
@@ -19,9 +19,13 @@ package polls
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -29,6 +33,17 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 )
 
+// expiryIDPrefix prefixes the scheduler
+// task IDs of poll expiries, so they can
+// be told apart from other scheduled tasks.
+const expiryIDPrefix = "@pollexpiry:"
+
+// ExpiryID returns the scheduler task
+// ID of the given poll ID's expiry.
+func ExpiryID(pollID string) string {
+	return expiryIDPrefix + pollID
+}
+
 func (p *Processor) ScheduleAll(ctx context.Context) error {
 	// Fetch all open polls from the database (barebones models are enough).
 	polls, err := p.state.DB.GetOpenPolls(gtscontext.SetBarebones(ctx))
@@ -56,7 +71,7 @@ func (p *Processor) ScheduleExpiry(ctx context.Context, poll *gtsmodel.Poll) err
 
 	// Add the given poll to the scheduler.
 	ok := p.state.Workers.Scheduler.AddOnce(
-		poll.ID,
+		ExpiryID(poll.ID),
 		poll.ExpiresAt,
 		p.onExpiry(poll.ID),
 	)
@@ -72,6 +87,73 @@ func (p *Processor) ScheduleExpiry(ctx context.Context, poll *gtsmodel.Poll) err
 	return nil
 }
 
+// ScheduleExpirySweep schedules SweepExpiries to run at
+// the configured poll expiry sweep age, if it's enabled.
+func (p *Processor) ScheduleExpirySweep() error {
+	sweepAge := config.GetStatusesPollExpirySweepAge()
+	if sweepAge <= 0 {
+		// Sweep disabled.
+		return nil
+	}
+
+	fn := func(ctx context.Context, _ time.Time) {
+		if n := p.SweepExpiries(ctx); n > 0 {
+			log.Infof(ctx, "swept %d stale poll expiries", n)
+		}
+	}
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@pollexpirysweep",
+		time.Now().Add(sweepAge),
+		sweepAge,
+		fn,
+	) {
+		return gtserror.New("failed adding poll expiry sweep to scheduler")
+	}
+
+	return nil
+}
+
+// SweepExpiries cancels scheduled poll expiries that are
+// older than the configured poll expiry sweep age, and
+// whose poll no longer exists. This catches expiries that
+// were missed when deleting a poll. Returns number swept.
+func (p *Processor) SweepExpiries(ctx context.Context) int {
+	var (
+		sweepAge = config.GetStatusesPollExpirySweepAge()
+		before   = time.Now().Add(-sweepAge)
+		swept    int
+	)
+
+	for _, id := range p.state.Workers.Scheduler.IDsAddedBefore(
+		expiryIDPrefix,
+		before,
+	) {
+		pollID := strings.TrimPrefix(id, expiryIDPrefix)
+
+		_, err := p.state.DB.GetPollByID(
+			gtscontext.SetBarebones(ctx),
+			pollID,
+		)
+		switch {
+		case err == nil:
+			// Poll still exists.
+			continue
+
+		case !errors.Is(err, db.ErrNoEntries):
+			log.Errorf(ctx, "error getting poll %s from db: %v", pollID, err)
+			continue
+		}
+
+		// Poll is gone, cancel its expiry.
+		if p.state.Workers.Scheduler.Cancel(id) {
+			swept++
+		}
+	}
+
+	return swept
+}
+
 // onExpiry returns a callback function to be used by the scheduler when the given poll expires.
 func (p *Processor) onExpiry(pollID string) func(context.Context, time.Time) {
 	return func(ctx context.Context, now time.Time) {
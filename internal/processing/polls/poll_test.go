@@ -22,12 +22,15 @@ import (
 	"math/rand"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
@@ -209,6 +212,44 @@ func (suite *PollTestSuite) testPollVote(ctx context.Context, requester *gtsmode
 }
 
 // voteChoicesAreValid is a utility function to check whether choices are valid for poll.
+func (suite *PollTestSuite) TestSweepExpiries() {
+	ctx, cncl := context.WithCancel(context.Background())
+	defer cncl()
+
+	// Schedule expiry of an existing poll, and of
+	// a poll that doesn't exist (ie., was deleted
+	// without its scheduled expiry being cancelled).
+	existing := new(gtsmodel.Poll)
+	*existing = *testrig.NewTestPolls()["local_account_1_status_6_poll"]
+	existing.ClosedAt = time.Time{}
+	existing.ExpiresAt = time.Now().Add(time.Hour)
+
+	deleted := &gtsmodel.Poll{
+		ID:        id.NewULID(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	for _, poll := range []*gtsmodel.Poll{existing, deleted} {
+		if err := suite.polls.ScheduleExpiry(ctx, poll); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Nothing is old enough to
+	// be swept with default age.
+	suite.Zero(suite.polls.SweepExpiries(ctx))
+
+	// Expiries are now old enough.
+	config.SetStatusesPollExpirySweepAge(time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+
+	// Only the deleted poll's
+	// expiry should be swept.
+	suite.Equal(1, suite.polls.SweepExpiries(ctx))
+	suite.False(suite.state.Workers.Scheduler.Cancel(polls.ExpiryID(deleted.ID)))
+	suite.True(suite.state.Workers.Scheduler.Cancel(polls.ExpiryID(existing.ID)))
+}
+
 func voteChoicesAreValid(poll *gtsmodel.Poll, choices []int) bool {
 	if len(choices) == 0 || !*poll.Multiple && len(choices) > 1 {
 		// Invalid number of vote choices.
@@ -124,6 +124,17 @@ func (p *Processor) BoostCreate(
 
 	boost.PendingApproval = &pendingApproval
 
+	if pendingApproval && !boost.PreApproved {
+		// Boost would be pending approval, make sure
+		// requester hasn't already flooded the target.
+		if errWithCode := p.checkPendingLimit(ctx,
+			requester,
+			target.AccountID,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	// Store the new boost.
 	if err := p.state.DB.PutStatus(ctx, boost); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -80,6 +80,21 @@ func (p *Processor) Create(
 		Text:                     form.Status,
 	}
 
+	if form.ExpiresAt != "" {
+		// Set the time at which to
+		// automatically wipe status.
+		expiresAt, err := util.ParseISO8601(form.ExpiresAt)
+		if err != nil {
+			err := gtserror.Newf("invalid expires_at: %w", err)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		if !expiresAt.After(time.Now()) {
+			const text = "expires_at must be in the future"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+		status.ExpiresAt = expiresAt
+	}
+
 	if form.Poll != nil {
 		// Update the status AS type to "Question".
 		status.ActivityStreamsType = ap.ActivityQuestion
@@ -123,8 +138,8 @@ func (p *Processor) Create(
 
 	// Process policy AFTER visibility as it
 	// relies on status.Visibility being set.
-	if err := processInteractionPolicy(form, requester.Settings, status); err != nil {
-		return nil, gtserror.NewErrorInternalError(err)
+	if errWithCode := p.processInteractionPolicy(ctx, form, requester.Settings, status); errWithCode != nil {
+		return nil, errWithCode
 	}
 
 	if err := processLanguage(form, requester.Settings.Language, status); err != nil {
@@ -135,6 +150,23 @@ func (p *Processor) Create(
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Process approval tag AFTER content
+	// as it relies on status.Tags being set.
+	if errWithCode := p.processApprovalTag(ctx, requester, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if util.PtrOrValue(status.PendingApproval, false) && !status.PreApproved {
+		// Reply would be pending approval, make sure
+		// requester hasn't already flooded the target.
+		if errWithCode := p.checkPendingLimit(ctx,
+			requester,
+			status.InReplyToAccountID,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	if status.Poll != nil {
 		// Try to insert the new status poll in the database.
 		if err := p.state.DB.PutPoll(ctx, status.Poll); err != nil {
@@ -167,6 +199,51 @@ func (p *Processor) Create(
 	return p.c.GetAPIStatus(ctx, requester, status)
 }
 
+// processApprovalTag pre-approves the given reply, if it's
+// pending approval by a local account, and uses the hashtag
+// with which that account approves replies automatically.
+// Tags aren't known yet when processing inReplyTo, hence
+// this being done separately.
+func (p *Processor) processApprovalTag(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	status *gtsmodel.Status,
+) gtserror.WithCode {
+	if status.InReplyTo == nil ||
+		!*status.InReplyTo.Local ||
+		!util.PtrOrValue(status.PendingApproval, false) ||
+		status.PreApproved ||
+		len(status.Tags) == 0 {
+		// Not a tagged reply pending
+		// approval by a local account.
+		return nil
+	}
+
+	tagNames := make([]string, 0, len(status.Tags))
+	for _, tag := range status.Tags {
+		tagNames = append(tagNames, tag.Name)
+	}
+
+	policyResult, err := p.intFilter.StatusReplyableWithTags(ctx,
+		requester,
+		status.InReplyTo,
+		tagNames,
+	)
+	if err != nil {
+		err := gtserror.Newf("error seeing if status %s is replyable: %w", status.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if policyResult.MatchedOnCollection() {
+		// As for any other collection match on a
+		// local target, the processor will create
+		// the Accept for this immediately.
+		status.PreApproved = true
+	}
+
+	return nil
+}
+
 func (p *Processor) processInReplyTo(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status, inReplyToID string) gtserror.WithCode {
 	if inReplyToID == "" {
 		// Not a reply.
@@ -184,7 +261,12 @@ func (p *Processor) processInReplyTo(ctx context.Context, requester *gtsmodel.Ac
 		return errWithCode
 	}
 
-	// If this is a boost, unwrap it to get source status.
+	// If this is a boost, unwrap it to get source status,
+	// keeping note of the boost that was replied to.
+	if inReplyTo.BoostOfID != "" {
+		status.InReplyToBoostID = inReplyTo.ID
+	}
+
 	inReplyTo, errWithCode = p.c.UnwrapIfBoost(ctx,
 		requester,
 		inReplyTo,
@@ -364,18 +446,31 @@ func processVisibility(
 	return nil
 }
 
-func processInteractionPolicy(
-	_ *apimodel.AdvancedStatusCreateForm,
+func (p *Processor) processInteractionPolicy(
+	ctx context.Context,
+	form *apimodel.AdvancedStatusCreateForm,
 	settings *gtsmodel.AccountSettings,
 	status *gtsmodel.Status,
-) error {
-	// TODO: parse policy for this
-	// status from form and prefer this.
-
+) gtserror.WithCode {
+	// If policy is set on the
+	// form then prefer this.
+	//
 	// TODO: prevent scope widening by
 	// limiting interaction policy if
 	// inReplyTo status has a stricter
 	// interaction policy than this one.
+	if form.InteractionPolicy != nil {
+		policy, err := typeutils.APIInteractionPolicyToInteractionPolicy(
+			form.InteractionPolicy,
+			p.converter.VisToAPIVis(ctx, status.Visibility),
+		)
+		if err != nil {
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		status.InteractionPolicy = policy
+		return nil
+	}
 
 	switch status.Visibility {
 
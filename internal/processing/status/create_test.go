@@ -19,13 +19,16 @@ package status_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type StatusCreateTestSuite struct {
@@ -265,6 +268,193 @@ func (suite *StatusCreateTestSuite) TestProcessReplyToUnthreadedRemoteStatus() {
 	suite.NotEmpty(dbStatus.ThreadID)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessAccountDefaultInteractionPolicy() {
+	ctx := context.Background()
+
+	// Copy zork.
+	creatingAccount := &gtsmodel.Account{}
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Copy zork's settings.
+	settings := &gtsmodel.AccountSettings{}
+	*settings = *suite.testAccounts["local_account_1"].Settings
+	creatingAccount.Settings = settings
+
+	// Set a default policy for public statuses
+	// so that only followers can reply.
+	creatingAccount.Settings.InteractionPolicyPublic = &gtsmodel.InteractionPolicy{
+		CanLike: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		},
+		CanReply: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{
+				gtsmodel.PolicyValueAuthor,
+				gtsmodel.PolicyValueFollowers,
+			},
+		},
+		CanAnnounce: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		},
+	}
+	if err := suite.state.DB.UpdateAccountSettings(ctx,
+		creatingAccount.Settings,
+		"interaction_policy_public",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "only my followers can reply to this",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	// New status should have
+	// the account default policy.
+	dbStatus, dbErr := suite.state.DB.GetStatusByID(ctx, apiStatus.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.Equal(creatingAccount.Settings.InteractionPolicyPublic, dbStatus.InteractionPolicy)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessInteractionPolicyOverridesAccountDefault() {
+	ctx := context.Background()
+
+	// Copy zork.
+	creatingAccount := &gtsmodel.Account{}
+	*creatingAccount = *suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Copy zork's settings.
+	settings := &gtsmodel.AccountSettings{}
+	*settings = *suite.testAccounts["local_account_1"].Settings
+	creatingAccount.Settings = settings
+
+	// Set a default policy for public statuses
+	// so that only followers can reply.
+	creatingAccount.Settings.InteractionPolicyPublic = &gtsmodel.InteractionPolicy{
+		CanLike: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		},
+		CanReply: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{
+				gtsmodel.PolicyValueAuthor,
+				gtsmodel.PolicyValueFollowers,
+			},
+		},
+		CanAnnounce: gtsmodel.PolicyRules{
+			Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+		},
+	}
+	if err := suite.state.DB.UpdateAccountSettings(ctx,
+		creatingAccount.Settings,
+		"interaction_policy_public",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Set a policy on the status itself
+	// allowing only mentioned accounts to
+	// reply, and everyone else with approval.
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "only mentioned accounts can reply to this",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+			InteractionPolicy: &apimodel.InteractionPolicy{
+				CanFavourite: apimodel.PolicyRules{
+					Always: []apimodel.PolicyValue{apimodel.PolicyValuePublic},
+				},
+				CanReply: apimodel.PolicyRules{
+					Always:       []apimodel.PolicyValue{apimodel.PolicyValueMentioned},
+					WithApproval: []apimodel.PolicyValue{apimodel.PolicyValuePublic},
+				},
+				CanReblog: apimodel.PolicyRules{
+					Always: []apimodel.PolicyValue{apimodel.PolicyValuePublic},
+				},
+			},
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+
+	// New status should have the policy from
+	// the form, not the account default policy.
+	dbStatus, dbErr := suite.state.DB.GetStatusByID(ctx, apiStatus.ID)
+	if dbErr != nil {
+		suite.FailNow(dbErr.Error())
+	}
+	suite.Equal(gtsmodel.PolicyValues{
+		gtsmodel.PolicyValueMentioned,
+		gtsmodel.PolicyValueAuthor,
+	}, dbStatus.InteractionPolicy.CanReply.Always)
+	suite.Equal(gtsmodel.PolicyValues{
+		gtsmodel.PolicyValuePublic,
+	}, dbStatus.InteractionPolicy.CanReply.WithApproval)
+}
+
+func (suite *StatusCreateTestSuite) TestProcessInteractionPolicyNotFeasible() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// "public" can't reply to
+	// a followers-only status.
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "hello followers",
+			Visibility:  apimodel.VisibilityPrivate,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+			InteractionPolicy: &apimodel.InteractionPolicy{
+				CanReply: apimodel.PolicyRules{
+					Always: []apimodel.PolicyValue{apimodel.PolicyValuePublic},
+				},
+			},
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.Nil(apiStatus)
+	suite.EqualError(errWithCode, "error converting private.can_reply.always: policyURI public is not feasible for visibility private")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *StatusCreateTestSuite) TestProcessExpiresAtNotInFuture() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this expired already",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+			ExpiresAt:   time.Now().Add(-time.Hour).UTC().Format(util.ISO8601),
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.Nil(apiStatus)
+	suite.EqualError(errWithCode, "expires_at must be in the future")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
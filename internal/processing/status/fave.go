@@ -140,6 +140,17 @@ func (p *Processor) FaveCreate(
 
 	status.PendingApproval = &pendingApproval
 
+	if pendingApproval && !preApproved {
+		// Fave would be pending approval, make sure
+		// requester hasn't already flooded the target.
+		if errWithCode := p.checkPendingLimit(ctx,
+			requester,
+			status.AccountID,
+		); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	// Create a new fave, marking it
 	// as pending approval if necessary.
 	faveID := id.NewULID()
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusFaveTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusFaveTestSuite) TestFavePendingLimit() {
+	var (
+		ctx       = context.Background()
+		requester = suite.testAccounts["local_account_2"]
+		statuses  = []*gtsmodel.Status{
+			suite.testStatuses["local_account_1_status_1"],
+			suite.testStatuses["local_account_1_status_2"],
+		}
+	)
+
+	// Allow only one pending
+	// interaction per account.
+	config.SetInstanceInteractionPendingLimit(1)
+
+	// Make likes of both statuses
+	// require approval from zork.
+	for _, status := range statuses {
+		status.InteractionPolicy = &gtsmodel.InteractionPolicy{
+			CanLike: gtsmodel.PolicyRules{
+				Always:       gtsmodel.PolicyValues{gtsmodel.PolicyValueAuthor},
+				WithApproval: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+			},
+			CanReply: gtsmodel.PolicyRules{
+				Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+			},
+			CanAnnounce: gtsmodel.PolicyRules{
+				Always: gtsmodel.PolicyValues{gtsmodel.PolicyValuePublic},
+			},
+		}
+		if err := suite.db.UpdateStatus(ctx, status, "interaction_policy"); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// First fave should be
+	// stored pending approval.
+	if _, errWithCode := suite.status.FaveCreate(ctx,
+		requester,
+		statuses[0].ID,
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Second fave should be rejected, as the
+	// account already has 1 pending interaction.
+	_, errWithCode := suite.status.FaveCreate(ctx,
+		requester,
+		statuses[1].ID,
+	)
+	if suite.NotNil(errWithCode) {
+		suite.Equal(http.StatusForbidden, errWithCode.Code())
+	}
+}
+
+func TestStatusFaveTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusFaveTestSuite))
+}
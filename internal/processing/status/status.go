@@ -18,9 +18,13 @@
 package status
 
 import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/interaction"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
@@ -68,3 +72,36 @@ func New(
 		polls:        polls,
 	}
 }
+
+// checkPendingLimit returns a forbidden error if the requester
+// already has the configured maximum number of interactions
+// pending approval by the target account, as any further
+// interaction with it would need approval too.
+func (p *Processor) checkPendingLimit(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetAccountID string,
+) gtserror.WithCode {
+	limit := config.GetInstanceInteractionPendingLimit()
+	if limit <= 0 {
+		// No limit set.
+		return nil
+	}
+
+	pending, err := p.state.DB.CountPendingInteractions(ctx,
+		requester.ID,
+		targetAccountID,
+	)
+	if err != nil {
+		err := gtserror.Newf("db error counting pending interactions: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if pending >= limit {
+		const errText = "you have too many interactions pending approval by this account"
+		err := gtserror.New(errText)
+		return gtserror.NewErrorForbidden(err, errText)
+	}
+
+	return nil
+}
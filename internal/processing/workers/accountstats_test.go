@@ -0,0 +1,88 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountStatsTestSuite struct {
+	WorkersTestSuite
+}
+
+func (suite *AccountStatsTestSuite) TestRecalculateAccountStats() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	// Get the accurate stats of zork.
+	want, err := testStructs.State.DB.CountAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Inject drift into zork's stats.
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+	*account.Stats.FollowersCount += 5
+	*account.Stats.StatusesCount = 0
+	*account.Stats.FollowRequestsCount += 2
+	if err := testStructs.State.DB.UpdateAccountStats(ctx,
+		account.Stats,
+		"followers_count",
+		"statuses_count",
+		"follow_requests_count",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Recalculate, which should repair them.
+	if err := testStructs.Processor.Workers().RecalculateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	got, err := testStructs.State.DB.GetAccountByID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, got); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(*want.FollowersCount, *got.Stats.FollowersCount)
+	suite.Equal(*want.LocalFollowersCount, *got.Stats.LocalFollowersCount)
+	suite.Equal(*want.RemoteFollowersCount, *got.Stats.RemoteFollowersCount)
+	suite.Equal(*want.FollowingCount, *got.Stats.FollowingCount)
+	suite.Equal(*want.FollowRequestsCount, *got.Stats.FollowRequestsCount)
+	suite.Equal(*want.StatusesCount, *got.Stats.StatusesCount)
+	suite.Equal(*want.StatusesPinnedCount, *got.Stats.StatusesPinnedCount)
+	suite.True(want.LastStatusAt.Equal(got.Stats.LastStatusAt))
+	suite.False(got.Stats.RegeneratedAt.IsZero())
+}
+
+func TestAccountStatsTestSuite(t *testing.T) {
+	suite.Run(t, &AccountStatsTestSuite{})
+}
@@ -0,0 +1,242 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// ScheduleApprovalExpiries schedules expiry tasks for
+// all stored interaction approvals with an expiry time.
+func (p *Processor) ScheduleApprovalExpiries(ctx context.Context) error {
+	// Fetch all approvals with an expiry set from the database.
+	approvals, err := p.state.DB.GetExpiringInteractionApprovals(ctx)
+	if err != nil {
+		return gtserror.Newf("error getting expiring approvals from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, approval := range approvals {
+		// Schedule each of the approvals and catch any errors.
+		if err := p.ScheduleApprovalExpiry(ctx, approval); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// ScheduleApprovalExpiry schedules the given interaction approval
+// to be expired at its ExpiresAt time, at which point the approved
+// interaction will be hidden again and a Reject sent out for it.
+func (p *Processor) ScheduleApprovalExpiry(ctx context.Context, approval *gtsmodel.InteractionApproval) error {
+	return p.clientAPI.utils.scheduleApprovalExpiry(ctx, approval)
+}
+
+// setApprovalExpiry sets the expiry time of the given, about to
+// be stored approval, according to the approving account's
+// settings. Only approvals by local accounts can have an expiry.
+func (u *utils) setApprovalExpiry(ctx context.Context, approval *gtsmodel.InteractionApproval) error {
+	if approval.Account == nil {
+		// Approving account not set, fetch from the db.
+		var err error
+		approval.Account, err = u.state.DB.GetAccountByID(ctx, approval.AccountID)
+		if err != nil {
+			return gtserror.Newf("db error getting approving account: %w", err)
+		}
+	}
+
+	if !approval.Account.IsLocal() {
+		// Expiry is only set
+		// by local accounts.
+		return nil
+	}
+
+	if approval.Account.Settings == nil {
+		// Settings not set, fetch from the db.
+		var err error
+		approval.Account.Settings, err = u.state.DB.GetAccountSettings(ctx, approval.AccountID)
+		if err != nil {
+			return gtserror.Newf("db error getting approving account settings: %w", err)
+		}
+	}
+
+	days := approval.Account.Settings.InteractionApprovalExpiryDays
+	if days <= 0 {
+		// Approvals
+		// don't expire.
+		return nil
+	}
+
+	approval.ExpiresAt = time.Now().Add(time.Duration(days) * 24 * time.Hour)
+	return nil
+}
+
+// scheduleApprovalExpiry schedules the given approval to be
+// expired at its ExpiresAt time. Approval expiry tasks are
+// keyed by gtsmodel.ApprovalExpiryID, so that they can be
+// cancelled by it wherever approvals are deleted.
+func (u *utils) scheduleApprovalExpiry(ctx context.Context, approval *gtsmodel.InteractionApproval) error {
+	// Ensure has a valid expiry.
+	if !approval.Expires() {
+		return gtserror.Newf("approval %s has no expiry", approval.ID)
+	}
+
+	// Add the given approval to the scheduler.
+	ok := u.state.Workers.Scheduler.AddOnce(
+		gtsmodel.ApprovalExpiryID(approval.ID),
+		approval.ExpiresAt,
+		u.onApprovalExpiry(approval.ID),
+	)
+
+	if !ok {
+		// Failed to add the approval to the scheduler, either it was
+		// starting / stopping or there already exists a task for approval.
+		return gtserror.Newf("failed adding approval %s to scheduler", approval.ID)
+	}
+
+	atStr := approval.ExpiresAt.Local().Format("Jan _2 2006 15:04:05")
+	log.Infof(ctx, "scheduled approval expiry for %s at '%s'", approval.ID, atStr)
+	return nil
+}
+
+// cancelApprovalExpiries cancels the scheduled
+// expiry tasks of the approvals with given IDs,
+// for use once those approvals are deleted.
+func (u *utils) cancelApprovalExpiries(approvalIDs []string) {
+	for _, approvalID := range approvalIDs {
+		_ = u.state.Workers.Scheduler.Cancel(gtsmodel.ApprovalExpiryID(approvalID))
+	}
+}
+
+// onApprovalExpiry returns a callback function to be used
+// by the scheduler when the given interaction approval expires.
+func (u *utils) onApprovalExpiry(approvalID string) func(context.Context, time.Time) {
+	return func(ctx context.Context, now time.Time) {
+		// Fired tasks stay registered,
+		// drop this one as it's done.
+		_ = u.state.Workers.Scheduler.Cancel(gtsmodel.ApprovalExpiryID(approvalID))
+
+		// Get the latest version of approval from database.
+		approval, err := u.state.DB.GetInteractionApprovalByID(ctx, approvalID)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error getting approval %s from db: %v", approvalID, err)
+			}
+			return
+		}
+
+		// Mark the approved interaction as pending
+		// approval again, hiding it from view.
+		objectType, err := u.unapproveInteraction(ctx, approval)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "error unapproving interaction %s: %v", approval.InteractionURI, err)
+			return
+		}
+
+		// The approval is no longer valid, remove it.
+		if err := u.state.DB.DeleteInteractionApprovalByID(ctx, approvalID); err != nil {
+			log.Errorf(ctx, "error deleting approval %s from db: %v", approvalID, err)
+			return
+		}
+
+		if objectType == "" {
+			// Interaction itself
+			// has since been removed,
+			// nothing left to Reject.
+			return
+		}
+
+		// Enqueue a reject operation to the client API worker,
+		// this will asynchronously clear up timelines and send
+		// a Reject of the interaction to the interacting account.
+		u.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APActivityType: ap.ActivityReject,
+			APObjectType:   objectType,
+			GTSModel:       approval,
+			Origin:         approval.Account,
+			Target:         approval.InteractingAccount,
+		})
+	}
+}
+
+// unapproveInteraction marks the interaction targeted by the
+// given approval as pending approval again, returning the AP
+// object type of the interaction for further processing.
+func (u *utils) unapproveInteraction(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+) (string, error) {
+	if approval.InteractionType == gtsmodel.InteractionLike {
+		fave, err := u.state.DB.GetStatusFaveByURI(ctx, approval.InteractionURI)
+		if err != nil {
+			return "", gtserror.Newf("db error getting fave: %w", err)
+		}
+
+		// Mark the fave as pending again.
+		fave.PendingApproval = util.Ptr(true)
+		fave.ApprovedByURI = ""
+
+		if err := u.state.DB.UpdateStatusFave(
+			ctx,
+			fave,
+			"pending_approval",
+			"approved_by_uri",
+		); err != nil {
+			return "", gtserror.Newf("db error updating status fave: %w", err)
+		}
+
+		return ap.ActivityLike, nil
+	}
+
+	// Reply or announce, both stored as statuses.
+	status, err := u.state.DB.GetStatusByURI(ctx, approval.InteractionURI)
+	if err != nil {
+		return "", gtserror.Newf("db error getting status: %w", err)
+	}
+
+	// Mark the status as pending again.
+	status.PendingApproval = util.Ptr(true)
+	status.ApprovedByURI = ""
+
+	if err := u.state.DB.UpdateStatus(
+		ctx,
+		status,
+		"pending_approval",
+		"approved_by_uri",
+	); err != nil {
+		return "", gtserror.Newf("db error updating status: %w", err)
+	}
+
+	if status.BoostOfID != "" {
+		return ap.ActivityAnnounce, nil
+	}
+
+	return ap.ObjectNote, nil
+}
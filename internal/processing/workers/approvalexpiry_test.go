@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ApprovalExpiryTestSuite struct {
+	WorkersTestSuite
+}
+
+func (suite *ApprovalExpiryTestSuite) TestApprovalExpiryRehidesReply() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = context.Background()
+		account        = suite.testAccounts["local_account_1"]
+		replyingStatus = suite.testStatuses["remote_account_1_status_1"]
+		approvalID     = "01J5QVKB5NXM69MJ7QT3JKB8P6"
+		approvalURI    = "http://localhost:8080/users/the_mighty_zork/accepts/" + approvalID
+	)
+
+	// Make the remote status a reply to zork's status,
+	// approved by zork for just a short amount of time.
+	replyingStatus.InReplyToID = suite.testStatuses["local_account_1_status_1"].ID
+	replyingStatus.InReplyToURI = suite.testStatuses["local_account_1_status_1"].URI
+	replyingStatus.InReplyToAccountID = account.ID
+	replyingStatus.PendingApproval = util.Ptr(false)
+	replyingStatus.ApprovedByURI = approvalURI
+	if err := testStructs.State.DB.UpdateStatus(
+		ctx,
+		replyingStatus,
+		"in_reply_to_id",
+		"in_reply_to_uri",
+		"in_reply_to_account_id",
+		"pending_approval",
+		"approved_by_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	approval := &gtsmodel.InteractionApproval{
+		ID:                   approvalID,
+		AccountID:            account.ID,
+		InteractingAccountID: replyingStatus.AccountID,
+		InteractionURI:       replyingStatus.URI,
+		InteractionType:      gtsmodel.InteractionReply,
+		URI:                  approvalURI,
+		ExpiresAt:            time.Now().Add(500 * time.Millisecond),
+	}
+	if err := testStructs.State.DB.PutInteractionApproval(ctx, approval); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Schedule the expiry.
+	if err := testStructs.Processor.Workers().ScheduleApprovalExpiry(ctx, approval); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Wait for the reply to be hidden again.
+	var reply *gtsmodel.Status
+	if !testrig.WaitFor(func() bool {
+		var err error
+		reply, err = testStructs.State.DB.GetStatusByID(ctx, replyingStatus.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *reply.PendingApproval
+	}) {
+		suite.FailNow("timed out waiting for reply to be hidden")
+	}
+	suite.Empty(reply.ApprovedByURI)
+
+	// The approval itself should be gone.
+	_, err := testStructs.State.DB.GetInteractionApprovalByID(ctx, approvalID)
+	if !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow("", "expected ErrNoEntries, got %v", err)
+	}
+
+	// A Reject should have been queued for delivery to the replier.
+	var sent []byte
+	if !testrig.WaitFor(func() bool {
+		delivery, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+		if !ok {
+			return false
+		}
+		sent, err = io.ReadAll(delivery.Request.Body)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return true
+	}) {
+		suite.FailNow("timed out waiting for Reject to be sent")
+	}
+	suite.Contains(string(sent), `"type":"Reject"`)
+	suite.Contains(string(sent), `"object":"`+replyingStatus.URI+`"`)
+}
+
+func (suite *ApprovalExpiryTestSuite) TestApprovalExpiryTaskID() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	ctx := context.Background()
+
+	approval := &gtsmodel.InteractionApproval{
+		ID:        "01JAB7W2Q4N6R8T0V2X4Z6B8D0",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Schedule the expiry.
+	if err := testStructs.Processor.Workers().ScheduleApprovalExpiry(ctx, approval); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Task shouldn't be keyed by bare approval ID,
+	// as that may clash with other tasks' IDs.
+	suite.False(testStructs.State.Workers.Scheduler.Cancel(approval.ID))
+	suite.True(testStructs.State.Workers.Scheduler.Cancel(gtsmodel.ApprovalExpiryID(approval.ID)))
+}
+
+func TestApprovalExpiryTestSuite(t *testing.T) {
+	suite.Run(t, &ApprovalExpiryTestSuite{})
+}
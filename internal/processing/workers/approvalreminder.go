@@ -0,0 +1,172 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// ScheduleApprovalReminders schedules a recurring task to
+// remind accounts of interactions awaiting their approval,
+// if enabled in the instance config.
+func (p *Processor) ScheduleApprovalReminders() error {
+	every := config.GetInstanceInteractionPendingReminderEvery()
+	if every <= 0 {
+		// Reminders
+		// disabled.
+		return nil
+	}
+
+	// Check at least hourly, so that reminders
+	// go out roughly on time for each account.
+	freq := min(every, time.Hour)
+
+	if !p.state.Workers.Scheduler.AddRecurring(
+		"@approvalreminders",
+		time.Time{},
+		freq,
+		func(ctx context.Context, now time.Time) {
+			if err := p.SendApprovalReminders(ctx, now); err != nil {
+				log.Errorf(ctx, "error sending approval reminders: %v", err)
+			}
+		},
+	) {
+		return gtserror.New("failed to schedule @approvalreminders")
+	}
+
+	return nil
+}
+
+// SendApprovalReminders notifies each local account that
+// opted in to reminders, and which has interactions still
+// pending its approval, of how many are awaiting approval.
+//
+// An account is reminded at most once per configured
+// interval; each new reminder replaces the previous one.
+func (p *Processor) SendApprovalReminders(ctx context.Context, now time.Time) error {
+	every := config.GetInstanceInteractionPendingReminderEvery()
+	if every <= 0 {
+		// Reminders
+		// disabled.
+		return nil
+	}
+
+	accountIDs, err := p.state.DB.GetAccountIDsWithPendingInteractions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting accounts with pending interactions: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, accountID := range accountIDs {
+		if err := p.sendApprovalReminder(ctx, accountID, now, every); err != nil {
+			errs.Appendf("error reminding account %s: %w", accountID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (p *Processor) sendApprovalReminder(
+	ctx context.Context,
+	accountID string,
+	now time.Time,
+	every time.Duration,
+) error {
+	account, err := p.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	if err != nil {
+		return gtserror.Newf("db error getting account: %w", err)
+	}
+
+	if account.IsRemote() || account.IsSuspended() {
+		// Only remind
+		// active locals.
+		return nil
+	}
+
+	if account.Settings == nil {
+		account.Settings, err = p.state.DB.GetAccountSettings(ctx, account.ID)
+		if err != nil {
+			return gtserror.Newf("db error getting account settings: %w", err)
+		}
+	}
+
+	if !util.PtrOrZero(account.Settings.InteractionPendingReminders) {
+		// Not opted
+		// in, skip.
+		return nil
+	}
+
+	// Check for a previous reminder.
+	prev, err := p.state.DB.GetNotification(
+		gtscontext.SetBarebones(ctx),
+		gtsmodel.NotificationPendingRemind,
+		account.ID,
+		account.ID,
+		"",
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting previous reminder: %w", err)
+	}
+
+	if prev != nil {
+		if now.Sub(prev.CreatedAt) < every {
+			// Reminded recently
+			// enough already.
+			return nil
+		}
+
+		// Remove the previous reminder
+		// so it's replaced by a fresh one.
+		if err := p.state.DB.DeleteNotificationByID(ctx, prev.ID); err != nil {
+			return gtserror.Newf("db error deleting previous reminder: %w", err)
+		}
+	}
+
+	// Still anything to be reminded of?
+	count, err := p.state.DB.CountPendingInteractionsForAccount(ctx, account.ID)
+	if err != nil {
+		return gtserror.Newf("db error counting pending interactions: %w", err)
+	}
+
+	if count == 0 {
+		// Nothing
+		// pending.
+		return nil
+	}
+
+	return p.surface.Notify(ctx,
+		gtsmodel.NotificationPendingRemind,
+		account,
+		account,
+		"",
+	)
+}
@@ -0,0 +1,140 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ApprovalReminderTestSuite struct {
+	WorkersTestSuite
+}
+
+func (suite *ApprovalReminderTestSuite) TestApprovalReminder() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx         = context.Background()
+		account     = suite.testAccounts["local_account_1"]
+		optedOut    = suite.testAccounts["local_account_2"]
+		faver       = suite.testAccounts["remote_account_1"]
+		now         = time.Now()
+		state       = testStructs.State
+		workers     = testStructs.Processor.Workers()
+		statusOptIn = suite.testStatuses["local_account_1_status_1"]
+		statusOut   = suite.testStatuses["local_account_2_status_1"]
+	)
+
+	// Fave a status of both zork and
+	// turtle, pending their approval.
+	for _, status := range []*gtsmodel.Status{statusOptIn, statusOut} {
+		if err := state.DB.PutStatusFave(ctx, &gtsmodel.StatusFave{
+			ID:              id.NewULID(),
+			AccountID:       faver.ID,
+			TargetAccountID: status.AccountID,
+			StatusID:        status.ID,
+			URI:             faver.URI + "/likes/" + status.ID,
+			PendingApproval: util.Ptr(true),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Opt zork in to reminders.
+	settings, err := state.DB.GetAccountSettings(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.InteractionPendingReminders = util.Ptr(true)
+	if err := state.DB.UpdateAccountSettings(ctx,
+		settings,
+		"interaction_pending_reminders",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// getReminder returns the current
+	// reminder for account, if any.
+	getReminder := func(account *gtsmodel.Account) *gtsmodel.Notification {
+		notif, err := state.DB.GetNotification(
+			gtscontext.SetBarebones(ctx),
+			gtsmodel.NotificationPendingRemind,
+			account.ID,
+			account.ID,
+			"",
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+		return notif
+	}
+
+	// Send reminders.
+	if err := workers.SendApprovalReminders(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Zork should have been reminded,
+	// turtle should not have been.
+	reminder := getReminder(account)
+	if reminder == nil {
+		suite.FailNow("expected reminder for opted in account")
+	}
+	suite.Nil(getReminder(optedOut))
+
+	// Pending count should be included in API model.
+	apiNotif, err := testStructs.TypeConverter.NotificationToAPINotification(ctx, reminder, nil, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("pending.reminder", apiNotif.Type)
+	suite.Positive(apiNotif.PendingCount)
+
+	// Send reminders again within the interval;
+	// the existing reminder should be left alone.
+	if err := workers.SendApprovalReminders(ctx, now.Add(time.Hour)); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(reminder.ID, getReminder(account).ID)
+
+	// Send reminders again after the interval;
+	// the reminder should have been replaced.
+	if err := workers.SendApprovalReminders(ctx, now.Add(25*time.Hour)); err != nil {
+		suite.FailNow(err.Error())
+	}
+	newReminder := getReminder(account)
+	if newReminder == nil {
+		suite.FailNow("expected new reminder after interval")
+	}
+	suite.NotEqual(reminder.ID, newReminder.ID)
+}
+
+func TestApprovalReminderTestSuite(t *testing.T) {
+	suite.Run(t, &ApprovalReminderTestSuite{})
+}
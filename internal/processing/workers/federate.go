@@ -1196,3 +1196,92 @@ func (f *federate) AcceptInteraction(
 
 	return nil
 }
+
+func (f *federate) RejectInteraction(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+) error {
+	// Populate model.
+	if err := f.state.DB.PopulateInteractionApproval(ctx, approval); err != nil {
+		return gtserror.Newf("error populating approval: %w", err)
+	}
+
+	return f.RejectInteractionURI(ctx,
+		approval.Account,
+		approval.InteractingAccount,
+		approval.InteractionURI,
+	)
+}
+
+// RejectInteractionURI sends a Reject of the interaction
+// with given URI, from the interacted-with account to the
+// interacting account. This can be used for rejecting
+// interactions that were never approved in the first place.
+func (f *federate) RejectInteractionURI(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	interactingAccount *gtsmodel.Account,
+	interactionURI string,
+) error {
+	// Bail if interacting account is ours:
+	// we've already rejected internally and
+	// shouldn't send a Reject to ourselves.
+	if interactingAccount.IsLocal() {
+		return nil
+	}
+
+	// Bail if account isn't ours:
+	// we can't Reject on another
+	// instance's behalf. (This
+	// should never happen but...)
+	if account.IsRemote() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	rejectingAcctIRI, err := parseURI(account.URI)
+	if err != nil {
+		return err
+	}
+
+	interactingAcctURI, err := parseURI(interactingAccount.URI)
+	if err != nil {
+		return err
+	}
+
+	interactionIRI, err := parseURI(interactionURI)
+	if err != nil {
+		return err
+	}
+
+	// Create a new Reject.
+	reject := streams.NewActivityStreamsReject()
+
+	// Set interacted-with account
+	// as Actor of the Reject.
+	ap.AppendActorIRIs(reject, rejectingAcctIRI)
+
+	// Set the interacted-with object
+	// as Object of the Reject.
+	ap.AppendObjectIRIs(reject, interactionIRI)
+
+	// Address the Reject To the interacting acct.
+	ap.AppendTo(reject, interactingAcctURI)
+
+	// Send the Reject via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, reject,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T for %s via outbox %s: %w",
+			reject, interactionURI, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
@@ -25,8 +25,10 @@ import (
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
@@ -48,6 +50,14 @@ type clientAPI struct {
 }
 
 func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	// Give the message an operation ID on
+	// first processing, so that stats are
+	// only updated once should it be retried.
+	if cMsg.OpID == "" {
+		cMsg.OpID = id.NewULID()
+	}
+	ctx = gtscontext.SetStatsOpID(ctx, cMsg.OpID)
+
 	// Allocate new log fields slice
 	fields := make([]kv.Field, 3, 4)
 	fields[0] = kv.Field{"activityType", cMsg.APActivityType}
@@ -160,6 +170,18 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg *messages.Fro
 		// REJECT USER (ie., new user+account sign-up)
 		case ap.ObjectProfile:
 			return p.clientAPI.RejectUser(ctx, cMsg)
+
+		// REJECT LIKE
+		case ap.ActivityLike:
+			return p.clientAPI.RejectLike(ctx, cMsg)
+
+		// REJECT REPLY
+		case ap.ObjectNote:
+			return p.clientAPI.RejectReply(ctx, cMsg)
+
+		// REJECT BOOST
+		case ap.ActivityAnnounce:
+			return p.clientAPI.RejectAnnounce(ctx, cMsg)
 		}
 
 	// UNDO SOMETHING
@@ -248,6 +270,14 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
 	}
 
+	if status.Expires() {
+		// Schedule the status to be
+		// wiped at its expiry time.
+		if err := p.utils.scheduleStatusExpiry(ctx, status); err != nil {
+			log.Errorf(ctx, "error scheduling status expiry: %v", err)
+		}
+	}
+
 	// If pending approval is true then status must
 	// reply to a status (either one of ours or a
 	// remote) that requires approval for the reply.
@@ -256,7 +286,23 @@ func (p *clientAPI) CreateStatus(ctx context.Context, cMsg *messages.FromClientA
 		false,
 	)
 
+	if pendingApproval && !status.PreApproved {
+		// Ensure status populated, including
+		// replied-to account, before checking
+		// if replying account is too new.
+		if err := p.state.DB.PopulateStatus(ctx, status); err != nil {
+			return gtserror.Newf("error populating status: %w", err)
+		}
+	}
+
 	switch {
+	case pendingApproval && !status.PreApproved &&
+		p.utils.tooNewToInteract(ctx, status.Account, status.InReplyToAccount):
+		// Replying account is younger than the minimum
+		// account age set by the replied-to account,
+		// so automatically reject the reply instead.
+		return p.utils.rejectTooNewReply(ctx, status, true)
+
 	case pendingApproval && !status.PreApproved:
 		// If approval is required and status isn't
 		// preapproved, then send out the Create to
@@ -372,6 +418,15 @@ func (p *clientAPI) CreateFollowReq(ctx context.Context, cMsg *messages.FromClie
 		return gtserror.Newf("%T not parseable as *gtsmodel.FollowRequest", cMsg.GTSModel)
 	}
 
+	// Update stats for the target account.
+	//
+	// This is done even if the request is accepted
+	// immediately below, since AcceptFollow moves
+	// the request count over to the followers count.
+	if err := p.utils.incrementFollowRequestsCount(ctx, cMsg.Target, followRequest.ID); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
 	// If target is a local, unlocked account,
 	// we can skip side effects for the follow
 	// request and accept the follow immediately.
@@ -396,11 +451,6 @@ func (p *clientAPI) CreateFollowReq(ctx context.Context, cMsg *messages.FromClie
 		})
 	}
 
-	// Update stats for the target account.
-	if err := p.utils.incrementFollowRequestsCount(ctx, cMsg.Target); err != nil {
-		log.Errorf(ctx, "error updating account stats: %v", err)
-	}
-
 	if err := p.surface.notifyFollowRequest(ctx, followRequest); err != nil {
 		log.Errorf(ctx, "error notifying follow request: %v", err)
 	}
@@ -438,6 +488,13 @@ func (p *clientAPI) CreateLike(ctx context.Context, cMsg *messages.FromClientAPI
 	)
 
 	switch {
+	case pendingApproval && !fave.PreApproved &&
+		p.utils.tooNewToInteract(ctx, fave.Account, fave.TargetAccount):
+		// Faving account is younger than the minimum
+		// account age set by the faved account, so
+		// automatically reject the fave instead.
+		return p.utils.rejectTooNewFave(ctx, fave)
+
 	case pendingApproval && !fave.PreApproved:
 		// If approval is required and fave isn't
 		// preapproved, then send out the Like to
@@ -514,6 +571,13 @@ func (p *clientAPI) CreateAnnounce(ctx context.Context, cMsg *messages.FromClien
 	)
 
 	switch {
+	case pendingApproval && !boost.PreApproved &&
+		p.utils.tooNewToInteract(ctx, boost.Account, boost.BoostOfAccount):
+		// Boosting account is younger than the minimum
+		// account age set by the boosted account, so
+		// automatically reject the boost instead.
+		return p.utils.rejectTooNewAnnounce(ctx, boost)
+
 	case pendingApproval && !boost.PreApproved:
 		// If approval is required and boost isn't
 		// preapproved, then send out the Announce to
@@ -613,6 +677,11 @@ func (p *clientAPI) CreateBlock(ctx context.Context, cMsg *messages.FromClientAP
 	// TODO: same with notifications?
 	// TODO: same with bookmarks?
 
+	// Remove blockee's faves of blocker's statuses, if configured.
+	if err := p.utils.removeBlockedFaves(ctx, block); err != nil {
+		log.Errorf(ctx, "error removing faves for block: %v", err)
+	}
+
 	if err := p.federate.Block(ctx, block); err != nil {
 		log.Errorf(ctx, "error federating block: %v", err)
 	}
@@ -627,6 +696,18 @@ func (p *clientAPI) UpdateStatus(ctx context.Context, cMsg *messages.FromClientA
 		return gtserror.Newf("cannot cast %T -> *gtsmodel.Status", cMsg.GTSModel)
 	}
 
+	// Concurrent edits may have left the
+	// attachment IDs in a bad state, fix.
+	if err := p.utils.repairAttachmentIDs(ctx, status); err != nil {
+		log.Errorf(ctx, "error repairing attachment ids: %v", err)
+	}
+
+	// The edit may have changed or
+	// cleared the status expiry.
+	if err := p.utils.updateStatusExpiry(ctx, status); err != nil {
+		log.Errorf(ctx, "error updating status expiry: %v", err)
+	}
+
 	// Federate the updated status changes out remotely.
 	if err := p.federate.UpdateStatus(ctx, status); err != nil {
 		log.Errorf(ctx, "error federating status update: %v", err)
@@ -662,9 +743,125 @@ func (p *clientAPI) UpdateAccount(ctx context.Context, cMsg *messages.FromClient
 		log.Errorf(ctx, "error federating account update: %v", err)
 	}
 
+	// If the account is now unlocked, any follow requests
+	// still pending were made while it was locked (ie., it
+	// has just been unlocked), so accept them all now.
+	//
+	// When switching from unlocked to locked there
+	// are no pending requests, so this is a no-op.
+	if account.IsLocal() && !*account.Locked {
+		if err := p.acceptAllFollowRequests(ctx, account); err != nil {
+			log.Errorf(ctx, "error accepting pending follow requests: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// acceptAllFollowRequests accepts all follow requests pending
+// for the given (now unlocked) account, creating follows for
+// each and recomputing the account's follower + follow request
+// counts in one go while holding the account's processing lock.
+func (p *clientAPI) acceptAllFollowRequests(ctx context.Context, account *gtsmodel.Account) error {
+	follows, err := p.acceptFollowRequests(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	// Do side effects for each newly-accepted follow.
+	for _, follow := range follows {
+		// Update stats for the origin account.
+		if err := p.utils.incrementFollowingCount(ctx, follow.Account, follow.ID); err != nil {
+			log.Errorf(ctx, "error updating account stats: %v", err)
+		}
+
+		if err := p.surface.notifyFollow(ctx, follow); err != nil {
+			log.Errorf(ctx, "error notifying follow: %v", err)
+		}
+
+		if err := p.federate.AcceptFollow(ctx, follow); err != nil {
+			log.Errorf(ctx, "error federating follow accept: %v", err)
+		}
+	}
+
 	return nil
 }
 
+// acceptFollowRequests does the database part of
+// acceptAllFollowRequests, returning accepted follows.
+func (p *clientAPI) acceptFollowRequests(ctx context.Context, account *gtsmodel.Account) ([]*gtsmodel.Follow, error) {
+	// Lock on this account since we're changing stats.
+	unlock := p.state.ProcessingLocks.Lock(account.URI)
+	defer unlock()
+
+	// Get all follow requests currently targeting account.
+	followReqs, err := p.state.DB.GetAccountFollowRequests(ctx, account.ID, nil)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting follow requests: %w", err)
+	}
+
+	// Populate stats.
+	if err := p.state.DB.PopulateAccountStats(ctx, account); err != nil {
+		return nil, gtserror.Newf("db error getting account stats: %w", err)
+	}
+
+	if len(followReqs) == 0 &&
+		*account.Stats.FollowRequestsCount == 0 {
+		// Nothing to do.
+		return nil, nil
+	}
+
+	var accepted, acceptedLocal, acceptedRemote int
+	follows := make([]*gtsmodel.Follow, 0, len(followReqs))
+	for _, followReq := range followReqs {
+		follow, err := p.state.DB.AcceptFollowRequest(ctx,
+			followReq.AccountID,
+			followReq.TargetAccountID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "db error accepting follow request %s: %v", followReq.ID, err)
+			continue
+		}
+
+		// Follow now exists.
+		accepted++
+
+		if follow.Account == nil {
+			// Requesting account has since been
+			// removed, nothing more to do for this.
+			continue
+		}
+
+		if follow.Account.IsLocal() {
+			acceptedLocal++
+		} else {
+			acceptedRemote++
+		}
+
+		follows = append(follows, follow)
+	}
+
+	// Update stats for all accepted requests at once:
+	// the followers count goes up by however many were
+	// accepted, and there are no pending requests left.
+	*account.Stats.FollowersCount += accepted
+	account.Stats.LocalFollowersCount = util.Ptr(util.PtrOrZero(account.Stats.LocalFollowersCount) + acceptedLocal)
+	account.Stats.RemoteFollowersCount = util.Ptr(util.PtrOrZero(account.Stats.RemoteFollowersCount) + acceptedRemote)
+	*account.Stats.FollowRequestsCount = 0
+	if err := p.state.DB.UpdateAccountStats(
+		ctx,
+		account.Stats,
+		"followers_count",
+		"local_followers_count",
+		"remote_followers_count",
+		"follow_requests_count",
+	); err != nil {
+		return nil, gtserror.Newf("db error updating account stats: %w", err)
+	}
+
+	return follows, nil
+}
+
 func (p *clientAPI) UpdateReport(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	report, ok := cMsg.GTSModel.(*gtsmodel.Report)
 	if !ok {
@@ -707,16 +904,16 @@ func (p *clientAPI) AcceptFollow(ctx context.Context, cMsg *messages.FromClientA
 	}
 
 	// Update stats for the target account.
-	if err := p.utils.decrementFollowRequestsCount(ctx, cMsg.Target); err != nil {
+	if err := p.utils.decrementFollowRequestsCount(ctx, cMsg.Target, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
-	if err := p.utils.incrementFollowersCount(ctx, cMsg.Target); err != nil {
+	if err := p.utils.incrementFollowersCount(ctx, cMsg.Target, cMsg.Origin, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
 	// Update stats for the origin account.
-	if err := p.utils.incrementFollowingCount(ctx, cMsg.Origin); err != nil {
+	if err := p.utils.incrementFollowingCount(ctx, cMsg.Origin, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -738,7 +935,7 @@ func (p *clientAPI) RejectFollowRequest(ctx context.Context, cMsg *messages.From
 	}
 
 	// Update stats for the target account.
-	if err := p.utils.decrementFollowRequestsCount(ctx, cMsg.Target); err != nil {
+	if err := p.utils.decrementFollowRequestsCount(ctx, cMsg.Target, followReq.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -753,18 +950,24 @@ func (p *clientAPI) RejectFollowRequest(ctx context.Context, cMsg *messages.From
 }
 
 func (p *clientAPI) UndoFollow(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	if followReq, ok := cMsg.GTSModel.(*gtsmodel.FollowRequest); ok {
+		// A pending follow request
+		// was withdrawn by requester.
+		return p.UndoFollowRequest(ctx, cMsg, followReq)
+	}
+
 	follow, ok := cMsg.GTSModel.(*gtsmodel.Follow)
 	if !ok {
 		return gtserror.Newf("%T not parseable as *gtsmodel.Follow", cMsg.GTSModel)
 	}
 
 	// Update stats for the origin account.
-	if err := p.utils.decrementFollowingCount(ctx, cMsg.Origin); err != nil {
+	if err := p.utils.decrementFollowingCount(ctx, cMsg.Origin, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
 	// Update stats for the target account.
-	if err := p.utils.decrementFollowersCount(ctx, cMsg.Target); err != nil {
+	if err := p.utils.decrementFollowersCount(ctx, cMsg.Target, cMsg.Origin, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -775,6 +978,28 @@ func (p *clientAPI) UndoFollow(ctx context.Context, cMsg *messages.FromClientAPI
 	return nil
 }
 
+func (p *clientAPI) UndoFollowRequest(
+	ctx context.Context,
+	cMsg *messages.FromClientAPI,
+	followReq *gtsmodel.FollowRequest,
+) error {
+	// Update stats for the target account;
+	// origin following count is untouched
+	// as the request was never accepted.
+	if err := p.utils.decrementFollowRequestsCount(ctx, cMsg.Target, followReq.ID); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	if err := p.federate.UndoFollow(
+		ctx,
+		p.converter.FollowRequestToFollow(ctx, followReq),
+	); err != nil {
+		log.Errorf(ctx, "error federating follow request undo: %v", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) UndoBlock(ctx context.Context, cMsg *messages.FromClientAPI) error {
 	block, ok := cMsg.GTSModel.(*gtsmodel.Block)
 	if !ok {
@@ -811,19 +1036,26 @@ func (p *clientAPI) UndoAnnounce(ctx context.Context, cMsg *messages.FromClientA
 		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
 	}
 
-	if err := p.state.DB.DeleteStatusByID(ctx, status.ID); err != nil {
-		return gtserror.Newf("db error deleting status: %w", err)
+	// Wipe the boost, taking its
+	// notifications etc with it.
+	if err := p.utils.wipeStatus(ctx,
+		status,
+		cMsg.Origin.ID,
+		false,
+	); err != nil {
+		if gtserror.HasType(err, WipeErrOwnerMismatch) ||
+			gtserror.HasType(err, WipeErrVetoed) {
+			return gtserror.Newf("not wiping boost: %w", err)
+		}
+		log.Errorf(ctx, "error wiping boost: %v", err)
 	}
 
-	// Update stats for the origin account.
-	if err := p.utils.decrementStatusesCount(ctx, cMsg.Origin); err != nil {
+	// Update stats for the origin (boosting) account only;
+	// the boosted status and its author are left as-is.
+	if err := p.utils.decrementStatusesCount(ctx, cMsg.Origin, status); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
-	if err := p.surface.deleteStatusFromTimelines(ctx, status.ID); err != nil {
-		log.Errorf(ctx, "error removing timelined status: %v", err)
-	}
-
 	// Interaction counts changed on the boosted status;
 	// uncache the prepared version from all timelines.
 	p.surface.invalidateStatusFromTimelines(ctx, status.BoostOfID)
@@ -867,13 +1099,22 @@ func (p *clientAPI) DeleteStatus(ctx context.Context, cMsg *messages.FromClientA
 	// (stops processing of remote origin data targeting this status).
 	p.state.Workers.Federator.Queue.Delete("TargetURI", status.URI)
 
-	// First perform the actual status deletion.
-	if err := p.utils.wipeStatus(ctx, status, deleteAttachments); err != nil {
+	// First perform the actual status deletion,
+	// ensuring it's owned by the origin account.
+	if err := p.utils.wipeStatus(ctx,
+		status,
+		cMsg.Origin.ID,
+		deleteAttachments,
+	); err != nil {
+		if gtserror.HasType(err, WipeErrOwnerMismatch) ||
+			gtserror.HasType(err, WipeErrVetoed) {
+			return gtserror.Newf("not wiping status: %w", err)
+		}
 		log.Errorf(ctx, "error wiping status: %v", err)
 	}
 
 	// Update stats for the origin account.
-	if err := p.utils.decrementStatusesCount(ctx, cMsg.Origin); err != nil {
+	if err := p.utils.decrementStatusesCount(ctx, cMsg.Origin, status); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -931,10 +1172,20 @@ func (p *clientAPI) DeleteAccountOrUser(ctx context.Context, cMsg *messages.From
 		log.Errorf(ctx, "error federating account delete: %v", err)
 	}
 
+	// Get local followers of the account before its
+	// follows are deleted, so that their following
+	// counts can be decremented once the follows are gone.
+	followers, err := p.state.DB.GetAccountLocalFollowers(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting local followers: %v", err)
+	}
+
 	if err := p.account.Delete(ctx, cMsg.Target, originID); err != nil {
 		log.Errorf(ctx, "error deleting account: %v", err)
 	}
 
+	p.utils.decrementFollowingCounts(ctx, followers)
+
 	return nil
 }
 
@@ -960,31 +1211,81 @@ func (p *clientAPI) ReportAccount(ctx context.Context, cMsg *messages.FromClient
 }
 
 func (p *clientAPI) MoveAccount(ctx context.Context, cMsg *messages.FromClientAPI) error {
-	// Redirect each local follower of
-	// OriginAccount to follow move target.
-	p.utils.redirectFollowers(ctx, cMsg.Origin, cMsg.Target)
-
 	// At this point, we know OriginAccount has the
 	// Move set on it. Just make sure it's populated.
-	if err := p.state.DB.PopulateMove(ctx, cMsg.Origin.Move); err != nil {
+	move := cMsg.Origin.Move
+	if err := p.state.DB.PopulateMove(ctx, move); err != nil {
 		return gtserror.Newf("error populating Move: %w", err)
 	}
 
+	// The Move was validated, and target
+	// dereferenced, before being enqueued.
+	p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventValidated, "")
+	p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventTargetDereffed, cMsg.Target.URI)
+
+	return p.processMove(ctx, move, cMsg.Origin, cMsg.Target)
+}
+
+// processMove redirects followers of the moving origin
+// account to target, and sends out the Move, deferring
+// it until later if too many Moves are in progress.
+func (p *clientAPI) processMove(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	origin *gtsmodel.Account,
+	target *gtsmodel.Account,
+) error {
+	release, ok := p.utils.moves.tryAcquire()
+	if !ok {
+		// Too many Moves in progress,
+		// try again a little later.
+		p.utils.deferMove(ctx, move, func(ctx context.Context) error {
+			return p.processMove(ctx, move, origin, target)
+		})
+		return nil
+	}
+	defer release()
+
+	// Stop if the Move was cancelled
+	// while it was deferred.
+	if p.utils.moveCancelled(ctx, move, origin) {
+		return nil
+	}
+
+	// Redirect each local follower of
+	// OriginAccount to follow move target.
+	if !p.utils.redirectFollowers(ctx, move, origin, target) {
+		// Don't mark the Move as succeeded; when
+		// the account tries the Move again, it
+		// resumes from the stored progress.
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "error redirecting followers")
+		return gtserror.Newf("error redirecting followers of account %s", origin.URI)
+	}
+
+	// Don't send the Move out if it was
+	// cancelled while redirecting; any
+	// followers migrated so far stay so.
+	if p.utils.moveCancelled(ctx, move, origin) {
+		return nil
+	}
+
 	// Now send the Move message out to
 	// OriginAccount's (remote) followers.
-	if err := p.federate.MoveAccount(ctx, cMsg.Origin); err != nil {
+	if err := p.federate.MoveAccount(ctx, origin); err != nil {
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "error federating Move")
 		return gtserror.Newf("error federating account move: %w", err)
 	}
 
 	// Mark the move attempt as successful.
-	cMsg.Origin.Move.SucceededAt = cMsg.Origin.Move.AttemptedAt
+	move.SucceededAt = move.AttemptedAt
 	if err := p.state.DB.UpdateMove(
 		ctx,
-		cMsg.Origin.Move,
+		move,
 		"succeeded_at",
 	); err != nil {
 		return gtserror.Newf("error marking move as successful: %w", err)
 	}
+	p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventCompleted, "")
 
 	return nil
 }
@@ -1058,7 +1359,50 @@ func (p *clientAPI) AcceptLike(ctx context.Context, cMsg *messages.FromClientAPI
 }
 
 func (p *clientAPI) AcceptReply(ctx context.Context, cMsg *messages.FromClientAPI) error {
-	// TODO
+	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Status", cMsg.GTSModel)
+	}
+
+	// Ensure status populated.
+	if err := p.state.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status: %w", err)
+	}
+
+	// Put approval in the database and
+	// update the status with approvedBy URI.
+	// This also cascades approval to any
+	// nested pending replies by same account.
+	approval, err := p.utils.approveReplyWith(ctx, status, false)
+	if err != nil {
+		return gtserror.Newf("error approving reply: %w", err)
+	}
+
+	// Send out the approval as Accept.
+	if err := p.federate.AcceptInteraction(ctx, approval); err != nil {
+		log.Errorf(ctx, "error federating approval of reply: %v", err)
+	}
+
+	// Update stats for the replying account.
+	if err := p.utils.incrementStatusesCount(ctx, status.Account, status); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	// Timeline and notify the status.
+	if err := p.surface.timelineAndNotifyStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Interaction counts changed on the replied-to status;
+	// uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, status.InReplyToID)
+
+	// Send out the reply fully,
+	// if it's one of ours.
+	if err := p.federate.CreateStatus(ctx, status); err != nil {
+		log.Errorf(ctx, "error federating status: %v", err)
+	}
+
 	return nil
 }
 
@@ -1066,3 +1410,127 @@ func (p *clientAPI) AcceptAnnounce(ctx context.Context, cMsg *messages.FromClien
 	// TODO
 	return nil
 }
+
+func (p *clientAPI) RejectLike(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	approval, ok := cMsg.GTSModel.(*gtsmodel.InteractionApproval)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.InteractionApproval", cMsg.GTSModel)
+	}
+
+	if err := p.federate.RejectInteraction(ctx, approval); err != nil {
+		log.Errorf(ctx, "error federating like reject: %v", err)
+	}
+
+	if err := p.utils.recordRejection(ctx, approval); err != nil {
+		log.Errorf(ctx, "db error recording like rejection: %v", err)
+	}
+
+	// Update rejecting account's pending count.
+	if err := p.surface.pendingInteractionsChanged(ctx, approval.AccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectReply(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	approval, ok := cMsg.GTSModel.(*gtsmodel.InteractionApproval)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.InteractionApproval", cMsg.GTSModel)
+	}
+
+	// Get the now-hidden reply from the db,
+	// unless it was deleted when rejected.
+	reply, err := p.state.DB.GetStatusByURI(
+		ctx,
+		approval.InteractionURI,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting reply: %w", err)
+	}
+
+	switch {
+	case reply == nil:
+		// Already gone.
+
+	case approval.ID == "":
+		// Reject of a pending reply
+		// that was never approved, so
+		// wipe it and everything on it.
+		if err := p.utils.wipeStatus(ctx, reply, "", true); err != nil {
+			log.Errorf(ctx, "error wiping rejected reply: %v", err)
+		}
+
+	default:
+		// Remove the reply from all timelines.
+		if err := p.surface.deleteStatusFromTimelines(ctx, reply.ID); err != nil {
+			log.Errorf(ctx, "error removing timelined reply: %v", err)
+		}
+	}
+
+	if err := p.federate.RejectInteraction(ctx, approval); err != nil {
+		log.Errorf(ctx, "error federating reply reject: %v", err)
+	}
+
+	if err := p.utils.recordRejection(ctx, approval); err != nil {
+		log.Errorf(ctx, "db error recording reply rejection: %v", err)
+	}
+
+	// Update rejecting account's pending count.
+	if err := p.surface.pendingInteractionsChanged(ctx, approval.AccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectAnnounce(ctx context.Context, cMsg *messages.FromClientAPI) error {
+	approval, ok := cMsg.GTSModel.(*gtsmodel.InteractionApproval)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.InteractionApproval", cMsg.GTSModel)
+	}
+
+	// Get the now-hidden boost from the db,
+	// unless it was deleted when rejected.
+	boost, err := p.state.DB.GetStatusByURI(
+		ctx,
+		approval.InteractionURI,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting boost: %w", err)
+	}
+
+	switch {
+	case boost == nil:
+		// Already gone.
+
+	case approval.ID == "":
+		// Reject of a pending boost
+		// that was never approved, so
+		// wipe it and everything on it.
+		if err := p.utils.wipeStatus(ctx, boost, "", true); err != nil {
+			log.Errorf(ctx, "error wiping rejected boost: %v", err)
+		}
+
+	default:
+		// Remove the boost from all timelines.
+		if err := p.surface.deleteStatusFromTimelines(ctx, boost.ID); err != nil {
+			log.Errorf(ctx, "error removing timelined boost: %v", err)
+		}
+	}
+
+	if err := p.federate.RejectInteraction(ctx, approval); err != nil {
+		log.Errorf(ctx, "error federating announce reject: %v", err)
+	}
+
+	if err := p.utils.recordRejection(ctx, approval); err != nil {
+		log.Errorf(ctx, "db error recording announce rejection: %v", err)
+	}
+
+	// Update rejecting account's pending count.
+	if err := p.surface.pendingInteractionsChanged(ctx, approval.AccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
+	return nil
+}
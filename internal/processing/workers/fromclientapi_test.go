@@ -21,17 +21,24 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
@@ -1660,6 +1667,1918 @@ func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	}
 }
 
+func (suite *FromClientAPITestSuite) TestProcessStatusDeletePendingInteractions() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		interacter      = suite.testAccounts["remote_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	// Put a pending reply by interacter.
+	reply := new(gtsmodel.Status)
+	*reply = *suite.testStatuses["remote_account_1_status_1"]
+	reply.ID = "01JA1M3B4MMG9AZK1J3XJ6N8R6"
+	reply.URI = interacter.URI + "/statuses/" + reply.ID
+	reply.InReplyToID = deletedStatus.ID
+	reply.InReplyToURI = deletedStatus.URI
+	reply.InReplyToAccountID = deletingAccount.ID
+	reply.AttachmentIDs = nil
+	reply.PendingApproval = util.Ptr(true)
+	if err := testStructs.State.DB.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Notify deleting account of the pending reply.
+	notif := &gtsmodel.Notification{
+		ID:               "01JA1M3B4MMG9AZK1J3XJ6N8R8",
+		NotificationType: gtsmodel.NotificationPendingReply,
+		TargetAccountID:  deletingAccount.ID,
+		OriginAccountID:  interacter.ID,
+		StatusID:         reply.ID,
+	}
+	if err := testStructs.State.DB.PutNotification(ctx, notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a pending fave by interacter.
+	fave := &gtsmodel.StatusFave{
+		ID:              "01JA1M3B4MMG9AZK1J3XJ6N8R7",
+		AccountID:       interacter.ID,
+		TargetAccountID: deletingAccount.ID,
+		StatusID:        deletedStatus.ID,
+		URI:             interacter.URI + "/likes/01JA1M3B4MMG9AZK1J3XJ6N8R7",
+		PendingApproval: util.Ptr(true),
+	}
+	if err := testStructs.State.DB.PutStatusFave(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Pending interactions should be gone.
+	_, err := testStructs.State.DB.GetStatusByID(ctx, reply.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = testStructs.State.DB.GetStatusFaveByID(ctx, fave.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Reply was wiped, so its notification should be gone.
+	_, err = testStructs.State.DB.GetNotificationByID(ctx, notif.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Rejects should have been queued for
+	// both pending interactions by interacter.
+	rejected := make(map[string]bool)
+	for {
+		delivery, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+		if !ok {
+			break
+		}
+
+		b, err := io.ReadAll(delivery.Request.Body)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		if !strings.Contains(string(b), `"type":"Reject"`) {
+			// Not interested.
+			continue
+		}
+
+		for _, uri := range []string{reply.URI, fave.URI} {
+			if strings.Contains(string(b), `"object":"`+uri+`"`) {
+				rejected[uri] = true
+			}
+		}
+	}
+	suite.True(rejected[reply.URI])
+	suite.True(rejected[fave.URI])
+}
+
+func (suite *FromClientAPITestSuite) TestProcessRejectPendingReply() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx        = context.Background()
+		rejecting  = suite.testAccounts["local_account_1"]
+		interacter = suite.testAccounts["remote_account_1"]
+		target     = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Put a pending reply by interacter.
+	reply := new(gtsmodel.Status)
+	*reply = *suite.testStatuses["remote_account_1_status_1"]
+	reply.ID = "01JA1M3B4MMG9AZK1J3XJ6N8R9"
+	reply.URI = interacter.URI + "/statuses/" + reply.ID
+	reply.InReplyToID = target.ID
+	reply.InReplyToURI = target.URI
+	reply.InReplyToAccountID = rejecting.ID
+	reply.AttachmentIDs = nil
+	reply.PendingApproval = util.Ptr(true)
+	if err := testStructs.State.DB.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process a reject of the pending
+	// reply, with an unstored approval.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityReject,
+			GTSModel: &gtsmodel.InteractionApproval{
+				AccountID:            rejecting.ID,
+				Account:              rejecting,
+				InteractingAccountID: interacter.ID,
+				InteractionURI:       reply.URI,
+				InteractionType:      gtsmodel.InteractionReply,
+				Reason:               gtsmodel.InteractionReasonSpam,
+			},
+			Origin: rejecting,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Rejected reply should be wiped.
+	_, err := testStructs.State.DB.GetStatusByID(ctx, reply.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Rejection should be recorded with the
+	// reason, outliving the wiped reply.
+	_, rejected, err := testStructs.State.DB.CountInteractionReasons(ctx, rejecting.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(map[gtsmodel.InteractionReason]int{
+		gtsmodel.InteractionReasonSpam: 1,
+	}, rejected)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStatusDeleteOrphanedReplyPlaceholder() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetStatusesOrphanedReplyPlaceholder(true)
+	defer config.SetStatusesOrphanedReplyPlaceholder(false)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	replies, err := testStructs.State.DB.GetStatusReplies(ctx, deletedStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(replies, 2)
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Status itself should be gone.
+	_, err = testStructs.State.DB.GetStatusByID(ctx, deletedStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	instanceAcct, err := testStructs.State.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Each reply should now reference
+	// the same placeholder status.
+	var placeholderID string
+	for _, reply := range replies {
+		reply, err := testStructs.State.DB.GetStatusByID(ctx, reply.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.NotEqual(deletedStatus.ID, reply.InReplyToID)
+		suite.Equal(instanceAcct.ID, reply.InReplyToAccountID)
+		if placeholderID == "" {
+			placeholderID = reply.InReplyToID
+		}
+		suite.Equal(placeholderID, reply.InReplyToID)
+	}
+
+	placeholder, err := testStructs.State.DB.GetStatusByID(ctx, placeholderID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(instanceAcct.ID, placeholder.AccountID)
+	suite.Equal(deletedStatus.ThreadID, placeholder.ThreadID)
+	suite.Equal(deletedStatus.Visibility, placeholder.Visibility)
+	suite.Empty(placeholder.Content)
+	suite.False(*placeholder.Federated)
+	suite.True(*placeholder.Placeholder)
+
+	// Placeholder shouldn't show up on the instance account's profile.
+	statuses, err := testStructs.State.DB.GetAccountStatuses(ctx, instanceAcct.ID, 0, false, false, "", "", false, false)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	for _, status := range statuses {
+		suite.NotEqual(placeholderID, status.ID)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStatusDeleteOrphanedReplyNoPlaceholder() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	replies, err := testStructs.State.DB.GetStatusReplies(ctx, deletedStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(replies, 2)
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Replies should be left as-is,
+	// still pointing at deleted status.
+	for _, reply := range replies {
+		reply, err := testStructs.State.DB.GetStatusByID(ctx, reply.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(deletedStatus.ID, reply.InReplyToID)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStatusDeleteArchivePoll() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetStatusesPollArchiveOnDelete(true)
+	defer config.SetStatusesPollArchiveOnDelete(false)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	// Take a copy of poll tallies before deletion.
+	poll, err := testStructs.State.DB.GetPollByID(ctx, deletedStatus.PollID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	votes := append([]int(nil), poll.Votes...)
+	voters := *poll.Voters
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Poll and its votes should be gone.
+	_, err = testStructs.State.DB.GetPollByID(ctx, poll.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	pollVotes, err := testStructs.State.DB.GetPollVotes(ctx, poll.ID)
+	suite.NoError(err)
+	suite.Empty(pollVotes)
+
+	// Archived tallies should match pre-deletion counts.
+	archive, err := testStructs.State.DB.GetPollArchiveByPollID(ctx, poll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(deletedStatus.ID, archive.StatusID)
+	suite.Equal(deletingAccount.ID, archive.AccountID)
+	suite.Equal(poll.Options, archive.Options)
+	suite.Equal(votes, archive.Votes)
+	suite.Equal(voters, *archive.Voters)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStatusDeleteNoArchivePoll() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// No tallies should have been archived.
+	_, err := testStructs.State.DB.GetPollArchiveByPollID(ctx, deletedStatus.PollID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateBlockRemoveFaves() {
+	suite.processCreateBlockFaves(true)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateBlockKeepFaves() {
+	suite.processCreateBlockFaves(false)
+}
+
+func (suite *FromClientAPITestSuite) processCreateBlockFaves(removeFaves bool) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetInstanceBlockRemoveFaves(removeFaves)
+	defer config.SetInstanceBlockRemoveFaves(false)
+
+	var (
+		ctx           = context.Background()
+		blocker       = suite.testAccounts["local_account_1"]
+		blockee       = suite.testAccounts["admin_account"]
+		blockeeFave   = suite.testFaves["admin_account_local_account_1_status_1"]
+		blockerFave   = suite.testFaves["local_account_1_admin_account_status_1"]
+		blockedStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	favesBefore, err := testStructs.State.DB.CountStatusFaves(ctx, blockedStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	block := &gtsmodel.Block{
+		ID:              "01J9YCN8KRBA2SWFP81WJCKJGS",
+		URI:             "http://localhost:8080/users/the_mighty_zork/blocks/01J9YCN8KRBA2SWFP81WJCKJGS",
+		AccountID:       blocker.ID,
+		Account:         blocker,
+		TargetAccountID: blockee.ID,
+		TargetAccount:   blockee,
+	}
+
+	if err := testStructs.State.DB.PutBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the block.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActivityBlock,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       block,
+			Origin:         blocker,
+			Target:         blockee,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	favesAfter, err := testStructs.State.DB.CountStatusFaves(ctx, blockedStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = testStructs.State.DB.GetStatusFaveByID(ctx, blockeeFave.ID)
+	if removeFaves {
+		// Blockee's fave of blocker's
+		// status should be gone.
+		suite.ErrorIs(err, db.ErrNoEntries)
+		suite.Equal(favesBefore-1, favesAfter)
+	} else {
+		// Blockee's fave should remain.
+		suite.NoError(err)
+		suite.Equal(favesBefore, favesAfter)
+	}
+
+	// Blocker's own faves of blockee's
+	// statuses should be untouched.
+	_, err = testStructs.State.DB.GetStatusFaveByID(ctx, blockerFave.ID)
+	suite.NoError(err)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusCascadeApproval() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetInstanceInteractionApprovalCascadeDepth(2)
+	defer config.SetInstanceInteractionApprovalCascadeDepth(0)
+
+	var (
+		ctx           = context.Background()
+		approver      = suite.testAccounts["local_account_1"]
+		replier       = suite.testAccounts["local_account_2"]
+		otherReplier  = suite.testAccounts["admin_account"]
+		repliedStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// newReply puts a new reply by account
+	// to given status, optionally pending.
+	newReply := func(
+		account *gtsmodel.Account,
+		replyTo *gtsmodel.Status,
+		pending bool,
+	) *gtsmodel.Status {
+		reply := suite.newStatus(
+			ctx,
+			testStructs.State,
+			account,
+			gtsmodel.VisibilityPublic,
+			replyTo,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+		if pending {
+			reply.PendingApproval = util.Ptr(true)
+			if err := testStructs.State.DB.UpdateStatus(ctx,
+				reply,
+				"pending_approval",
+			); err != nil {
+				suite.FailNow(err.Error())
+			}
+		}
+		return reply
+	}
+
+	// Build a thread beneath the replied status:
+	//
+	//   repliedStatus (approver)
+	//   └─ reply (replier, pending)
+	//      └─ approverReply1 (approver)
+	//         ├─ nestedReply (replier, pending)
+	//         │  └─ approverReply2 (approver)
+	//         │     └─ tooDeepReply (replier, pending)
+	//         └─ otherReply (otherReplier, pending)
+	var (
+		reply          = newReply(replier, repliedStatus, true)
+		approverReply1 = newReply(approver, reply, false)
+		nestedReply    = newReply(replier, approverReply1, true)
+		otherReply     = newReply(otherReplier, approverReply1, true)
+		approverReply2 = newReply(approver, nestedReply, false)
+		tooDeepReply   = newReply(replier, approverReply2, true)
+	)
+
+	// Have the approver's approvals expire.
+	settings, err := testStructs.State.DB.GetAccountSettings(ctx, approver.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.InteractionApprovalExpiryDays = 7
+	if err := testStructs.State.DB.UpdateAccountSettings(ctx,
+		settings,
+		"interaction_approval_expiry_days",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the approver's approval of the reply.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityAccept,
+			GTSModel:       reply,
+			Origin:         approver,
+			Target:         replier,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// isPending fetches the given status
+	// fresh and returns its pending state.
+	isPending := func(status *gtsmodel.Status) bool {
+		status, err := testStructs.State.DB.GetStatusByID(ctx, status.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *status.PendingApproval
+	}
+
+	// Same-author nested reply within
+	// cascade depth should be approved.
+	suite.False(isPending(reply))
+	suite.False(isPending(nestedReply))
+
+	// Another account's reply, and a reply
+	// beyond the cascade depth, should not.
+	suite.True(isPending(otherReply))
+	suite.True(isPending(tooDeepReply))
+
+	// getApprovals fetches the stored
+	// approvals of the given status.
+	getApprovals := func(status *gtsmodel.Status) []*gtsmodel.InteractionApproval {
+		approvals, err := testStructs.State.DB.GetInteractionApprovalsByInteractionURI(ctx, status.URI)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return approvals
+	}
+
+	// The manual approval is stored.
+	suite.Len(getApprovals(reply), 1)
+
+	// The cascaded approval is stored,
+	// expiring as per approver's setting.
+	approvals := getApprovals(nestedReply)
+	if !suite.Len(approvals, 1) {
+		suite.FailNow("")
+	}
+	suite.WithinDuration(time.Now().Add(7*24*time.Hour), approvals[0].ExpiresAt, time.Minute)
+
+	// The cascaded approval was automatic,
+	// so the replier isn't notified of it.
+	_, err = testStructs.State.DB.GetNotification(
+		ctx,
+		gtsmodel.NotificationInteractionApproved,
+		replier.ID,
+		approver.ID,
+		nestedReply.ID,
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusPreApprovedNoCascade() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetInstanceInteractionApprovalCascadeDepth(2)
+	defer config.SetInstanceInteractionApprovalCascadeDepth(0)
+
+	var (
+		ctx           = context.Background()
+		replier       = suite.testAccounts["local_account_2"]
+		repliedStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// newPendingReply puts a new pending
+	// reply by replier to given status.
+	newPendingReply := func(replyTo *gtsmodel.Status) *gtsmodel.Status {
+		reply := suite.newStatus(
+			ctx,
+			testStructs.State,
+			replier,
+			gtsmodel.VisibilityPublic,
+			replyTo,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+		reply.PendingApproval = util.Ptr(true)
+		if err := testStructs.State.DB.UpdateStatus(ctx,
+			reply,
+			"pending_approval",
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return reply
+	}
+
+	// Pending reply, with a pending
+	// nested reply by the same account.
+	reply := newPendingReply(repliedStatus)
+	nestedReply := newPendingReply(reply)
+
+	// Process the reply as pre-approved.
+	reply.PreApproved = true
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       reply,
+			Origin:         replier,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Approval is only cascaded once a pending
+	// reply is approved, not on creation, so
+	// the nested reply is still pending.
+	dbNested, err := testStructs.State.DB.GetStatusByID(ctx, nestedReply.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbNested.PendingApproval)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusApprovedUnboostable() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetInstanceInteractionApprovedBoostable(false)
+	defer config.SetInstanceInteractionApprovedBoostable(true)
+
+	var (
+		ctx           = context.Background()
+		replier       = suite.testAccounts["local_account_2"]
+		booster       = suite.testAccounts["admin_account"]
+		repliedStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Put a public reply pending approval.
+	reply := suite.newStatus(
+		ctx,
+		testStructs.State,
+		replier,
+		gtsmodel.VisibilityPublic,
+		repliedStatus,
+		nil,
+		nil,
+		false,
+		nil,
+	)
+	reply.PendingApproval = util.Ptr(true)
+	if err := testStructs.State.DB.UpdateStatus(ctx,
+		reply,
+		"pending_approval",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the reply as pre-approved.
+	reply.PreApproved = true
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       reply,
+			Origin:         replier,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Reply should be approved, with
+	// its policy left untouched.
+	dbReply, err := testStructs.State.DB.GetStatusByID(ctx, reply.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbReply.PendingApproval)
+	suite.Equal(reply.InteractionPolicy, dbReply.InteractionPolicy)
+
+	// Someone else trying to
+	// boost the reply is rejected.
+	_, errWithCode := testStructs.Processor.Status().BoostCreate(ctx,
+		booster,
+		suite.testApplications["admin_account"],
+		reply.ID,
+	)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// But the reply's author may still boost it.
+	_, errWithCode = testStructs.Processor.Status().BoostCreate(ctx,
+		replier,
+		suite.testApplications["application_2"],
+		reply.ID,
+	)
+	suite.Nil(errWithCode)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusApprovalStreamsPendingCount() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetInstanceInteractionApprovalCascadeDepth(1)
+	defer config.SetInstanceInteractionApprovalCascadeDepth(0)
+
+	var (
+		ctx           = context.Background()
+		approver      = suite.testAccounts["local_account_1"]
+		replier       = suite.testAccounts["local_account_2"]
+		repliedStatus = suite.testStatuses["local_account_1_status_1"]
+		streams       = suite.openStreams(ctx, testStructs.Processor, approver, nil)
+		notifStream   = streams[stream.TimelineNotifications]
+	)
+
+	// newPendingReply puts a new pending
+	// reply by replier to given status.
+	newPendingReply := func(replyTo *gtsmodel.Status) *gtsmodel.Status {
+		reply := suite.newStatus(
+			ctx,
+			testStructs.State,
+			replier,
+			gtsmodel.VisibilityPublic,
+			replyTo,
+			nil,
+			nil,
+			false,
+			nil,
+		)
+		reply.PendingApproval = util.Ptr(true)
+		if err := testStructs.State.DB.UpdateStatus(ctx,
+			reply,
+			"pending_approval",
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return reply
+	}
+
+	// Pending reply, with a pending
+	// nested reply by the same account.
+	reply := newPendingReply(repliedStatus)
+	newPendingReply(reply)
+
+	// Load the pending count into the cache.
+	before, err := testStructs.State.DB.CountPendingInteractionsForAccount(ctx, approver.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.GreaterOrEqual(before, 2)
+
+	// Process approval of the reply,
+	// cascading approval to nested reply.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityAccept,
+			GTSModel:       reply,
+			Origin:         approver,
+			Target:         replier,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Cached count should be decremented
+	// by both of the approved replies.
+	after, err := testStructs.State.DB.CountPendingInteractionsForAccount(ctx, approver.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(before-2, after)
+
+	// Drain the notifications stream,
+	// keeping each pending count event.
+	var counts []string
+	for {
+		ctx, cncl := context.WithTimeout(ctx, time.Second)
+		msg, ok := notifStream.Recv(ctx)
+		cncl()
+
+		if !ok {
+			break
+		}
+
+		if msg.Event == stream.EventTypePendingInteractionsCount {
+			counts = append(counts, msg.Payload)
+		}
+	}
+
+	// Count should have been streamed on each
+	// approval, the latest being the new count.
+	suite.Equal([]string{
+		`{"count":` + strconv.Itoa(before-1) + `}`,
+		`{"count":` + strconv.Itoa(before-2) + `}`,
+	}, counts)
+}
+
+// wipeOrderDB wraps a db.DB in order to inspect
+// db state just before a status row is deleted,
+// optionally failing the delete of the status.
+type wipeOrderDB struct {
+	db.DB
+	statusID string
+	onDelete func()
+	fail     bool
+}
+
+func (w *wipeOrderDB) DeleteStatusByID(ctx context.Context, id string) error {
+	if id == w.statusID {
+		w.onDelete()
+		if w.fail {
+			return errors.New("simulated status delete failure")
+		}
+	}
+	return w.DB.DeleteStatusByID(ctx, id)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStatusDeleteStatusLast() {
+	suite.processStatusDeleteStatusLast(false)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStatusDeleteStatusLastFails() {
+	suite.processStatusDeleteStatusLast(true)
+}
+
+func (suite *FromClientAPITestSuite) processStatusDeleteStatusLast(fail bool) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx                  = context.Background()
+		deletingAccount      = suite.testAccounts["local_account_1"]
+		deletedStatus        = suite.testStatuses["local_account_1_status_1"]
+		boostOfDeletedStatus = suite.testStatuses["admin_account_status_4"]
+		innerDB              = testStructs.State.DB
+		deleteCalled         bool
+	)
+
+	// checkPeripheralsGone checks that the
+	// faves and boosts of status are gone.
+	checkPeripheralsGone := func() {
+		faves, err := innerDB.GetStatusFaves(ctx, deletedStatus.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			suite.FailNow(err.Error())
+		}
+		suite.Empty(faves)
+
+		_, err = innerDB.GetStatusByID(ctx, boostOfDeletedStatus.ID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	testStructs.State.DB = &wipeOrderDB{
+		DB:       innerDB,
+		statusID: deletedStatus.ID,
+		fail:     fail,
+		onDelete: func() {
+			deleteCalled = true
+
+			// Status row must still exist
+			// when it's about to be deleted...
+			_, err := innerDB.GetStatusByID(ctx, deletedStatus.ID)
+			suite.NoError(err)
+
+			// ... and only after all
+			// else has been removed.
+			checkPeripheralsGone()
+		},
+	}
+	defer func() { testStructs.State.DB = innerDB }()
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(deleteCalled)
+
+	_, err := innerDB.GetStatusByID(ctx, deletedStatus.ID)
+	if fail {
+		// Delete is not transactional, so the status
+		// should remain while everything else is gone.
+		suite.NoError(err)
+		checkPeripheralsGone()
+	} else {
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessUndoFollowRequest() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx              = context.Background()
+		requestingAcct   = suite.testAccounts["admin_account"]
+		targetAcct       = suite.testAccounts["local_account_2"]
+		followRequestID  = "01J9Z3MJJQ6ZE1A5JX8JHHYKHW"
+		followRequestURI = requestingAcct.URI + "/follow/" + followRequestID
+	)
+
+	// Put a pending follow request from
+	// requesting account to (locked) target.
+	if err := testStructs.State.DB.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+		ID:              followRequestID,
+		URI:             followRequestURI,
+		AccountID:       requestingAcct.ID,
+		TargetAccountID: targetAcct.ID,
+		ShowReblogs:     util.Ptr(true),
+		Notify:          util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Regenerate target's stats so
+	// the pending request is counted.
+	target, err := testStructs.State.DB.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.RegenerateAccountStats(ctx, target); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, *target.Stats.FollowRequestsCount)
+
+	// Withdraw the follow request.
+	if _, errWithCode := testStructs.Processor.Account().FollowRemove(
+		ctx,
+		requestingAcct,
+		targetAcct.ID,
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Target's follow requests count
+	// should be decremented back to 0.
+	if !testrig.WaitFor(func() bool {
+		target, err := testStructs.State.DB.GetAccountByID(ctx, targetAcct.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := testStructs.State.DB.PopulateAccountStats(ctx, target); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *target.Stats.FollowRequestsCount == 0
+	}) {
+		suite.FailNow("timed out waiting for follow requests count decrement")
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFollowRequestLifecycleLocked() {
+	suite.processFollowRequestLifecycle(
+		suite.testAccounts["admin_account"],
+		suite.testAccounts["local_account_2"],
+	)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFollowRequestLifecycleUnlocked() {
+	suite.processFollowRequestLifecycle(
+		suite.testAccounts["local_account_2"],
+		suite.testAccounts["admin_account"],
+	)
+}
+
+// processFollowRequestLifecycle checks the follow request, followers
+// and following counts at each stage of requester following target,
+// ie., after the request, and after the request is accepted (either
+// manually by a locked target, or automatically by an unlocked one).
+func (suite *FromClientAPITestSuite) processFollowRequestLifecycle(
+	requestingAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx    = context.Background()
+		locked = *targetAcct.Locked
+		other  = suite.testAccounts["remote_account_1"]
+	)
+
+	// Put an unrelated pending follow request to
+	// target, so that a count which is wrongly
+	// decremented won't be hidden by clamping at 0.
+	if err := testStructs.State.DB.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+		ID:              "01JA2QZ3V6S1J8WBMT2X6YKN4R",
+		URI:             other.URI + "/follow/01JA2QZ3V6S1J8WBMT2X6YKN4R",
+		AccountID:       other.ID,
+		TargetAccountID: targetAcct.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	target, err := testStructs.State.DB.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.RegenerateAccountStats(ctx, target); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// getStats returns the fresh
+	// stats of the given account.
+	getStats := func(account *gtsmodel.Account) *gtsmodel.AccountStats {
+		account, err := testStructs.State.DB.GetAccountByID(ctx, account.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := testStructs.State.DB.PopulateAccountStats(ctx, account); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return account.Stats
+	}
+
+	var (
+		targetBefore     = getStats(targetAcct)
+		requestingBefore = getStats(requestingAcct)
+	)
+
+	// waitForStats waits until stats of target
+	// and requester match the given deltas. As
+	// requester is local, any new followers
+	// should be counted as local followers.
+	waitForStats := func(requests, followers, following int) {
+		if !testrig.WaitFor(func() bool {
+			target := getStats(targetAcct)
+			requesting := getStats(requestingAcct)
+			return *target.FollowRequestsCount == *targetBefore.FollowRequestsCount+requests &&
+				*target.FollowersCount == *targetBefore.FollowersCount+followers &&
+				*target.LocalFollowersCount == *targetBefore.LocalFollowersCount+followers &&
+				*target.RemoteFollowersCount == *targetBefore.RemoteFollowersCount &&
+				*requesting.FollowingCount == *requestingBefore.FollowingCount+following
+		}) {
+			suite.FailNowf("timed out waiting for stats",
+				"requests %+d, followers %+d, following %+d",
+				requests, followers, following,
+			)
+		}
+	}
+
+	// Request to follow the target.
+	if _, errWithCode := testStructs.Processor.Account().FollowCreate(
+		ctx,
+		requestingAcct,
+		&apimodel.AccountFollowRequest{ID: targetAcct.ID},
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !locked {
+		// Unlocked target accepts immediately:
+		// request should be moved straight over
+		// to followers, with no request left.
+		waitForStats(0, 1, 1)
+		return
+	}
+
+	// Locked target: only the request should
+	// be counted, not yet a new follower.
+	waitForStats(1, 0, 0)
+
+	// Accept the follow request.
+	if _, errWithCode := testStructs.Processor.Account().FollowRequestAccept(
+		ctx,
+		targetAcct,
+		requestingAcct.ID,
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Request should now be
+	// moved over to followers.
+	waitForStats(0, 1, 1)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessUpdateAccountUnlockAcceptsRequests() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx        = context.Background()
+		targetAcct = suite.testAccounts["local_account_2"]
+		requesters = []*gtsmodel.Account{
+			suite.testAccounts["local_account_1"],
+			suite.testAccounts["remote_account_1"],
+		}
+	)
+
+	// Put pending follow requests from
+	// requesting accounts to (locked) target.
+	for i, requester := range requesters {
+		followRequestID := []string{
+			"01JA0V1WTQVZ8Q0R6K6Z2S3XQ1",
+			"01JA0V1WTQVZ8Q0R6K6Z2S3XQ2",
+		}[i]
+		if err := testStructs.State.DB.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+			ID:              followRequestID,
+			URI:             requester.URI + "/follow/" + followRequestID,
+			AccountID:       requester.ID,
+			TargetAccountID: targetAcct.ID,
+			ShowReblogs:     util.Ptr(true),
+			Notify:          util.Ptr(false),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Regenerate target's stats so
+	// the pending requests are counted.
+	target, err := testStructs.State.DB.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.RegenerateAccountStats(ctx, target); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, *target.Stats.FollowRequestsCount)
+	followersBefore := *target.Stats.FollowersCount
+
+	// Note local requester's following count.
+	requester, err := testStructs.State.DB.GetAccountByID(ctx, requesters[0].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, requester); err != nil {
+		suite.FailNow(err.Error())
+	}
+	followingBefore := *requester.Stats.FollowingCount
+
+	// Unlock the target account.
+	target.Locked = util.Ptr(false)
+	if err := testStructs.State.DB.UpdateAccount(ctx, target, "locked"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the account update.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActorPerson,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       target,
+			Origin:         target,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// All requests should now be follows.
+	for _, requester := range requesters {
+		follows, err := testStructs.State.DB.IsFollowing(ctx, requester.ID, targetAcct.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.True(follows)
+
+		requested, err := testStructs.State.DB.IsFollowRequested(ctx, requester.ID, targetAcct.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.False(requested)
+	}
+
+	// Target's stats should be updated.
+	target, err = testStructs.State.DB.GetAccountByID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, target); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(0, *target.Stats.FollowRequestsCount)
+	suite.Equal(followersBefore+2, *target.Stats.FollowersCount)
+
+	// Local requester's following count should be incremented.
+	requester, err = testStructs.State.DB.GetAccountByID(ctx, requesters[0].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, requester); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(followingBefore+1, *requester.Stats.FollowingCount)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessUpdateAccountLockNoop() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	ctx := context.Background()
+
+	// Lock an unlocked account.
+	account, err := testStructs.State.DB.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, account); err != nil {
+		suite.FailNow(err.Error())
+	}
+	statsBefore := account.Stats.Snapshot()
+
+	account.Locked = util.Ptr(true)
+	if err := testStructs.State.DB.UpdateAccount(ctx, account, "locked"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the account update.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActorPerson,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       account,
+			Origin:         account,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Stats should be unchanged.
+	statsAfter, err := testStructs.State.DB.SnapshotAccountStats(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(statsAfter.Delta(&statsBefore))
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFollowRequestStatsOpID() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = context.Background()
+		requestingAcct = suite.testAccounts["admin_account"]
+		targetAcct     = suite.testAccounts["local_account_2"]
+	)
+
+	followRequest := &gtsmodel.FollowRequest{
+		ID:              "01JA5C3TKYWXQ1M7Q3S3B2JBDG",
+		URI:             requestingAcct.URI + "/follow/01JA5C3TKYWXQ1M7Q3S3B2JBDG",
+		AccountID:       requestingAcct.ID,
+		TargetAccountID: targetAcct.ID,
+	}
+	if err := testStructs.State.DB.PutFollowRequest(ctx, followRequest); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// getRequestsCount returns the fresh
+	// follow requests count of target.
+	getRequestsCount := func() int {
+		target, err := testStructs.State.DB.GetAccountByID(ctx, targetAcct.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := testStructs.State.DB.PopulateAccountStats(ctx, target); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *target.Stats.FollowRequestsCount
+	}
+
+	newMsg := func() *messages.FromClientAPI {
+		return &messages.FromClientAPI{
+			APObjectType:   ap.ActivityFollow,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       followRequest,
+			Origin:         requestingAcct,
+			Target:         targetAcct,
+		}
+	}
+
+	// process processes the given message,
+	// as the client API worker would do.
+	process := func(msg *messages.FromClientAPI) {
+		if err := testStructs.Processor.Workers().ProcessFromClientAPI(ctx, msg); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	before := getRequestsCount()
+
+	// Process the follow request, then
+	// process the same message again,
+	// as though the worker retried it.
+	msg := newMsg()
+	process(msg)
+	suite.NotEmpty(msg.OpID)
+	opID := msg.OpID
+	process(msg)
+	suite.Equal(opID, msg.OpID)
+
+	// Count should only be incremented once.
+	suite.Equal(before+1, getRequestsCount())
+
+	// A different message is a
+	// different operation, so
+	// should be counted again.
+	process(newMsg())
+	suite.Equal(before+2, getRequestsCount())
+}
+
+func (suite *FromClientAPITestSuite) TestProcessUndoAnnounceStats() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = context.Background()
+		boostingAcct   = suite.testAccounts["admin_account"]
+		originalAuthor = suite.testAccounts["local_account_1"]
+		boost          = suite.testStatuses["admin_account_status_4"]
+		original       = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// getStatusesCount returns the fresh
+	// statuses count of given account.
+	getStatusesCount := func(account *gtsmodel.Account) int {
+		account, err := testStructs.State.DB.GetAccountByID(ctx, account.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := testStructs.State.DB.PopulateAccountStats(ctx, account); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *account.Stats.StatusesCount
+	}
+
+	// getBoostsCount returns the
+	// boosts count of the original.
+	getBoostsCount := func() int {
+		count, err := testStructs.State.DB.CountStatusBoosts(ctx, original.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return count
+	}
+
+	var (
+		boostingBefore = getStatusesCount(boostingAcct)
+		originalBefore = getStatusesCount(originalAuthor)
+		boostsBefore   = getBoostsCount()
+	)
+
+	// Process the unboost.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActivityAnnounce,
+			APActivityType: ap.ActivityUndo,
+			GTSModel:       boost,
+			Origin:         boostingAcct,
+			Target:         originalAuthor,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the booster's statuses count should change.
+	suite.Equal(boostingBefore-1, getStatusesCount(boostingAcct))
+	suite.Equal(originalBefore, getStatusesCount(originalAuthor))
+
+	// Boosts count of the original should be updated.
+	suite.Equal(boostsBefore-1, getBoostsCount())
+}
+
+func (suite *FromClientAPITestSuite) TestProcessAccountDeleteUndoesBoosts() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx = context.Background()
+
+		// Account whose boost should be removed.
+		deletingAccount = new(gtsmodel.Account)
+
+		// Boost by that account of someone else's status.
+		boost = suite.testStatuses["admin_account_status_4"]
+
+		// Account with the boost in their home timeline.
+		timelineOwner = suite.testAccounts["local_account_2"]
+	)
+
+	*deletingAccount = *suite.testAccounts["admin_account"]
+
+	// Put the boost in the timeline owner's home timeline.
+	indexed, err := testStructs.State.Timelines.Home.IngestOne(ctx, timelineOwner.ID, boost)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(indexed)
+
+	// Notify the boosted author of the boost.
+	notif := &gtsmodel.Notification{
+		ID:               "01J5SDK0YFSZ2A3D5R3YPMX7AS",
+		NotificationType: gtsmodel.NotificationReblog,
+		TargetAccountID:  boost.BoostOfAccountID,
+		OriginAccountID:  deletingAccount.ID,
+		StatusID:         boost.ID,
+	}
+	if err := testStructs.State.DB.PutNotification(ctx, notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suspensionOrigin := "01GWVP2A8J38Q2J2FDZ6TS8AQG"
+	if errWithCode := testStructs.Processor.Account().Delete(ctx,
+		deletingAccount,
+		suspensionOrigin,
+	); errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Boost should be gone from the timeline.
+	suite.Zero(testStructs.State.Timelines.Home.GetIndexedLength(ctx, timelineOwner.ID))
+
+	// And from the database, along with its notification.
+	_, err = testStructs.State.DB.GetStatusByID(ctx, boost.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = testStructs.State.DB.GetNotificationByID(ctx, notif.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// The boosted status itself should be untouched.
+	_, err = testStructs.State.DB.GetStatusByID(ctx, boost.BoostOfID)
+	suite.NoError(err)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateLikeInvalidURI() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx         = context.Background()
+		favingAcct  = suite.testAccounts["local_account_2"]
+		favedAcct   = suite.testAccounts["local_account_1"]
+		favedStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Put a pre-approved fave
+	// with a malformed URI.
+	fave := &gtsmodel.StatusFave{
+		ID:              "01JA8K2XG3B0C4ZR5W5P4N3Q2E",
+		AccountID:       favingAcct.ID,
+		TargetAccountID: favedAcct.ID,
+		StatusID:        favedStatus.ID,
+		URI:             "not a uri",
+		PendingApproval: util.Ptr(true),
+		PreApproved:     true,
+	}
+	if err := testStructs.State.DB.PutStatusFave(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActivityLike,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       fave,
+			Origin:         favingAcct,
+			Target:         favedAcct,
+		},
+	)
+
+	// Approval should have been refused.
+	suite.True(gtserror.HasType(err, workers.ApproveErrInvalidURI))
+
+	// And nothing should have been stored.
+	approvals, err := testStructs.State.DB.GetInteractionApprovalsByAccountID(ctx, favedAcct.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	for _, approval := range approvals {
+		suite.NotEqual(fave.URI, approval.InteractionURI)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateLikeTooNew() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx         = context.Background()
+		favingAcct  = new(gtsmodel.Account)
+		favedAcct   = suite.testAccounts["local_account_1"]
+		favedStatus = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Copy faving account so
+	// we don't modify fixture.
+	*favingAcct = *suite.testAccounts["local_account_2"]
+
+	// Faved account auto-rejects
+	// accounts younger than 7 days.
+	settings, err := testStructs.State.DB.GetAccountSettings(ctx, favedAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.InteractionMinAccountAgeDays = 7
+	if err := testStructs.State.DB.UpdateAccountSettings(
+		ctx,
+		settings,
+		"interaction_min_account_age_days",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Faving account is a day old.
+	favingAcct.CreatedAt = time.Now().Add(-24 * time.Hour)
+	if err := testStructs.State.DB.UpdateAccount(ctx, favingAcct, "created_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a pending fave.
+	fave := &gtsmodel.StatusFave{
+		ID:              "01JAB3RZ7M2K5Q8T1V4X6Z9C2E",
+		AccountID:       favingAcct.ID,
+		TargetAccountID: favedAcct.ID,
+		StatusID:        favedStatus.ID,
+		URI:             favingAcct.URI + "/faves/01JAB3RZ7M2K5Q8T1V4X6Z9C2E",
+		PendingApproval: util.Ptr(true),
+	}
+	if err := testStructs.State.DB.PutStatusFave(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActivityLike,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       fave,
+			Origin:         favingAcct,
+			Target:         favedAcct,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Fave should be gone.
+	_, err = testStructs.State.DB.GetStatusFaveByID(ctx, fave.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// And its rejection recorded.
+	_, rejected, err := testStructs.State.DB.CountInteractionReasons(ctx, favedAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, rejected[gtsmodel.InteractionReasonTooNew])
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateBoostPreApproved() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx           = context.Background()
+		boostingAcct  = suite.testAccounts["admin_account"]
+		boostedAcct   = suite.testAccounts["local_account_2"]
+		boostedStatus = suite.testStatuses["local_account_2_status_1"]
+	)
+
+	// Put a boost of turtle's status
+	// by admin, pending + pre-approved.
+	boost := suite.newStatus(
+		ctx,
+		testStructs.State,
+		boostingAcct,
+		gtsmodel.VisibilityPublic,
+		nil,
+		boostedStatus,
+		nil,
+		false,
+		nil,
+	)
+	boost.PendingApproval = util.Ptr(true)
+	if err := testStructs.State.DB.UpdateStatus(ctx,
+		boost,
+		"pending_approval",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+	boost.PreApproved = true
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActivityAnnounce,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       boost,
+			Origin:         boostingAcct,
+			Target:         boostedAcct,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The boost should be approved, with an
+	// Accept URI of the boosted account.
+	dbBoost, err := testStructs.State.DB.GetStatusByID(ctx, boost.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbBoost.PendingApproval)
+	suite.Regexp(
+		"^http://localhost:8080/users/"+boostedAcct.ID+"/accepts/[0-9A-Z]{26}$",
+		dbBoost.ApprovedByURI,
+	)
+
+	// But as it was pre-approved, no
+	// approval of it should be stored.
+	approvals, err := testStructs.State.DB.GetInteractionApprovalsByInteractionURI(ctx, boost.URI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(approvals)
+
+	// Its Accept should still resolve to
+	// an approval typed as an Announce.
+	approval, err := testStructs.State.DB.GetInteractionApprovalByURI(ctx, dbBoost.ApprovedByURI)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.InteractionAnnounce, approval.InteractionType)
+	suite.Equal(boostedAcct.ID, approval.AccountID)
+	suite.Equal(boostingAcct.ID, approval.InteractingAccountID)
+	suite.Equal(boost.URI, approval.InteractionURI)
+
+	// The boost was approved automatically,
+	// so the boosting account isn't notified.
+	_, err = testStructs.State.DB.GetNotification(
+		ctx,
+		gtsmodel.NotificationInteractionApproved,
+		boostingAcct.ID,
+		boostedAcct.ID,
+		boostedStatus.ID,
+	)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusOutOfOrderLastStatusAt() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = context.Background()
+		postingAccount = suite.testAccounts["admin_account"]
+		now            = time.Now().Truncate(time.Second)
+		newer          = suite.newStatus(ctx, testStructs.State, postingAccount, gtsmodel.VisibilityPublic, nil, nil, nil, false, nil)
+		older          = suite.newStatus(ctx, testStructs.State, postingAccount, gtsmodel.VisibilityPublic, nil, nil, nil, false, nil)
+	)
+
+	newer.CreatedAt = now
+	older.CreatedAt = now.Add(-time.Hour)
+
+	// getLastStatusAt returns the fresh
+	// last status at of posting account.
+	getLastStatusAt := func() time.Time {
+		account, err := testStructs.State.DB.GetAccountByID(ctx, postingAccount.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := testStructs.State.DB.PopulateAccountStats(ctx, account); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return account.Stats.LastStatusAt
+	}
+
+	// Process the newer status
+	// first, then the older one.
+	for _, status := range []*gtsmodel.Status{newer, older} {
+		if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityCreate,
+				GTSModel:       status,
+				Origin:         postingAccount,
+			},
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Last status at should still
+	// be that of the newer status.
+	suite.True(newer.CreatedAt.Equal(getLastStatusAt()))
+}
+
+func (suite *FromClientAPITestSuite) TestProcessUpdateStatusRepairsAttachmentIDs() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx           = context.Background()
+		account       = suite.testAccounts["local_account_1"]
+		testStatus    = suite.testStatuses["local_account_1_status_4"]
+		attachmentIDs = testStatus.AttachmentIDs
+		staleID       = id.NewULID()
+	)
+
+	// Corrupt the status attachment IDs as
+	// concurrent edits might, with duplicates
+	// and an ID with no matching attachment.
+	status, err := testStructs.State.DB.GetStatusByID(ctx, testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	status.AttachmentIDs = []string{
+		attachmentIDs[0],
+		staleID,
+		attachmentIDs[0],
+		attachmentIDs[1],
+		attachmentIDs[1],
+	}
+	if err := testStructs.State.DB.UpdateStatus(ctx,
+		status,
+		"attachments",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process an edit of the status.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			Origin:         account,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Stored attachment IDs should be
+	// repaired, in their original order.
+	status, err = testStructs.State.DB.GetStatusByID(ctx, testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(attachmentIDs, status.AttachmentIDs)
+
+	// With no valid attachment lost.
+	suite.Len(status.Attachments, len(attachmentIDs))
+	for i, attachment := range status.Attachments {
+		suite.Equal(attachmentIDs[i], attachment.ID)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessMoveRetriesUnconfirmedFollows() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetAccountsMoveFollowRetryAttempts(2)
+	config.SetAccountsMoveFollowRetryDelay(200 * time.Millisecond)
+	defer func() {
+		config.SetAccountsMoveFollowRetryAttempts(3)
+		config.SetAccountsMoveFollowRetryDelay(time.Hour)
+	}()
+
+	var (
+		ctx        = context.Background()
+		originAcct = new(gtsmodel.Account)
+		targetAcct = suite.testAccounts["remote_account_2"]
+
+		// Local followers of origin account.
+		confirmingAcct   = suite.testAccounts["admin_account"]
+		unconfirmingAcct = suite.testAccounts["local_account_2"]
+	)
+
+	// Copy origin account
+	// as we'll change it.
+	*originAcct = *suite.testAccounts["local_account_1"]
+
+	// Store a Move from origin to target.
+	moveID := id.NewULID()
+	move := &gtsmodel.Move{
+		ID:          moveID,
+		AttemptedAt: time.Now(),
+		OriginURI:   originAcct.URI,
+		Origin:      testrig.URLMustParse(originAcct.URI),
+		TargetURI:   targetAcct.URI,
+		Target:      testrig.URLMustParse(targetAcct.URI),
+		URI:         originAcct.URI + "/moves/" + moveID,
+	}
+	if err := testStructs.State.DB.PutMove(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	originAcct.MoveID = move.ID
+	originAcct.Move = move
+	originAcct.MovedToURI = targetAcct.URI
+	if err := testStructs.State.DB.UpdateAccount(ctx,
+		originAcct,
+		"move_id",
+		"moved_to_uri",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the Move.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ActorPerson,
+			APActivityType: ap.ActivityMove,
+			GTSModel:       move,
+			Origin:         originAcct,
+			Target:         targetAcct,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Target's instance confirms one follow,
+	// before any retry has had a chance to run.
+	if _, err := testStructs.State.DB.AcceptFollowRequest(ctx,
+		confirmingAcct.ID,
+		targetAcct.ID,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Count Follows of target queued for
+	// delivery, by actor, until past retries.
+	follows := make(map[string]int)
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		delivery, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+		if !ok {
+			time.Sleep(50 * time.Millisecond)
+			continue
+		}
+
+		b, err := io.ReadAll(delivery.Request.Body)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		var activity map[string]any
+		if err := json.Unmarshal(b, &activity); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		if activity["type"] == "Follow" &&
+			activity["object"] == targetAcct.URI {
+			actor, _ := activity["actor"].(string)
+			follows[actor]++
+		}
+	}
+
+	// The confirmed follow should only have been sent
+	// once, and the unconfirmed one should have been
+	// sent again for each of the retry attempts.
+	suite.Equal(map[string]int{
+		confirmingAcct.URI:   1,
+		unconfirmingAcct.URI: 3,
+	}, follows)
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
@@ -24,11 +24,14 @@ import (
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
@@ -48,6 +51,14 @@ type fediAPI struct {
 }
 
 func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	// Give the message an operation ID on
+	// first processing, so that stats are
+	// only updated once should it be retried.
+	if fMsg.OpID == "" {
+		fMsg.OpID = id.NewULID()
+	}
+	ctx = gtscontext.SetStatsOpID(ctx, fMsg.OpID)
+
 	// Allocate new log fields slice
 	fields := make([]kv.Field, 3, 5)
 	fields[0] = kv.Field{"activityType", fMsg.APActivityType}
@@ -154,6 +165,15 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg *messages.FromF
 			return p.fediAPI.DeleteAccount(ctx, fMsg)
 		}
 
+	// UNDO SOMETHING
+	case ap.ActivityUndo:
+		switch fMsg.APObjectType {
+
+		// UNDO (pending) FOLLOW
+		case ap.ActivityFollow:
+			return p.fediAPI.UndoFollowRequest(ctx, fMsg)
+		}
+
 	// MOVE SOMETHING
 	case ap.ActivityMove:
 
@@ -237,6 +257,16 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 	)
 
 	switch {
+	case pendingApproval && !status.PreApproved &&
+		p.utils.tooNewToInteract(ctx, status.Account, status.InReplyToAccount):
+		// Replying account is younger than the minimum
+		// account age set by the replied-to account,
+		// so automatically reject the reply instead.
+		// Reply came in via the federating API,
+		// so handle attachments as for a Delete.
+		deleteAttachments := !config.GetMediaRemoteDeleteRetain()
+		return p.utils.rejectTooNewReply(ctx, status, deleteAttachments)
+
 	case pendingApproval && !status.PreApproved:
 		// If approval is required and status isn't
 		// preapproved, then just notify the account
@@ -355,7 +385,7 @@ func (p *fediAPI) CreateFollowReq(ctx context.Context, fMsg *messages.FromFediAP
 		}
 
 		// And update stats for the local account.
-		if err := p.utils.incrementFollowRequestsCount(ctx, fMsg.Receiving); err != nil {
+		if err := p.utils.incrementFollowRequestsCount(ctx, fMsg.Receiving, followRequest.ID); err != nil {
 			log.Errorf(ctx, "error updating account stats: %v", err)
 		}
 
@@ -375,12 +405,12 @@ func (p *fediAPI) CreateFollowReq(ctx context.Context, fMsg *messages.FromFediAP
 	}
 
 	// Update stats for the local account.
-	if err := p.utils.incrementFollowersCount(ctx, fMsg.Receiving); err != nil {
+	if err := p.utils.incrementFollowersCount(ctx, fMsg.Receiving, fMsg.Requesting, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
 	// Update stats for the remote account.
-	if err := p.utils.incrementFollowingCount(ctx, fMsg.Requesting); err != nil {
+	if err := p.utils.incrementFollowingCount(ctx, fMsg.Requesting, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -415,6 +445,13 @@ func (p *fediAPI) CreateLike(ctx context.Context, fMsg *messages.FromFediAPI) er
 	)
 
 	switch {
+	case pendingApproval && !fave.PreApproved &&
+		p.utils.tooNewToInteract(ctx, fave.Account, fave.TargetAccount):
+		// Faving account is younger than the minimum
+		// account age set by the faved account, so
+		// automatically reject the fave instead.
+		return p.utils.rejectTooNewFave(ctx, fave)
+
 	case pendingApproval && !fave.PreApproved:
 		// If approval is required and fave isn't
 		// preapproved, then just notify the account
@@ -502,6 +539,13 @@ func (p *fediAPI) CreateAnnounce(ctx context.Context, fMsg *messages.FromFediAPI
 	)
 
 	switch {
+	case pendingApproval && !boost.PreApproved &&
+		p.utils.tooNewToInteract(ctx, boost.Account, boost.BoostOfAccount):
+		// Boosting account is younger than the minimum
+		// account age set by the boosted account, so
+		// automatically reject the boost instead.
+		return p.utils.rejectTooNewAnnounce(ctx, boost)
+
 	case pendingApproval && !boost.PreApproved:
 		// If approval is required and boost isn't
 		// preapproved, then just notify the account
@@ -684,18 +728,39 @@ func (p *fediAPI) UpdateAccount(ctx context.Context, fMsg *messages.FromFediAPI)
 	return nil
 }
 
+func (p *fediAPI) UndoFollowRequest(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	followReq, ok := fMsg.GTSModel.(*gtsmodel.FollowRequest)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.FollowRequest", fMsg.GTSModel)
+	}
+
+	// Update stats for the target account;
+	// origin following count is untouched
+	// as the request was never accepted.
+	if err := p.utils.decrementFollowRequestsCount(ctx, fMsg.Receiving, followReq.ID); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	return nil
+}
+
 func (p *fediAPI) AcceptFollow(ctx context.Context, fMsg *messages.FromFediAPI) error {
+	follow, ok := fMsg.GTSModel.(*gtsmodel.Follow)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.Follow", fMsg.GTSModel)
+	}
+
 	// Update stats for the remote account.
-	if err := p.utils.decrementFollowRequestsCount(ctx, fMsg.Requesting); err != nil {
+	if err := p.utils.decrementFollowRequestsCount(ctx, fMsg.Requesting, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
-	if err := p.utils.incrementFollowersCount(ctx, fMsg.Requesting); err != nil {
+	if err := p.utils.incrementFollowersCount(ctx, fMsg.Requesting, fMsg.Receiving, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
 	// Update stats for the local account.
-	if err := p.utils.incrementFollowingCount(ctx, fMsg.Receiving); err != nil {
+	if err := p.utils.incrementFollowingCount(ctx, fMsg.Receiving, follow.ID); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -733,6 +798,10 @@ func (p *fediAPI) AcceptReply(ctx context.Context, fMsg *messages.FromFediAPI) e
 		log.Errorf(ctx, "error federating announce: %v", err)
 	}
 
+	// Reply was approved; approve any nested
+	// pending replies by same account.
+	p.utils.cascadeApproveReplies(ctx, status)
+
 	return nil
 }
 
@@ -774,6 +843,10 @@ func (p *fediAPI) UpdateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 	// Cast the updated ActivityPub statusable object .
 	apStatus, _ := fMsg.APObject.(ap.Statusable)
 
+	// Note visibility before the update,
+	// to check if it's changed afterwards.
+	oldVis := existing.Visibility
+
 	// Fetch up-to-date attach status attachments, etc.
 	status, _, err := p.federate.RefreshStatus(
 		ctx,
@@ -787,9 +860,26 @@ func (p *fediAPI) UpdateStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 		log.Errorf(ctx, "error refreshing status: %v", err)
 	}
 
+	// Concurrent edits may have left the
+	// attachment IDs in a bad state, fix.
+	if err := p.utils.repairAttachmentIDs(ctx, status); err != nil {
+		log.Errorf(ctx, "error repairing attachment ids: %v", err)
+	}
+
 	// Status representation was refetched, uncache from timelines.
 	p.surface.invalidateStatusFromTimelines(ctx, status.ID)
 
+	// If visibility was changed, add to or remove
+	// from timelines of accounts that can now (or
+	// can no longer) see the status, as appropriate.
+	if err := p.surface.adjustStatusTimelinesForVisibility(ctx,
+		status,
+		oldVis,
+		status.Visibility,
+	); err != nil {
+		log.Errorf(ctx, "error adjusting timelines for visibility: %v", err)
+	}
+
 	if status.Poll != nil && status.Poll.Closing {
 
 		// If the latest status has a newly closed poll, at least compared
@@ -811,7 +901,11 @@ func (p *fediAPI) DeleteStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 	// Delete attachments from this status, since this request
 	// comes from the federating API, and there's no way the
 	// poster can do a delete + redraft for it on our instance.
-	const deleteAttachments = true
+	//
+	// The exception is if the instance admin has configured
+	// remote media to be retained after a Delete, in which
+	// case we just unattach and leave them for media cleanup.
+	deleteAttachments := !config.GetMediaRemoteDeleteRetain()
 
 	status, ok := fMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -839,13 +933,22 @@ func (p *fediAPI) DeleteStatus(ctx context.Context, fMsg *messages.FromFediAPI)
 	// (stops processing of remote origin data targeting this status).
 	p.state.Workers.Federator.Queue.Delete("TargetURI", status.URI)
 
-	// First perform the actual status deletion.
-	if err := p.utils.wipeStatus(ctx, status, deleteAttachments); err != nil {
+	// First perform the actual status deletion,
+	// ensuring it's owned by the requesting account.
+	if err := p.utils.wipeStatus(ctx,
+		status,
+		fMsg.Requesting.ID,
+		deleteAttachments,
+	); err != nil {
+		if gtserror.HasType(err, WipeErrOwnerMismatch) ||
+			gtserror.HasType(err, WipeErrVetoed) {
+			return gtserror.Newf("not wiping status: %w", err)
+		}
 		log.Errorf(ctx, "error wiping status: %v", err)
 	}
 
 	// Update stats for the remote account.
-	if err := p.utils.decrementStatusesCount(ctx, fMsg.Requesting); err != nil {
+	if err := p.utils.decrementStatusesCount(ctx, fMsg.Requesting, status); err != nil {
 		log.Errorf(ctx, "error updating account stats: %v", err)
 	}
 
@@ -879,10 +982,20 @@ func (p *fediAPI) DeleteAccount(ctx context.Context, fMsg *messages.FromFediAPI)
 	p.state.Workers.Federator.Queue.Delete("Requesting.ID", account.ID)
 	p.state.Workers.Federator.Queue.Delete("TargetURI", account.URI)
 
+	// Get local followers of the account before its
+	// follows are deleted, so that their following
+	// counts can be decremented once the follows are gone.
+	followers, err := p.state.DB.GetAccountLocalFollowers(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting local followers: %v", err)
+	}
+
 	// First perform the actual account deletion.
 	if err := p.account.Delete(ctx, account, account.ID); err != nil {
 		log.Errorf(ctx, "error deleting account: %v", err)
 	}
 
+	p.utils.decrementFollowingCounts(ctx, followers)
+
 	return nil
 }
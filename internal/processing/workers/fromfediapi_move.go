@@ -301,6 +301,7 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 			targetAcctURIStr, err,
 		)
 	}
+	p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventValidated, "")
 
 	// Account to which the Move is taking place.
 	targetAcct, targetAcctable, err := p.federate.GetAccountByURI(
@@ -309,6 +310,7 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 		targetAcctURI,
 	)
 	if err != nil {
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "target account could not be dereferenced")
 		return gtserror.Newf(
 			"error getting target account %s: %w",
 			targetAcctURIStr, err,
@@ -330,6 +332,7 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 	//      to hell with it.
 	if targetAcct.IsSuspended() {
 		l.Info("target account is suspended, will not process Move")
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "target account is suspended")
 		return nil
 	}
 
@@ -343,12 +346,14 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 			dereferencing.Freshest,
 		)
 		if err != nil {
+			p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "target account could not be refreshed")
 			return gtserror.Newf(
 				"error refreshing target account %s: %w",
 				targetAcctURIStr, err,
 			)
 		}
 	}
+	p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventTargetDereffed, targetAcct.URI)
 
 	// Target must not itself have moved somewhere.
 	// You can't move to an already-moved account.
@@ -358,6 +363,7 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 			"target account has, itself, already moved to %s, will not process Move",
 			targetAcctMovedTo,
 		)
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "target account has already moved to "+targetAcctMovedTo)
 		return nil
 	}
 
@@ -366,6 +372,7 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 	// origin account, so we know it's for real.
 	if !targetAcct.IsAliasedTo(originAcctURIStr) {
 		l.Info("target account is not aliased back to origin account, will not process Move")
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "target account is not aliased back to origin account")
 		return nil
 	}
 
@@ -374,10 +381,42 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 		looks valid and we should process it.
 	*/
 
+	return p.processMove(ctx, move, originAcct, targetAcct)
+}
+
+// processMove redirects local followers of the moving
+// originAcct to targetAcct, deferring the Move until
+// later if too many Moves are already in progress.
+//
+// Callers to this function MUST have obtained a lock
+// already by calling FedLocks.Lock; when deferred, the
+// lock is obtained again before processing.
+func (p *fediAPI) processMove(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	originAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+) error {
+	release, ok := p.utils.moves.tryAcquire()
+	if !ok {
+		// Too many Moves in progress,
+		// try again a little later.
+		p.utils.deferMove(ctx, move, func(ctx context.Context) error {
+			unlock := p.state.FedLocks.Lock(
+				"move:" + move.OriginURI + ":" + move.TargetURI,
+			)
+			defer unlock()
+			return p.processMove(ctx, move, originAcct, targetAcct)
+		})
+		return nil
+	}
+	defer release()
+
 	// Transfer originAcct's followers
 	// on this instance to targetAcct.
 	redirectOK := p.utils.redirectFollowers(
 		ctx,
+		move,
 		originAcct,
 		targetAcct,
 	)
@@ -395,7 +434,14 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 	move.AttemptedAt = time.Now()
 	updateColumns := []string{"attempted_at"}
 
-	if redirectOK && removeFollowingOK {
+	switch {
+	case !redirectOK:
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "error redirecting followers")
+
+	case !removeFollowingOK:
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventFailed, "error removing follows owned by origin account")
+
+	default:
 		// All OK means we can mark the
 		// Move as definitively succeeded.
 		//
@@ -403,6 +449,7 @@ func (p *fediAPI) MoveAccount(ctx context.Context, fMsg *messages.FromFediAPI) e
 		// isn't 0.0001s later or something.
 		move.SucceededAt = move.AttemptedAt
 		updateColumns = append(updateColumns, "succeeded_at")
+		p.utils.recordMoveEvent(ctx, move, gtsmodel.MoveEventCompleted, "")
 	}
 
 	// Update whatever columns we need to update.
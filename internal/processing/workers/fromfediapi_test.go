@@ -28,12 +28,19 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdk "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 type FromFediAPITestSuite struct {
@@ -420,6 +427,160 @@ func (suite *FromFediAPITestSuite) TestProcessAccountDelete() {
 	suite.Equal(dbAccount.ID, dbAccount.SuspensionOrigin)
 }
 
+func (suite *FromFediAPITestSuite) TestProcessAccountDeleteDecrementsFollowingCounts() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx            = context.Background()
+		deletedAccount = new(gtsmodel.Account)
+		followers      = []*gtsmodel.Account{
+			suite.testAccounts["local_account_1"],
+			suite.testAccounts["local_account_2"],
+		}
+	)
+
+	*deletedAccount = *suite.testAccounts["remote_account_1"]
+
+	followingCount := func(account *gtsmodel.Account) int {
+		dbAccount, err := testStructs.State.DB.GetAccountByID(ctx, account.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := testStructs.State.DB.PopulateAccountStats(ctx, dbAccount); err != nil {
+			suite.FailNow(err.Error())
+		}
+		return *dbAccount.Stats.FollowingCount
+	}
+
+	// Have both local accounts follow the
+	// remote account, noting their counts.
+	before := make(map[string]int, len(followers))
+	for _, follower := range followers {
+		followID := id.NewULID()
+		if err := testStructs.State.DB.PutFollow(ctx, &gtsmodel.Follow{
+			ID:              followID,
+			AccountID:       follower.ID,
+			TargetAccountID: deletedAccount.ID,
+			URI:             follower.URI + "/follows/" + followID,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+		before[follower.ID] = followingCount(follower)
+	}
+
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityDelete,
+		GTSModel:       deletedAccount,
+		Receiving:      followers[0],
+		Requesting:     deletedAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Each local follower should
+	// now be following one fewer.
+	for _, follower := range followers {
+		suite.Equal(before[follower.ID]-1, followingCount(follower))
+	}
+}
+
+func (suite *FromFediAPITestSuite) TestProcessStatusDeleteAttachments() {
+	for _, retain := range []bool{false, true} {
+		suite.processStatusDeleteAttachments(retain)
+	}
+}
+
+func (suite *FromFediAPITestSuite) processStatusDeleteAttachments(retain bool) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetMediaRemoteDeleteRetain(retain)
+
+	var (
+		ctx              = context.Background()
+		deletingAccount  = suite.testAccounts["remote_account_1"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus    = suite.testStatuses["remote_account_1_status_1"]
+		attachmentID     = deletedStatus.AttachmentIDs[0]
+	)
+
+	// Process the remote status delete.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityDelete,
+		GTSModel:       deletedStatus,
+		Receiving:      receivingAccount,
+		Requesting:     deletingAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	attachment, err := testStructs.State.DB.GetAttachmentByID(ctx, attachmentID)
+	if !retain {
+		// Attachment should be deleted.
+		suite.ErrorIs(err, db.ErrNoEntries)
+		return
+	}
+
+	// Attachment should still
+	// exist, but be unattached.
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(attachment.StatusID)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessStatusDeletePreservesReported() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetInstanceReportsPreserveStatuses(true)
+	defer config.SetInstanceReportsPreserveStatuses(false)
+
+	var (
+		ctx              = context.Background()
+		deletingAccount  = suite.testAccounts["remote_account_1"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus    = suite.testStatuses["remote_account_1_status_1"]
+		reportID         = "01GP3AWY4CRDVRNZKW0TEAMB5R"
+	)
+
+	// Process the remote status delete.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityDelete,
+		GTSModel:       deletedStatus,
+		Receiving:      receivingAccount,
+		Requesting:     deletingAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Status itself should be gone.
+	_, err := testStructs.State.DB.GetStatusByID(ctx, deletedStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// But the report referencing it
+	// should now hold a snapshot.
+	report, err := testStructs.State.DB.GetReportByID(ctx, reportID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(report.StatusSnapshots, 1) {
+		suite.FailNow("")
+	}
+
+	snapshot := report.StatusSnapshots[0]
+	suite.Equal(deletedStatus.ID, snapshot.StatusID)
+	suite.Equal(deletedStatus.URI, snapshot.URI)
+	suite.Equal(deletedStatus.Content, snapshot.Content)
+	suite.Len(snapshot.Attachments, 1)
+	suite.False(snapshot.DeletedAt.IsZero())
+}
+
 func (suite *FromFediAPITestSuite) TestProcessFollowRequestLocked() {
 	testStructs := suite.SetupTestStructs()
 	defer suite.TearDownTestStructs(testStructs)
@@ -477,6 +638,56 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestLocked() {
 	suite.Empty(testStructs.HTTPClient.SentMessages)
 }
 
+func (suite *FromFediAPITestSuite) TestProcessUndoFollowRequest() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx           = context.Background()
+		originAccount = suite.testAccounts["remote_account_1"]
+		targetAccount = suite.testAccounts["local_account_2"]
+	)
+
+	// Give the locked target one pending follow request.
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, targetAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+	targetAccount.Stats.FollowRequestsCount = util.Ptr(1)
+	if err := testStructs.State.DB.UpdateAccountStats(ctx,
+		targetAccount.Stats,
+		"follow_requests_count",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	followReq := &gtsmodel.FollowRequest{
+		ID:              "01J5QD5MXDFNSKQ9AS3F4ZTB1V",
+		AccountID:       originAccount.ID,
+		Account:         originAccount,
+		TargetAccountID: targetAccount.ID,
+		TargetAccount:   targetAccount,
+		URI:             fmt.Sprintf("%s/follows/01J5QD5MXDFNSKQ9AS3F4ZTB1V", originAccount.URI),
+	}
+
+	// Requester withdraws the follow request.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActivityFollow,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       followReq,
+		Receiving:      targetAccount,
+		Requesting:     originAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Target's follow requests count should be back to 0.
+	targetAccount.Stats = nil
+	if err := testStructs.State.DB.PopulateAccountStats(ctx, targetAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(0, *targetAccount.Stats.FollowRequestsCount)
+}
+
 func (suite *FromFediAPITestSuite) TestProcessFollowRequestUnlocked() {
 	testStructs := suite.SetupTestStructs()
 	defer suite.TearDownTestStructs(testStructs)
@@ -508,6 +719,13 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestUnlocked() {
 	err := testStructs.State.DB.Put(ctx, satanFollowRequestTurtle)
 	suite.NoError(err)
 
+	// Snapshot stats of the target
+	// before the follow is accepted.
+	statsBefore, err := testStructs.State.DB.SnapshotAccountStats(ctx, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
 	err = testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
 		APObjectType:   ap.ActivityFollow,
 		APActivityType: ap.ActivityCreate,
@@ -575,6 +793,18 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestUnlocked() {
 	suite.NoError(err)
 	suite.Equal("follow", notif.Type)
 	suite.Equal(originAccount.ID, notif.Account.ID)
+
+	// The new follower is remote, so only the
+	// remote followers count should go up with
+	// the followers count, not the local one.
+	statsAfter, err := testStructs.State.DB.SnapshotAccountStats(ctx, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	delta := statsAfter.Delta(&statsBefore)
+	suite.Equal(1, delta.FollowersCount)
+	suite.Equal(0, delta.LocalFollowersCount)
+	suite.Equal(1, delta.RemoteFollowersCount)
 }
 
 // TestCreateStatusFromIRI checks if a forwarded status can be dereferenced by the processor.
@@ -607,6 +837,12 @@ func (suite *FromFediAPITestSuite) TestMoveAccount() {
 	testStructs := suite.SetupTestStructs()
 	defer suite.TearDownTestStructs(testStructs)
 
+	// Use a test meter provider so
+	// we can read recorded metrics.
+	reader := sdk.NewManualReader()
+	otel.SetMeterProvider(sdk.NewMeterProvider(sdk.WithReader(reader)))
+	defer otel.SetMeterProvider(noop.NewMeterProvider())
+
 	// We're gonna migrate foss_satan to our local admin account.
 	ctx := context.Background()
 	receivingAcct := suite.testAccounts["local_account_1"]
@@ -678,8 +914,465 @@ func (suite *FromFediAPITestSuite) TestMoveAccount() {
 
 	// Move should be marked as completed.
 	suite.WithinDuration(time.Now(), move.SucceededAt, 1*time.Minute)
+
+	// Move event log should show each phase
+	// of the Move, in order, with zork being
+	// the one follower that was migrated.
+	events, err := testStructs.State.DB.GetMoveEvents(ctx, move.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	phases := make([]gtsmodel.MoveEventPhase, 0, len(events))
+	for _, event := range events {
+		phases = append(phases, event.Phase)
+	}
+	suite.Equal([]gtsmodel.MoveEventPhase{
+		gtsmodel.MoveEventValidated,
+		gtsmodel.MoveEventTargetDereffed,
+		gtsmodel.MoveEventRedirectStarted,
+		gtsmodel.MoveEventFollowerMigrated,
+		gtsmodel.MoveEventCompleted,
+	}, phases)
+	suite.Equal(targetAcct.URI, events[1].Details)
+	suite.Equal(receivingAcct.URI, events[3].Details)
+
+	// Move duration should have been
+	// recorded for the one follower.
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	samples := make(map[string]uint64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			hist, ok := m.Data.(metricdata.Histogram[float64])
+			if !ok {
+				continue
+			}
+			for _, dp := range hist.DataPoints {
+				bucket, _ := dp.Attributes.Value("followers")
+				suite.Equal("1-10", bucket.AsString())
+				samples[m.Name] += dp.Count
+			}
+		}
+	}
+	suite.Equal(uint64(1), samples["gotosocial.moves.duration"])
+	suite.Equal(uint64(1), samples["gotosocial.moves.follower_duration"])
+}
+
+func (suite *FromFediAPITestSuite) TestProcessPendingFaveFromNewAccount() {
+	suite.processPendingFaveMinAccountAge(24*time.Hour, true)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessPendingFaveFromOldAccount() {
+	suite.processPendingFaveMinAccountAge(30*24*time.Hour, false)
+}
+
+func (suite *FromFediAPITestSuite) processPendingFaveMinAccountAge(
+	favingAccountAge time.Duration,
+	expectRejected bool,
+) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx           = context.Background()
+		favedStatus   = suite.testStatuses["local_account_1_status_1"]
+		favedAccount  = new(gtsmodel.Account)
+		favingAccount = new(gtsmodel.Account)
+	)
+
+	// Copy accounts so we
+	// don't modify fixtures.
+	*favedAccount = *suite.testAccounts["local_account_1"]
+	*favingAccount = *suite.testAccounts["remote_account_1"]
+
+	// Faved account auto-rejects
+	// accounts younger than 7 days.
+	settings, err := testStructs.State.DB.GetAccountSettings(ctx, favedAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	settings.InteractionMinAccountAgeDays = 7
+	if err := testStructs.State.DB.UpdateAccountSettings(
+		ctx,
+		settings,
+		"interaction_min_account_age_days",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+	favedAccount.Settings = settings
+
+	// Set age of faving account.
+	favingAccount.CreatedAt = time.Now().Add(-favingAccountAge)
+	if err := testStructs.State.DB.UpdateAccount(ctx, favingAccount, "created_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	fave := &gtsmodel.StatusFave{
+		ID:              "01J9ZYF7N1Y6JQ7F6Q8JH5XKPM",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: favedAccount.ID,
+		TargetAccount:   favedAccount,
+		StatusID:        favedStatus.ID,
+		Status:          favedStatus,
+		URI:             favingAccount.URI + "/faves/pending",
+		PendingApproval: util.Ptr(true),
+	}
+
+	if err := testStructs.State.DB.PutStatusFave(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		Receiving:      favedAccount,
+		Requesting:     favingAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, rejected, err := testStructs.State.DB.CountInteractionReasons(ctx, favedAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = testStructs.State.DB.GetStatusFaveByID(ctx, fave.ID)
+	delivery, sent := testStructs.State.Workers.Delivery.Queue.Pop()
+
+	if !expectRejected {
+		// Fave should still be
+		// there pending approval,
+		// and nothing sent out
+		// or recorded.
+		suite.NoError(err)
+		suite.False(sent)
+		suite.Zero(rejected[gtsmodel.InteractionReasonTooNew])
+		return
+	}
+
+	// Fave should be gone, with
+	// its rejection recorded.
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Equal(1, rejected[gtsmodel.InteractionReasonTooNew])
+
+	// Reject should be queued
+	// for the faving account.
+	if !sent {
+		suite.FailNow("expected Reject to be sent")
+	}
+	b, err := io.ReadAll(delivery.Request.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(string(b), `"type":"Reject"`)
+	suite.Contains(string(b), `"object":"`+fave.URI+`"`)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessPreApprovedFaveMetrics() {
+	suite.processFaveApprovalMetrics(true)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessPendingFaveMetrics() {
+	suite.processFaveApprovalMetrics(false)
+}
+
+func (suite *FromFediAPITestSuite) processFaveApprovalMetrics(preApproved bool) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx           = context.Background()
+		favedStatus   = suite.testStatuses["local_account_1_status_1"]
+		favedAccount  = suite.testAccounts["local_account_1"]
+		favingAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	fave := &gtsmodel.StatusFave{
+		ID:              "01JA1C5ZB4W0Y8QNDQ7V3H7T2E",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: favedAccount.ID,
+		TargetAccount:   favedAccount,
+		StatusID:        favedStatus.ID,
+		Status:          favedStatus,
+		URI:             favingAccount.URI + "/faves/metrics",
+		PendingApproval: util.Ptr(true),
+		PreApproved:     preApproved,
+	}
+
+	if err := testStructs.State.DB.PutStatusFave(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Note counts before processing.
+	autoBefore := metrics.AutoApprovedInteractions()
+	manualBefore := metrics.ManualApprovedInteractions()
+
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		Receiving:      favedAccount,
+		Requesting:     favingAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if preApproved {
+		// Pre-approved fave should be
+		// counted as auto-approved.
+		suite.Equal(autoBefore+1, metrics.AutoApprovedInteractions())
+	} else {
+		// Fave still pending, so
+		// shouldn't be counted yet.
+		suite.Equal(autoBefore, metrics.AutoApprovedInteractions())
+	}
+
+	// Nothing was approved manually.
+	suite.Equal(manualBefore, metrics.ManualApprovedInteractions())
 }
 
 func TestFromFederatorTestSuite(t *testing.T) {
 	suite.Run(t, &FromFediAPITestSuite{})
 }
+
+func (suite *FromFediAPITestSuite) TestProcessAcceptFollowersOnlyReply() {
+	suite.processAcceptReply(gtsmodel.VisibilityFollowersOnly)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessAcceptPublicReply() {
+	suite.processAcceptReply(gtsmodel.VisibilityPublic)
+}
+
+func (suite *FromFediAPITestSuite) processAcceptReply(
+	visibility gtsmodel.Visibility,
+) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		author          = suite.testAccounts["local_account_1"]
+		follower        = suite.testAccounts["admin_account"]
+		repliedAccount  = suite.testAccounts["remote_account_1"]
+		repliedStatus   = suite.testStatuses["remote_account_1_status_1"]
+		authorStream    = suite.openStreams(ctx, testStructs.Processor, author, nil)[stream.TimelineHome]
+		followerStream  = suite.openStreams(ctx, testStructs.Processor, follower, nil)[stream.TimelineHome]
+		replyID         = "01J4F8ZQZ3YJ2N5WSAV0KS3G8E"
+		replyURI        = author.URI + "/statuses/" + replyID
+		followRepliedID = "01J4F8ZQZ3YJ2N5WSAV0KS3G8F"
+	)
+
+	// Have the follower also follow the replied-to
+	// account, so the reply is hometimelineable for them.
+	if err := testStructs.State.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              followRepliedID,
+		URI:             follower.URI + "/follow/" + followRepliedID,
+		AccountID:       follower.ID,
+		TargetAccountID: repliedAccount.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Mention the replied-to account in the reply.
+	mention := &gtsmodel.Mention{
+		ID:               "01J4F8ZQZ3YJ2N5WSAV0KS3G8H",
+		StatusID:         replyID,
+		OriginAccountID:  author.ID,
+		OriginAccountURI: author.URI,
+		TargetAccountID:  repliedAccount.ID,
+	}
+	if err := testStructs.State.DB.PutMention(ctx, mention); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put the now-approved reply by author.
+	reply := &gtsmodel.Status{
+		ID:                  replyID,
+		URI:                 replyURI,
+		URL:                 replyURI,
+		Content:             "approve me pls",
+		Local:               util.Ptr(true),
+		AccountURI:          author.URI,
+		AccountID:           author.ID,
+		Account:             author,
+		InReplyToID:         repliedStatus.ID,
+		InReplyToURI:        repliedStatus.URI,
+		InReplyToAccountID:  repliedAccount.ID,
+		MentionIDs:          []string{mention.ID},
+		Visibility:          visibility,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           util.Ptr(true),
+		PendingApproval:     util.Ptr(false),
+		ApprovedByURI:       repliedAccount.URI + "/accepts/01J4F8ZQZ3YJ2N5WSAV0KS3G8G",
+	}
+	if err := testStructs.State.DB.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the Accept of the reply.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       reply,
+		Receiving:      author,
+		Requesting:     repliedAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The author's own home timeline should
+	// have had the reply streamed to it.
+	recvCtx, cncl := context.WithTimeout(ctx, time.Second*5)
+	defer cncl()
+
+	msg, ok := authorStream.Recv(recvCtx)
+	suite.True(ok)
+	suite.Equal(stream.EventTypeUpdate, msg.Event)
+	suite.Contains(msg.Payload, replyURI)
+
+	// The reply should also have been fanned out
+	// to the follower's home timeline, whatever
+	// its visibility.
+	recvCtx, cncl = context.WithTimeout(ctx, time.Second*5)
+	defer cncl()
+
+	msg, ok = followerStream.Recv(recvCtx)
+	suite.True(ok)
+	suite.Equal(stream.EventTypeUpdate, msg.Event)
+	suite.Contains(msg.Payload, replyURI)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessUpdateStatusVisibilityDowngrade() {
+	suite.processUpdateStatusVisibility(
+		gtsmodel.VisibilityPublic,
+		gtsmodel.VisibilityFollowersOnly,
+	)
+}
+
+func (suite *FromFediAPITestSuite) TestProcessUpdateStatusVisibilityUpgrade() {
+	suite.processUpdateStatusVisibility(
+		gtsmodel.VisibilityFollowersOnly,
+		gtsmodel.VisibilityPublic,
+	)
+}
+
+// processUpdateStatusVisibility processes an update of a remote,
+// tagged status changing its visibility from oldVis to newVis, and
+// checks that it stays in the home timeline of a local follower of
+// its author, while it's only in the home timeline of a (non-following)
+// local follower of its tag while public.
+func (suite *FromFediAPITestSuite) processUpdateStatusVisibility(
+	oldVis gtsmodel.Visibility,
+	newVis gtsmodel.Visibility,
+) {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx         = context.Background()
+		author      = suite.testAccounts["remote_account_1"]
+		follower    = suite.testAccounts["local_account_1"]
+		tagFollower = suite.testAccounts["admin_account"]
+		tag         = suite.testTags["welcome"]
+	)
+
+	// Follower follows author.
+	if err := testStructs.State.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01JA7Y0Z8K3M5Q1T9V2X4B6D8F",
+		URI:             follower.URI + "/follow/01JA7Y0Z8K3M5Q1T9V2X4B6D8F",
+		AccountID:       follower.ID,
+		TargetAccountID: author.ID,
+		ShowReblogs:     util.Ptr(true),
+		Notify:          util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Tag follower follows tag.
+	if err := testStructs.State.DB.PutFollowedTag(ctx, tagFollower.ID, tag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put a tagged status by author
+	// with the old visibility.
+	status := new(gtsmodel.Status)
+	*status = *suite.testStatuses["remote_account_1_status_1"]
+	status.ID = "01JA7Y2H4N6R8T0W2Y4A6C8E0G"
+	status.URI = author.URI + "/statuses/" + status.ID
+	status.URL = author.URL + "/statuses/" + status.ID
+	status.Content = "<p>hello <a href=\"http://fossbros-anonymous.io/tags/welcome\">#welcome</a></p>"
+	status.AttachmentIDs = nil
+	status.TagIDs = []string{tag.ID}
+	status.Visibility = oldVis
+	if err := testStructs.State.DB.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	existing, err := testStructs.State.DB.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put status in home timelines
+	// of those who can currently see it.
+	timelined := []*gtsmodel.Account{follower}
+	if oldVis == gtsmodel.VisibilityPublic {
+		timelined = append(timelined, tagFollower)
+	}
+	for _, account := range timelined {
+		if _, err := testStructs.State.Timelines.Home.IngestOne(ctx, account.ID, existing); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	var (
+		followerBefore    = testStructs.State.Timelines.Home.GetIndexedLength(ctx, follower.ID)
+		tagFollowerBefore = testStructs.State.Timelines.Home.GetIndexedLength(ctx, tagFollower.ID)
+	)
+
+	// Convert the updated status to its
+	// AS representation, with new visibility.
+	updated := new(gtsmodel.Status)
+	*updated = *existing
+	updated.Visibility = newVis
+	statusable, err := testStructs.TypeConverter.StatusToAS(ctx, updated)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the status update.
+	if err := testStructs.Processor.Workers().ProcessFromFediAPI(ctx, &messages.FromFediAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       existing,
+		APObject:       statusable,
+		Receiving:      follower,
+		Requesting:     author,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Follower can see the status either
+	// way, so it should have been kept.
+	suite.Equal(followerBefore, testStructs.State.Timelines.Home.GetIndexedLength(ctx, follower.ID))
+
+	// Tag follower should only have the
+	// status in their timeline if public.
+	tagFollowerAfter := testStructs.State.Timelines.Home.GetIndexedLength(ctx, tagFollower.ID)
+	if newVis == gtsmodel.VisibilityPublic {
+		suite.Equal(tagFollowerBefore+1, tagFollowerAfter)
+	} else {
+		suite.Equal(tagFollowerBefore-1, tagFollowerAfter)
+	}
+}
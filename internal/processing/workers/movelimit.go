@@ -0,0 +1,103 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// moveDeferDelay is how long a Move is
+// deferred for when too many Moves are
+// already in progress, before trying again.
+const moveDeferDelay = 30 * time.Second
+
+// moveLimiter limits the number of account
+// Moves that are processed at once across
+// the instance, as each Move redirects all
+// follows of the moving account and can
+// generate a lot of federation traffic.
+//
+// A nil moveLimiter does not limit anything.
+type moveLimiter chan struct{}
+
+// newMoveLimiter returns a moveLimiter allowing up
+// to n Moves at once, or nil if n is <= 0.
+func newMoveLimiter(n int) moveLimiter {
+	if n <= 0 {
+		return nil
+	}
+	return make(moveLimiter, n)
+}
+
+// tryAcquire returns a function to call once the Move
+// is processed, or false if too many Moves are already
+// in progress. It never waits, so as not to tie up a
+// worker; callers should use deferMove if false.
+func (l moveLimiter) tryAcquire() (func(), bool) {
+	if l == nil {
+		// No limit.
+		return func() {}, true
+	}
+
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, true
+	default:
+		return nil, false
+	}
+}
+
+// MoveDeferID returns the scheduler task ID
+// used for deferring the Move with given ID.
+func MoveDeferID(moveID string) string {
+	return "move_defer:" + moveID
+}
+
+// deferMove schedules process to be called again for the
+// given Move after moveDeferDelay, for when moves.tryAcquire
+// fails, instead of blocking the worker until a slot frees.
+func (u *utils) deferMove(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	process func(context.Context) error,
+) {
+	taskID := MoveDeferID(move.ID)
+	at := time.Now().Add(moveDeferDelay)
+
+	if !u.state.Workers.Scheduler.AddOnce(
+		taskID,
+		at,
+		func(ctx context.Context, _ time.Time) {
+			// Fired tasks stay registered, drop
+			// this one so the ID can be reused.
+			_ = u.state.Workers.Scheduler.Cancel(taskID)
+			if err := process(ctx); err != nil {
+				log.Errorf(ctx, "error processing deferred Move %s: %v", move.URI, err)
+			}
+		},
+	) {
+		log.Warnf(ctx, "failed to schedule %s", taskID)
+		return
+	}
+
+	log.Infof(ctx, "too many Moves in progress, deferred Move %s", move.URI)
+}
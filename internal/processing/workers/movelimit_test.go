@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"testing"
+)
+
+func TestMoveLimiterDefersExcessMoves(t *testing.T) {
+	const limit = 2
+
+	limiter := newMoveLimiter(limit)
+
+	// Take all the slots.
+	releases := make([]func(), 0, limit)
+	for i := 0; i < limit; i++ {
+		release, ok := limiter.tryAcquire()
+		if !ok {
+			t.Fatalf("expected move %d to be processed", i)
+		}
+		releases = append(releases, release)
+	}
+
+	// Next Move should be turned away, not wait.
+	if _, ok := limiter.tryAcquire(); ok {
+		t.Fatal("expected excess move to be deferred")
+	}
+
+	// Once one finishes, the next may go.
+	releases[0]()
+	release, ok := limiter.tryAcquire()
+	if !ok {
+		t.Fatal("expected move to be processed after release")
+	}
+	release()
+	releases[1]()
+}
+
+func TestMoveLimiterNoLimit(t *testing.T) {
+	limiter := newMoveLimiter(0)
+
+	// With no limit set,
+	// nothing should be deferred.
+	for i := 0; i < 10; i++ {
+		if _, ok := limiter.tryAcquire(); !ok {
+			t.Fatal("expected move to be processed")
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// StatusExpiryID returns the scheduler task
+// ID used for wiping the (local) status with
+// given ID once its expiry time is reached.
+func StatusExpiryID(statusID string) string {
+	return statusTaskID(statusID, "expiry")
+}
+
+// ScheduleStatusExpiries schedules expiry tasks for
+// all stored local statuses with an expiry time.
+func (p *Processor) ScheduleStatusExpiries(ctx context.Context) error {
+	// Fetch all statuses with an expiry set from the database.
+	statuses, err := p.state.DB.GetExpiringStatuses(ctx)
+	if err != nil {
+		return gtserror.Newf("error getting expiring statuses from db: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, status := range statuses {
+		// Schedule each of the statuses and catch any errors.
+		if err := p.clientAPI.utils.scheduleStatusExpiry(ctx, status); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// scheduleStatusExpiry schedules the given status to be
+// deleted at its ExpiresAt time, replacing any expiry
+// task already scheduled for it.
+func (u *utils) scheduleStatusExpiry(ctx context.Context, status *gtsmodel.Status) error {
+	// Ensure has a valid expiry.
+	if !status.Expires() {
+		return gtserror.Newf("status %s has no expiry", status.ID)
+	}
+
+	// Drop any previously scheduled
+	// expiry, it may have changed.
+	taskID := StatusExpiryID(status.ID)
+	_ = u.state.Workers.Scheduler.Cancel(taskID)
+
+	// Add the given status to the scheduler.
+	ok := u.state.Workers.Scheduler.AddOnce(
+		taskID,
+		status.ExpiresAt,
+		u.onStatusExpiry(status.ID),
+	)
+
+	if !ok {
+		// Failed to add the status to the scheduler,
+		// the scheduler was likely starting / stopping.
+		return gtserror.Newf("failed adding status %s to scheduler", status.ID)
+	}
+
+	atStr := status.ExpiresAt.Local().Format("Jan _2 2006 15:04:05")
+	log.Infof(ctx, "scheduled status expiry for %s at '%s'", status.ID, atStr)
+	return nil
+}
+
+// updateStatusExpiry (re)schedules the expiry of the given,
+// just edited status, or cancels it if the edit cleared it.
+func (u *utils) updateStatusExpiry(ctx context.Context, status *gtsmodel.Status) error {
+	if !status.Expires() {
+		_ = u.state.Workers.Scheduler.Cancel(StatusExpiryID(status.ID))
+		return nil
+	}
+
+	return u.scheduleStatusExpiry(ctx, status)
+}
+
+// onStatusExpiry returns a callback function to be used
+// by the scheduler when the given status expires.
+func (u *utils) onStatusExpiry(statusID string) func(context.Context, time.Time) {
+	return func(ctx context.Context, now time.Time) {
+		// Fired tasks stay registered, drop this
+		// one so the expiry can be rescheduled.
+		_ = u.state.Workers.Scheduler.Cancel(StatusExpiryID(statusID))
+
+		// Get the latest version of status from database.
+		status, err := u.state.DB.GetStatusByID(ctx, statusID)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error getting status %s from db: %v", statusID, err)
+			}
+			return
+		}
+
+		if !status.Expires() || status.ExpiresAt.After(now) {
+			// Expiry was cleared or pushed
+			// back since this was scheduled.
+			return
+		}
+
+		// Enqueue a delete of the status to the client API
+		// worker, this will wipe the status and everything
+		// relating to it and federate out the deletion.
+		u.state.Workers.Client.Queue.Push(&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       status,
+			Origin:         status.Account,
+			Target:         status.Account,
+		})
+	}
+}
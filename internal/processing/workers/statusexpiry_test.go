@@ -0,0 +1,148 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusExpiryTestSuite struct {
+	WorkersTestSuite
+}
+
+func (suite *StatusExpiryTestSuite) TestStatusExpiryWipesStatus() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		status  = suite.testStatuses["local_account_1_status_1"]
+	)
+
+	// Set the status to expire shortly.
+	status.ExpiresAt = time.Now().Add(500 * time.Millisecond)
+	if err := testStructs.State.DB.UpdateStatus(ctx, status, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the status create,
+	// which schedules the expiry.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         account,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Wait for the status to be wiped.
+	if !testrig.WaitFor(func() bool {
+		_, err := testStructs.State.DB.GetStatusByID(ctx, status.ID)
+		return errors.Is(err, db.ErrNoEntries)
+	}) {
+		suite.FailNow("timed out waiting for status to be wiped")
+	}
+
+	// The fired expiry task should
+	// no longer be registered.
+	suite.False(testStructs.State.Workers.Scheduler.Cancel(
+		workers.StatusExpiryID(status.ID),
+	))
+}
+
+func (suite *StatusExpiryTestSuite) TestStatusExpiryClearedOnEdit() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+		status  = new(gtsmodel.Status)
+	)
+
+	*status = *suite.testStatuses["local_account_1_status_1"]
+
+	// Set the status to expire in a bit.
+	status.ExpiresAt = time.Now().Add(time.Second)
+	if err := testStructs.State.DB.UpdateStatus(ctx, status, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the status create,
+	// which schedules the expiry.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			Origin:         account,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Edit the status to clear its expiry.
+	status.ExpiresAt = time.Time{}
+	if err := testStructs.State.DB.UpdateStatus(ctx, status, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			Origin:         account,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The expiry task should have been cancelled.
+	suite.False(testStructs.State.Workers.Scheduler.Cancel(
+		workers.StatusExpiryID(status.ID),
+	))
+
+	// Wait past the original expiry time;
+	// the status should still be there.
+	time.Sleep(1500 * time.Millisecond)
+	_, err := testStructs.State.DB.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+}
+
+func TestStatusExpiryTestSuite(t *testing.T) {
+	suite.Run(t, &StatusExpiryTestSuite{})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -56,6 +57,11 @@ func (s *Surface) notifyPendingReply(
 		return nil
 	}
 
+	// Update replied-to account's pending count.
+	if err := s.pendingInteractionsChanged(ctx, status.InReplyToAccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
 	// Ensure thread not muted
 	// by replied-to account.
 	muted, err := s.State.DB.IsThreadMutedByAccount(
@@ -88,6 +94,53 @@ func (s *Surface) notifyPendingReply(
 	return nil
 }
 
+// notifyInteractionApproved notifies the interacting
+// account of the given approval that their interaction
+// with the given status ID, which was pending approval,
+// has now been approved. Remote interacting accounts
+// aren't notified, as they receive the federated Accept.
+//
+// Callers should only call this for interactions that
+// were approved manually; interactions approved
+// automatically never appeared pending to the
+// interacting account, so there's nothing to tell.
+func (s *Surface) notifyInteractionApproved(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+	statusID string,
+) error {
+	// Beforehand, ensure the passed approval is fully populated.
+	if err := s.State.DB.PopulateInteractionApproval(ctx, approval); err != nil {
+		return gtserror.Newf("error populating approval %s: %w", approval.ID, err)
+	}
+
+	if approval.InteractingAccount.IsRemote() {
+		// Don't notify
+		// remote accounts.
+		return nil
+	}
+
+	if approval.AccountID == approval.InteractingAccountID {
+		// Don't notify
+		// self-approvals.
+		return nil
+	}
+
+	// notify interacting account
+	// of approval by account.
+	if err := s.notify(ctx,
+		gtsmodel.NotificationInteractionApproved,
+		approval.InteractingAccount,
+		approval.Account,
+		statusID,
+		approval.URI,
+	); err != nil {
+		return gtserror.Newf("error notifying interacting account %s: %w", approval.InteractingAccountID, err)
+	}
+
+	return nil
+}
+
 // notifyMentions iterates through mentions on the
 // given status, and notifies each mentioned account
 // that they have a new mention.
@@ -268,6 +321,11 @@ func (s *Surface) notifyPendingFave(
 	ctx context.Context,
 	fave *gtsmodel.StatusFave,
 ) error {
+	// Update faved account's pending count.
+	if err := s.pendingInteractionsChanged(ctx, fave.TargetAccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
 	notifyable, err := s.notifyableFave(ctx, fave)
 	if err != nil {
 		return err
@@ -372,6 +430,11 @@ func (s *Surface) notifyPendingAnnounce(
 	ctx context.Context,
 	boost *gtsmodel.Status,
 ) error {
+	// Update boosted account's pending count.
+	if err := s.pendingInteractionsChanged(ctx, boost.BoostOfAccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
 	notifyable, err := s.notifyableAnnounce(ctx, boost)
 	if err != nil {
 		return err
@@ -568,6 +631,26 @@ func (s *Surface) Notify(
 	targetAccount *gtsmodel.Account,
 	originAccount *gtsmodel.Account,
 	statusID string,
+) error {
+	return s.notify(ctx,
+		notificationType,
+		targetAccount,
+		originAccount,
+		statusID,
+		"",
+	)
+}
+
+// notify is like Notify, but also sets the
+// given approvalURI on the notification,
+// which may be an empty string.
+func (s *Surface) notify(
+	ctx context.Context,
+	notificationType gtsmodel.NotificationType,
+	targetAccount *gtsmodel.Account,
+	originAccount *gtsmodel.Account,
+	statusID string,
+	approvalURI string,
 ) error {
 	if targetAccount.IsRemote() {
 		// nothing to do.
@@ -616,6 +699,7 @@ func (s *Surface) Notify(
 		OriginAccountID:  originAccount.ID,
 		OriginAccount:    originAccount,
 		StatusID:         statusID,
+		ApprovalURI:      approvalURI,
 	}
 
 	if err := s.State.DB.PutNotification(ctx, notif); err != nil {
@@ -649,3 +733,40 @@ func (s *Surface) Notify(
 
 	return nil
 }
+
+// pendingInteractionsChanged invalidates the cached count
+// of interactions pending approval by the given account,
+// and streams the recalculated count to the account's open
+// sessions, if the account is local. This should be called
+// whenever an interaction pending approval by the account
+// is created, approved, or rejected.
+func (s *Surface) pendingInteractionsChanged(
+	ctx context.Context,
+	accountID string,
+) error {
+	// Drop any cached count for account.
+	s.State.Caches.DB.PendingInteractionCount.Invalidate(accountID)
+
+	account, err := s.State.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+	)
+	if err != nil {
+		return gtserror.Newf("db error getting account %s: %w", accountID, err)
+	}
+
+	if account.IsRemote() {
+		// No streams for
+		// remote accounts.
+		return nil
+	}
+
+	// Recalculate the count (caching it again).
+	count, err := s.State.DB.CountPendingInteractionsForAccount(ctx, accountID)
+	if err != nil {
+		return gtserror.Newf("db error counting pending interactions: %w", err)
+	}
+
+	s.Stream.PendingInteractionsCount(ctx, account, count)
+	return nil
+}
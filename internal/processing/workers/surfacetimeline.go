@@ -20,6 +20,7 @@ package workers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	statusfilter "github.com/superseriousbusiness/gotosocial/internal/filter/status"
@@ -93,6 +94,57 @@ func (s *Surface) timelineAndNotifyStatus(ctx context.Context, status *gtsmodel.
 	return nil
 }
 
+// timelineStatusForAuthor inserts the given status into the home
+// timeline of its (local) author ONLY, streaming it to them if it
+// was inserted. Unlike timelineAndNotifyStatus, no followers, tag
+// followers, lists or mentions are touched, making this suitable
+// for refreshing the author's own view of a status that shouldn't
+// (yet) be fanned out any further.
+func (s *Surface) timelineStatusForAuthor(ctx context.Context, status *gtsmodel.Status) error {
+	// Ensure status fully populated; including account, mentions, etc.
+	if err := s.State.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status with id %s: %w", status.ID, err)
+	}
+
+	if !status.Account.IsLocal() {
+		// Only local accounts
+		// have home timelines.
+		return nil
+	}
+
+	timelineable, err := s.VisFilter.StatusHomeTimelineable(
+		ctx, status.Account, status,
+	)
+	if err != nil {
+		return gtserror.Newf("error checking status %s hometimelineability: %w", status.ID, err)
+	}
+
+	if !timelineable {
+		// Nothing to do.
+		return nil
+	}
+
+	filters, mutes, err := s.getFiltersAndMutes(ctx, status.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.timelineStatus(
+		ctx,
+		s.State.Timelines.Home.IngestOne,
+		status.AccountID, // home timelines are keyed by account ID
+		status.Account,
+		status,
+		stream.TimelineHome,
+		filters,
+		mutes,
+	); err != nil {
+		return gtserror.Newf("error home timelining status: %w", err)
+	}
+
+	return nil
+}
+
 // timelineAndNotifyStatusForFollowers iterates through the given
 // slice of followers of the account that posted the given status,
 // adding the status to list timelines + home timelines of each
@@ -826,3 +878,219 @@ func (s *Surface) timelineStatusUpdateForTagFollowers(
 	}
 	return errs.Combine()
 }
+
+// adjustStatusTimelinesForVisibility adjusts the HOME and LIST
+// timelines of local accounts that follow the author of the given
+// (edited) status, or that follow its tags, after the status'
+// visibility changed from oldVis to newVis.
+//
+// The status is added to timelines of accounts which can now see it
+// (eg., tag followers of a status made public), and removed from
+// timelines of accounts which no longer can (eg., non-following tag
+// followers of a status made followers-only), while it's left in place
+// for accounts which can still see it, (eg., the author's followers).
+func (s *Surface) adjustStatusTimelinesForVisibility(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	oldVis gtsmodel.Visibility,
+	newVis gtsmodel.Visibility,
+) error {
+	if oldVis == newVis {
+		// Nothing to adjust.
+		return nil
+	}
+
+	// Ensure status fully populated; including account, mentions, etc.
+	if err := s.State.DB.PopulateStatus(ctx, status); err != nil {
+		return gtserror.Newf("error populating status with id %s: %w", status.ID, err)
+	}
+
+	// Get all local followers of the account that posted the status.
+	follows, err := s.State.DB.GetAccountLocalFollowers(ctx, status.AccountID)
+	if err != nil {
+		return gtserror.Newf("error getting local followers of account %s: %w", status.AccountID, err)
+	}
+
+	// If the poster is also local, add a fake entry for them
+	// so they can see their own status in their timeline.
+	if status.Account.IsLocal() {
+		follows = append(follows, &gtsmodel.Follow{
+			AccountID:   status.AccountID,
+			Account:     status.Account,
+			Notify:      util.Ptr(false), // Account shouldn't notify itself.
+			ShowReblogs: util.Ptr(true),  // Account should show own reblogs.
+		})
+	}
+
+	var (
+		errs        gtserror.MultiError
+		followerIDs = make([]string, 0, len(follows))
+	)
+
+	for _, follow := range follows {
+		followerIDs = append(followerIDs, follow.AccountID)
+
+		timelineable, err := s.VisFilter.StatusHomeTimelineable(
+			ctx, follow.Account, status,
+		)
+		if err != nil {
+			errs.Appendf("error checking status %s hometimelineability: %w", status.ID, err)
+			continue
+		}
+
+		if !timelineable {
+			// Follower may no longer see this status,
+			// remove it from their home and list timelines.
+			s.removeStatusFromTimelinesForFollow(ctx, status, follow, &errs)
+			continue
+		}
+
+		filters, mutes, err := s.getFiltersAndMutes(ctx, follow.AccountID)
+		if err != nil {
+			errs.Append(err)
+			continue
+		}
+
+		// Add status to any relevant lists for
+		// this follow, if not already there.
+		s.listTimelineStatusForFollow(
+			ctx,
+			status,
+			follow,
+			&errs,
+			filters,
+			mutes,
+		)
+
+		// Add status to home timeline for owner
+		// of this follow, if not already there.
+		if _, err := s.timelineStatus(
+			ctx,
+			s.State.Timelines.Home.IngestOne,
+			follow.AccountID, // home timelines are keyed by account ID
+			follow.Account,
+			status,
+			stream.TimelineHome,
+			filters,
+			mutes,
+		); err != nil {
+			errs.Appendf("error home timelining status: %w", err)
+		}
+	}
+
+	switch {
+	case newVis == gtsmodel.VisibilityPublic:
+		// Status may now be eligible for the
+		// home timelines of its tag followers.
+		if err := s.timelineAndNotifyStatusForTagFollowers(ctx, status, followerIDs); err != nil {
+			errs.Append(err)
+		}
+
+	case oldVis == gtsmodel.VisibilityPublic:
+		// Status no longer eligible for home
+		// timelines of (non-following) tag followers.
+		s.removeStatusFromTimelinesForTagFollowers(ctx, status, followerIDs, &errs)
+	}
+
+	return errs.Combine()
+}
+
+// removeStatusFromTimelinesForFollow removes the given status
+// from the home timeline of the given follower, and from
+// any list timelines containing the given follow.
+func (s *Surface) removeStatusFromTimelinesForFollow(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	follow *gtsmodel.Follow,
+	errs *gtserror.MultiError,
+) {
+	if _, err := s.State.Timelines.Home.Remove(ctx, follow.AccountID, status.ID); err != nil {
+		errs.Appendf("error removing status from home timeline: %w", err)
+	}
+
+	if follow.ID == "" {
+		// Fake follow of author
+		// by self, no list entries.
+		return
+	}
+
+	// Get every list entry that targets this follow's ID.
+	listEntries, err := s.State.DB.GetListEntriesForFollowID(
+		// We only need the list IDs.
+		gtscontext.SetBarebones(ctx),
+		follow.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		errs.Appendf("error getting list entries: %w", err)
+		return
+	}
+
+	for _, listEntry := range listEntries {
+		if _, err := s.State.Timelines.List.Remove(ctx, listEntry.ListID, status.ID); err != nil {
+			errs.Appendf("error removing status from timeline for list %s: %w", listEntry.ListID, err)
+		}
+	}
+}
+
+// removeStatusFromTimelinesForTagFollowers removes the given status
+// from the home timeline of each local account which follows a useable
+// tag from the status, skipping accounts with IDs in the provided list,
+// and any accounts which may still home timeline it (eg., if mentioned).
+func (s *Surface) removeStatusFromTimelinesForTagFollowers(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	skipAccountIDs []string,
+	errs *gtserror.MultiError,
+) {
+	// Build list of useable tag IDs.
+	useableTagIDs := make([]string, 0, len(status.Tags))
+	for _, tag := range status.Tags {
+		if *tag.Useable {
+			useableTagIDs = append(useableTagIDs, tag.ID)
+		}
+	}
+	if len(useableTagIDs) == 0 {
+		return
+	}
+
+	// Get IDs for all accounts who follow one or more of the useable tags from this status.
+	tagFollowerAccountIDs, err := s.State.DB.GetAccountIDsFollowingTagIDs(ctx, useableTagIDs)
+	if err != nil {
+		errs.Appendf("error getting followers for tags of status %s: %w", status.ID, err)
+		return
+	}
+
+	for _, accountID := range tagFollowerAccountIDs {
+		if slices.Contains(skipAccountIDs, accountID) {
+			// Already handled.
+			continue
+		}
+
+		account, err := s.State.DB.GetAccountByID(ctx, accountID)
+		if err != nil {
+			errs.Appendf("error getting tag follower account %s: %w", accountID, err)
+			continue
+		}
+
+		timelineable, err := s.VisFilter.StatusHomeTimelineable(ctx, account, status)
+		if err != nil {
+			errs.Appendf("error checking status %s hometimelineability: %w", status.ID, err)
+			continue
+		}
+
+		if timelineable {
+			// Can still
+			// see it here.
+			continue
+		}
+
+		if _, err := s.State.Timelines.Home.Remove(ctx, accountID, status.ID); err != nil {
+			errs.Appendf(
+				"error removing status %s from home timeline for account %s: %w",
+				status.ID,
+				accountID,
+				err,
+			)
+		}
+	}
+}
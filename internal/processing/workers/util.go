@@ -20,16 +20,23 @@ package workers
 import (
 	"context"
 	"errors"
+	"net/url"
+	"slices"
+	"time"
 
-	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"codeberg.org/gruf/go-cache/v3/simple"
+	"codeberg.org/gruf/go-debug"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/polls"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -38,83 +45,518 @@ import (
 // util provides util functions used by both
 // the fromClientAPI and fromFediAPI functions.
 type utils struct {
-	state   *state.State
-	media   *media.Processor
-	account *account.Processor
-	surface *Surface
+	state    *state.State
+	media    *media.Processor
+	account  *account.Processor
+	surface  *Surface
+	federate *federate
+
+	// statOps stores keys of recently applied
+	// stats updates, see statOpKey().
+	statOps *simple.Cache[string, struct{}]
+
+	// moves limits the number of account
+	// Moves being processed at once.
+	moves moveLimiter
+
+	// beforeWipe holds hooks that
+	// may veto a wipeStatus call.
+	beforeWipe wipeHooks
 }
 
+// statOpsCap is the maximum number of
+// recently applied stats update keys
+// to keep around for deduplication.
+const statOpsCap = 10000
+
+// Error types stored on the errors returned by wipeStatus,
+// allowing callers to check which part(s) of wiping a status
+// failed (e.g. for targeted retries) using gtserror.HasType().
+const (
+	WipeErrMedia         gtserror.ErrorType = "wipe_media"
+	WipeErrMentions      gtserror.ErrorType = "wipe_mentions"
+	WipeErrNotifications gtserror.ErrorType = "wipe_notifications"
+	WipeErrBookmarks     gtserror.ErrorType = "wipe_bookmarks"
+	WipeErrInteractions  gtserror.ErrorType = "wipe_interactions"
+	WipeErrPoll          gtserror.ErrorType = "wipe_poll"
+	WipeErrBoosts        gtserror.ErrorType = "wipe_boosts"
+	WipeErrReplies       gtserror.ErrorType = "wipe_replies"
+	WipeErrTimelines     gtserror.ErrorType = "wipe_timelines"
+	WipeErrMarkers       gtserror.ErrorType = "wipe_markers"
+	WipeErrStatus        gtserror.ErrorType = "wipe_status"
+	WipeErrReports       gtserror.ErrorType = "wipe_reports"
+
+	// WipeErrOwnerMismatch is stored on the error returned
+	// by wipeStatus when the status is not owned by the
+	// expected account; in this case nothing is wiped.
+	WipeErrOwnerMismatch gtserror.ErrorType = "wipe_owner_mismatch"
+
+	// WipeErrVetoed is stored on the error returned
+	// by wipeStatus when a registered BeforeWipeHook
+	// returns an error; in this case nothing is wiped.
+	WipeErrVetoed gtserror.ErrorType = "wipe_vetoed"
+)
+
+// ApproveErrInvalidURI is stored on the error returned by
+// the approve* helpers when the interaction to approve has
+// a malformed URI, which would make for an unresolvable
+// Accept; in this case nothing is stored.
+const ApproveErrInvalidURI gtserror.ErrorType = "approve_invalid_uri"
+
 // wipeStatus encapsulates common logic
 // used to totally delete a status + all
 // its attachments, notifications, boosts,
 // and timeline entries.
+//
+// The status row itself is always deleted
+// last, only once deletion of everything
+// that relates to it has been attempted.
+// Note this is not transactional: if the
+// final delete fails, the related models
+// will already be gone.
+//
+// If expectOwnerID is set, the status is only
+// wiped if it's owned by that account ID, else
+// an error of type WipeErrOwnerMismatch is
+// returned before anything is deleted. Likewise,
+// if any registered BeforeWipeHook returns an
+// error, an error of type WipeErrVetoed is
+// returned before anything is deleted.
+//
+// Each error in the returned error has one
+// of the WipeErr* types stored on it, noting
+// which part of the wipe it originated from.
 func (u *utils) wipeStatus(
 	ctx context.Context,
 	statusToDelete *gtsmodel.Status,
+	expectOwnerID string,
 	deleteAttachments bool,
 ) error {
+	if expectOwnerID != "" &&
+		statusToDelete.AccountID != expectOwnerID {
+		// Status isn't owned by who the caller
+		// thinks it is; don't wipe anything.
+		err := gtserror.Newf(
+			"status %s owned by account %s, expected %s",
+			statusToDelete.ID, statusToDelete.AccountID, expectOwnerID,
+		)
+		return gtserror.WithType(err, WipeErrOwnerMismatch)
+	}
+
+	// Give any registered hooks
+	// the chance to veto the wipe.
+	if err := u.beforeWipe.run(ctx, statusToDelete); err != nil {
+		return err
+	}
+
+	// In debug builds, record the order in which
+	// relations are wiped if not already doing so.
+	order := getWipeOrder(ctx)
+	if order == nil && debug.DEBUG {
+		order = new(WipeOrder)
+		ctx = WithWipeOrder(ctx, order)
+	}
+
 	var errs gtserror.MultiError
 
+	// Ensure attachment IDs are sane before
+	// wiping, so each attachment is handled
+	// exactly once below.
+	if err := u.repairAttachmentIDs(ctx, statusToDelete); err != nil {
+		errs.AppendTypef(WipeErrMedia, "error repairing attachment ids: %w", err)
+	}
+
+	// Before anything is removed, keep a copy
+	// of the status in any reports of it, if
+	// configured to do so.
+	if config.GetInstanceReportsPreserveStatuses() {
+		if err := u.snapshotReportedStatus(ctx, statusToDelete); err != nil {
+			errs.AppendTypef(WipeErrReports, "error preserving reported status: %w", err)
+		}
+	}
+
+	// Then wipe everything that
+	// relates to / points to status.
+	errs = append(errs, u.wipeStatusPeripherals(ctx,
+		statusToDelete,
+		deleteAttachments,
+	)...)
+
+	// Finally, delete the status itself. This MUST
+	// stay last, as the above rely on the status
+	// (and its ID) still being present in the db.
+	order.add(WipeStepStatus)
+	if err := u.state.DB.DeleteStatusByID(ctx, statusToDelete.ID); err != nil {
+		errs.AppendTypef(WipeErrStatus, "error deleting status: %w", err)
+	} else if statusToDelete.InReplyToID != "" &&
+		!util.PtrOrZero(statusToDelete.PendingApproval) &&
+		config.GetStatusesReplyDeleteParentUpdate() {
+		// If configured, let remotes know
+		// the parent's reply count changed.
+		u.scheduleParentUpdate(ctx, statusToDelete.InReplyToID)
+	}
+
+	if debug.DEBUG && order != nil {
+		log.Debugf(ctx, "wiped status %s in order: %v", statusToDelete.ID, order.Steps())
+		if err := order.Verify(); err != nil {
+			log.Errorf(ctx, "unsafe wipe order for status %s: %v", statusToDelete.ID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// repairAttachmentIDs ensures the given status' attachment
+// IDs contain no duplicates, and no IDs of attachments that
+// no longer exist, as may be left behind by concurrent edits.
+// The order of the remaining IDs is kept. If anything needed
+// repairing, the status is updated in the database.
+func (u *utils) repairAttachmentIDs(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	if len(status.AttachmentIDs) == 0 {
+		// Nothing
+		// to repair.
+		return nil
+	}
+
+	// Fetch attachments for the unique IDs,
+	// any without matching rows are dropped.
+	attachments, err := u.state.DB.GetAttachmentsByIDs(
+		gtscontext.SetBarebones(ctx),
+		util.Deduplicate(status.AttachmentIDs),
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting attachments: %w", err)
+	}
+
+	attachmentIDs := util.Gather(nil, attachments,
+		func(attachment *gtsmodel.MediaAttachment) string {
+			return attachment.ID
+		},
+	)
+
+	if slices.Equal(attachmentIDs, status.AttachmentIDs) {
+		// Already
+		// sane.
+		return nil
+	}
+
+	log.Warnf(ctx, "repairing attachment ids of status %s: %v -> %v",
+		status.ID, status.AttachmentIDs, attachmentIDs)
+
+	if err := u.swapStatusAttachments(ctx,
+		status,
+		attachmentIDs,
+	); err != nil {
+		return err
+	}
+
+	status.Attachments = attachments
+	return nil
+}
+
+// swapStatusAttachments replaces the attachments of the
+// given status with those of newAttachmentIDs. Updating
+// the status and attaching added / unattaching removed
+// media happens in one transaction, so an interrupted
+// swap never leaves the status pointing to missing media.
+// Removed media are deleted only once the swap is done;
+// should that fail, they're left unattached for cleanup.
+func (u *utils) swapStatusAttachments(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	newAttachmentIDs []string,
+) error {
+	removedIDs, err := u.state.DB.SwapStatusAttachments(ctx,
+		status,
+		newAttachmentIDs,
+	)
+	if err != nil {
+		return gtserror.Newf("db error swapping attachments: %w", err)
+	}
+
+	for _, id := range removedIDs {
+		if err := u.media.Delete(ctx, id); err != nil {
+			log.Errorf(ctx, "error deleting removed media %s: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// statusTaskPrefix returns the prefix of the IDs of
+// all scheduler tasks tied to the status with given ID.
+//
+// Any task that acts on a status should be scheduled
+// under an ID from statusTaskID, so that it gets
+// cancelled by cancelStatusTasks once the status is
+// wiped, rather than firing against a missing status.
+func statusTaskPrefix(statusID string) string {
+	return "status:" + statusID + ":"
+}
+
+// statusTaskID returns the scheduler task ID used for
+// a task of the given kind tied to status with given ID.
+func statusTaskID(statusID string, kind string) string {
+	return statusTaskPrefix(statusID) + kind
+}
+
+// cancelStatusTasks cancels all scheduled
+// tasks tied to the status with given ID.
+func (u *utils) cancelStatusTasks(statusID string) {
+	_ = u.state.Workers.Scheduler.CancelPrefix(statusTaskPrefix(statusID))
+}
+
+// ParentUpdateID returns the scheduler task ID
+// used for federating an Update of the parent
+// status with given ID after a reply deletion.
+func ParentUpdateID(parentID string) string {
+	return statusTaskID(parentID, "parent_update")
+}
+
+// scheduleParentUpdate schedules federation of an Update
+// of the local status with given ID, after the configured
+// delay. If an Update is already scheduled for the status,
+// this is a no-op, coalescing multiple reply deletions
+// within the delay window into a single Update.
+func (u *utils) scheduleParentUpdate(ctx context.Context, parentID string) {
+	parent, err := u.state.DB.GetStatusByID(
+		gtscontext.SetBarebones(ctx),
+		parentID,
+	)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting parent status %s: %v", parentID, err)
+		}
+		return
+	}
+
+	if !*parent.Local || !*parent.Federated {
+		// Only our own federated
+		// statuses need Updating.
+		return
+	}
+
+	taskID := ParentUpdateID(parentID)
+	delay := config.GetStatusesReplyDeleteUpdateDelay()
+	_ = u.state.Workers.Scheduler.AddOnce(
+		taskID,
+		time.Now().Add(delay),
+		func(ctx context.Context, _ time.Time) {
+			// Fired tasks stay registered, drop this
+			// one so later deletions can reschedule.
+			_ = u.state.Workers.Scheduler.Cancel(taskID)
+
+			// Get latest version of parent from the database.
+			parent, err := u.state.DB.GetStatusByID(ctx, parentID)
+			if err != nil {
+				if !errors.Is(err, db.ErrNoEntries) {
+					log.Errorf(ctx, "db error getting parent status %s: %v", parentID, err)
+				}
+				return
+			}
+
+			if err := u.federate.UpdateStatus(ctx, parent); err != nil {
+				log.Errorf(ctx, "error federating parent status %s update: %v", parentID, err)
+			}
+		},
+	)
+}
+
+// snapshotReportedStatus stores a copy of the given status'
+// content, content warning and attachment metadata on each
+// report referencing it, so that it remains available to
+// moderators once the status has been deleted.
+func (u *utils) snapshotReportedStatus(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	// Reports referencing a status must
+	// all target the author of the status.
+	reports, err := u.state.DB.GetReports(
+		gtscontext.SetBarebones(ctx),
+		nil,
+		"",
+		status.AccountID,
+		nil,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting reports: %w", err)
+	}
+
+	// Only bother building snapshot
+	// if status was actually reported.
+	reports = slices.DeleteFunc(reports, func(report *gtsmodel.Report) bool {
+		return !slices.Contains(report.StatusIDs, status.ID)
+	})
+	if len(reports) == 0 {
+		return nil
+	}
+
+	snapshot := &gtsmodel.ReportStatusSnapshot{
+		StatusID:       status.ID,
+		URI:            status.URI,
+		CreatedAt:      status.CreatedAt,
+		DeletedAt:      time.Now(),
+		ContentWarning: status.ContentWarning,
+		Content:        status.Content,
+	}
+
+	attachments := status.Attachments
+	if !status.AttachmentsPopulated() {
+		attachments, err = u.state.DB.GetAttachmentsByIDs(ctx, status.AttachmentIDs)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting attachments: %w", err)
+		}
+	}
+
+	for _, attachment := range attachments {
+		snapshot.Attachments = append(snapshot.Attachments,
+			&gtsmodel.ReportAttachmentSnapshot{
+				ID:          attachment.ID,
+				Type:        attachment.Type.String(),
+				URL:         attachment.URL,
+				RemoteURL:   attachment.RemoteURL,
+				Description: attachment.Description,
+			},
+		)
+	}
+
+	var errs gtserror.MultiError
+	for _, report := range reports {
+		report.StatusSnapshots = append(report.StatusSnapshots, snapshot)
+		if _, err := u.state.DB.UpdateReport(ctx,
+			report,
+			"status_snapshots",
+		); err != nil {
+			errs.Appendf("db error updating report %s: %w", report.ID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// wipeStatusPeripherals deletes all attachments, mentions,
+// notifications, bookmarks, approvals, faves, polls, boosts,
+// pending interactions, timeline and conversation entries of the
+// given status, but NOT the status itself. See wipeStatus()
+// for more info.
+func (u *utils) wipeStatusPeripherals(
+	ctx context.Context,
+	statusToDelete *gtsmodel.Status,
+	deleteAttachments bool,
+) gtserror.MultiError {
+	var (
+		errs  gtserror.MultiError
+		order = getWipeOrder(ctx)
+	)
+
 	// Either delete all attachments for this status,
 	// or simply unattach + clean them separately later.
 	//
 	// Reason to unattach rather than delete is that
 	// the poster might want to reattach them to another
 	// status immediately (in case of delete + redraft)
+	order.add(WipeStepAttachments)
 	if deleteAttachments {
-		// todo:u.state.DB.DeleteAttachmentsForStatus
-		for _, id := range statusToDelete.AttachmentIDs {
-			if err := u.media.Delete(ctx, id); err != nil {
-				errs.Appendf("error deleting media: %w", err)
-			}
+		for _, err := range u.media.DeleteForStatus(ctx, statusToDelete.ID) {
+			errs.AppendTypef(WipeErrMedia, "error deleting media: %w", err)
 		}
 	} else {
-		// todo:u.state.DB.UnattachAttachmentsForStatus
-		for _, id := range statusToDelete.AttachmentIDs {
-			if _, err := u.media.Unattach(ctx, statusToDelete.Account, id); err != nil {
-				errs.Appendf("error unattaching media: %w", err)
-			}
+		// Unattach all in one go; media that's
+		// already unattached is left as-is.
+		if _, err := u.state.DB.UnattachAttachmentsForStatus(ctx, statusToDelete); err != nil {
+			errs.AppendTypef(WipeErrMedia, "error unattaching media: %w", err)
 		}
 	}
 
 	// delete all mention entries generated by this status
-	// todo:u.state.DB.DeleteMentionsForStatus
-	for _, id := range statusToDelete.MentionIDs {
-		if err := u.state.DB.DeleteMentionByID(ctx, id); err != nil {
-			errs.Appendf("error deleting status mention: %w", err)
-		}
+	order.add(WipeStepMentions)
+	if err := u.state.DB.DeleteMentionsForStatus(ctx, statusToDelete.ID); err != nil {
+		errs.AppendTypef(WipeErrMentions, "error deleting status mentions: %w", err)
 	}
 
 	// delete all notification entries generated by this status
+	order.add(WipeStepNotifications)
 	if err := u.state.DB.DeleteNotificationsForStatus(ctx, statusToDelete.ID); err != nil {
-		errs.Appendf("error deleting status notifications: %w", err)
+		errs.AppendTypef(WipeErrNotifications, "error deleting status notifications: %w", err)
 	}
 
 	// delete all bookmarks that point to this status
+	order.add(WipeStepBookmarks)
 	if err := u.state.DB.DeleteStatusBookmarksForStatus(ctx, statusToDelete.ID); err != nil {
-		errs.Appendf("error deleting status bookmarks: %w", err)
+		errs.AppendTypef(WipeErrBookmarks, "error deleting status bookmarks: %w", err)
+	}
+
+	// delete all approvals of this status,
+	// and of any faves and boosts of it
+	order.add(WipeStepApprovals)
+	approvalIDs, err := u.state.DB.DeleteInteractionApprovalsForStatus(ctx, statusToDelete.ID)
+	if err != nil {
+		errs.AppendTypef(WipeErrInteractions, "error deleting interaction approvals: %w", err)
+	}
+	u.cancelApprovalExpiries(approvalIDs)
+
+	// delete all rejections of this status,
+	// and of any faves and boosts of it
+	order.add(WipeStepRejections)
+	if err := u.state.DB.DeleteInteractionRejectionsForStatus(ctx, statusToDelete.ID); err != nil {
+		errs.AppendTypef(WipeErrInteractions, "error deleting interaction rejections: %w", err)
+	}
+
+	// delete all pending faves, replies, and boosts
+	// of this status, rejecting any remote ones
+	order.add(WipeStepPendingInteractions)
+	if err := u.deletePendingInteractions(ctx, statusToDelete); err != nil {
+		errs.AppendTypef(WipeErrInteractions, "error deleting pending interactions: %w", err)
 	}
 
 	// delete all faves of this status
+	order.add(WipeStepFaves)
 	if err := u.state.DB.DeleteStatusFavesForStatus(ctx, statusToDelete.ID); err != nil {
-		errs.Appendf("error deleting status faves: %w", err)
+		errs.AppendTypef(WipeErrInteractions, "error deleting status faves: %w", err)
 	}
 
 	if pollID := statusToDelete.PollID; pollID != "" {
-		// Delete this poll by ID from the database.
-		if err := u.state.DB.DeletePollByID(ctx, pollID); err != nil {
-			errs.Appendf("error deleting status poll: %w", err)
+		// If configured, archive final poll tallies
+		// before the poll and its votes are deleted.
+		if config.GetStatusesPollArchiveOnDelete() {
+			if err := u.archivePoll(ctx, statusToDelete); err != nil {
+				errs.AppendTypef(WipeErrPoll, "error archiving status poll: %w", err)
+			}
 		}
 
-		// Delete any poll votes pointing to this poll ID.
+		// Delete any poll votes pointing to this poll ID,
+		// before the poll they point to.
+		order.add(WipeStepPollVotes)
 		if err := u.state.DB.DeletePollVotes(ctx, pollID); err != nil {
-			errs.Appendf("error deleting status poll votes: %w", err)
+			errs.AppendTypef(WipeErrPoll, "error deleting status poll votes: %w", err)
+		}
+
+		// Delete this poll by ID from the database.
+		order.add(WipeStepPoll)
+		if err := u.state.DB.DeletePollByID(ctx, pollID); err != nil {
+			errs.AppendTypef(WipeErrPoll, "error deleting status poll: %w", err)
 		}
 
 		// Cancel any scheduled expiry task for poll.
-		_ = u.state.Workers.Scheduler.Cancel(pollID)
+		_ = u.state.Workers.Scheduler.Cancel(polls.ExpiryID(pollID))
 	}
 
+	// Cancel any scheduled tasks for status, eg.,
+	// its expiry, so they don't fire once it's gone.
+	u.cancelStatusTasks(statusToDelete.ID)
+
+	// Removing a very popular status from timelines can
+	// take a while, so if above the configured threshold
+	// do it in the background after the status is gone.
+	var (
+		deferTimelines = u.deferTimelineRemoval(ctx, statusToDelete)
+		deferredIDs    []string
+	)
+
 	// delete all boosts for this status + remove them from timelines
 	boosts, err := u.state.DB.GetStatusBoosts(
 		// we MUST set a barebones context here,
@@ -123,128 +565,716 @@ func (u *utils) wipeStatus(
 		gtscontext.SetBarebones(ctx),
 		statusToDelete.ID)
 	if err != nil {
-		errs.Appendf("error fetching status boosts: %w", err)
+		errs.AppendTypef(WipeErrBoosts, "error fetching status boosts: %w", err)
+	}
+
+	// Remove all boosts from timelines before the
+	// original below, as their entries are indexed
+	// under it. When deferred, the IDs are removed
+	// in order, so the original must go in last.
+	order.add(WipeStepBoostTimelines)
+	for _, boost := range boosts {
+		if deferTimelines {
+			deferredIDs = append(deferredIDs, boost.ID)
+		} else if err := u.surface.deleteStatusFromTimelines(ctx, boost.ID); err != nil {
+			errs.AppendTypef(WipeErrBoosts, "error deleting boost from timelines: %w", err)
+		}
+	}
+
+	order.add(WipeStepBoosts)
+	for _, boost := range boosts {
+		if err := u.state.DB.DeleteStatusByID(ctx, boost.ID); err != nil {
+			errs.AppendTypef(WipeErrBoosts, "error deleting boost: %w", err)
+		}
+	}
+
+	// if configured, point any replies to
+	// this status at a placeholder instead
+	if config.GetStatusesOrphanedReplyPlaceholder() {
+		order.add(WipeStepReplies)
+		if err := u.reparentOrphanedReplies(ctx, statusToDelete); err != nil {
+			errs.AppendTypef(WipeErrReplies, "error reparenting orphaned replies: %w", err)
+		}
+	}
+
+	// roll back any home timeline markers
+	// that point at this status as last read
+	if err := u.rollbackMarkers(ctx, statusToDelete); err != nil {
+		errs.AppendTypef(WipeErrMarkers, "error rolling back markers: %w", err)
+	}
+
+	// delete this status from any and all timelines
+	order.add(WipeStepTimelines)
+	if deferTimelines {
+		deferredIDs = append(deferredIDs, statusToDelete.ID)
+		u.deleteFromTimelinesAsync(deferredIDs)
+	} else if err := u.surface.deleteStatusFromTimelines(ctx, statusToDelete.ID); err != nil {
+		errs.AppendTypef(WipeErrTimelines, "error deleting status from timelines: %w", err)
+	}
+
+	// delete this status from any conversations that it's part of
+	order.add(WipeStepConversationStatuses)
+	if err := u.state.DB.DeleteStatusFromConversations(ctx, statusToDelete.ID); err != nil {
+		errs.AppendTypef(WipeErrTimelines, "error deleting status from conversations: %w", err)
+	}
+
+	return errs
+}
+
+// deferTimelineRemoval returns whether removal of the given
+// status from timelines should be deferred to a background
+// worker, ie., whether its author has more followers (and
+// so the status likely is in more timelines) than allowed
+// by the configured threshold.
+func (u *utils) deferTimelineRemoval(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) bool {
+	threshold := config.GetStatusesTimelineRemovalDeferThreshold()
+	if threshold <= 0 {
+		// Never defer.
+		return false
+	}
+
+	stats, err := u.state.DB.SnapshotAccountStats(ctx, status.AccountID)
+	if err != nil {
+		log.Errorf(ctx, "db error getting stats of account %s: %v", status.AccountID, err)
+		return false
+	}
+
+	return util.PtrOrZero(stats.FollowersCount) > threshold
+}
+
+// deleteFromTimelinesAsync removes the statuses
+// with given IDs from all timelines, using the
+// processing worker queue. The statuses are
+// removed one after another, in the given order.
+func (u *utils) deleteFromTimelinesAsync(statusIDs []string) {
+	u.state.Workers.Processing.Queue.Push(func(ctx context.Context) {
+		for _, statusID := range statusIDs {
+			if err := u.surface.deleteStatusFromTimelines(ctx, statusID); err != nil {
+				log.Errorf(ctx, "error deleting status %s from timelines: %v", statusID, err)
+			}
+		}
+	})
+}
+
+// rollbackMarkers updates any home timeline markers
+// with the given status as their last read ID, to
+// point at the status preceding it in the marker
+// owner's home timeline instead.
+//
+// Markers with no such preceding status are
+// left as-is, as the last read ID will still
+// work as a paging position for clients.
+func (u *utils) rollbackMarkers(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	markers, err := u.state.DB.GetMarkersByLastReadID(ctx,
+		gtsmodel.MarkerNameHome,
+		status.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting markers: %w", err)
+	}
+
+	var errs gtserror.MultiError
+
+	for _, marker := range markers {
+		// Get the status directly before this
+		// one in the marker owner's home timeline.
+		prev, err := u.state.DB.GetHomeTimeline(
+			gtscontext.SetBarebones(ctx),
+			marker.AccountID,
+			status.ID, // maxID
+			"",        // sinceID
+			"",        // minID
+			1,         // limit
+			false,     // local
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("db error getting home timeline for account %s: %w", marker.AccountID, err)
+			continue
+		}
+
+		if len(prev) == 0 {
+			// Nothing
+			// to roll
+			// back to.
+			continue
+		}
+
+		marker.LastReadID = prev[0].ID
+		if err := u.state.DB.UpdateMarker(ctx, marker); err != nil {
+			errs.Appendf("db error updating marker for account %s: %w", marker.AccountID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// deletePendingInteractions deletes all interactions
+// with the given status that are still pending approval,
+// sending out Rejects for those by remote accounts.
+// Pending replies and boosts are wiped as statuses.
+func (u *utils) deletePendingInteractions(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	faves, statuses, err := u.state.DB.DeletePendingInteractionsForStatus(ctx, status.ID)
+	if err != nil {
+		return err
+	}
+
+	// Wipe each pending status with its own
+	// wipe order, it's not part of this one.
+	wipeCtx := WithWipeOrder(ctx, nil)
+	for _, s := range statuses {
+		if err := u.wipeStatus(wipeCtx, s, "", true); err != nil {
+			log.Errorf(ctx, "error wiping pending status %s: %v", s.ID, err)
+		}
+	}
+
+	if status.Account == nil || status.Account.IsRemote() {
+		// We can only Reject
+		// on behalf of our own
+		// accounts, nothing to do.
+		return nil
+	}
+
+	// Gather interacting account
+	// IDs + URIs of each interaction.
+	type interaction struct {
+		accountID string
+		uri       string
+	}
+	interactions := make([]interaction, 0, len(faves)+len(statuses))
+	for _, fave := range faves {
+		interactions = append(interactions, interaction{fave.AccountID, fave.URI})
+	}
+	for _, s := range statuses {
+		interactions = append(interactions, interaction{s.AccountID, s.URI})
+	}
+
+	for _, i := range interactions {
+		interacting, err := u.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			i.accountID,
+		)
+		if err != nil {
+			log.Errorf(ctx, "db error getting interacting account %s: %v", i.accountID, err)
+			continue
+		}
+
+		if err := u.federate.RejectInteractionURI(ctx,
+			status.Account,
+			interacting,
+			i.uri,
+		); err != nil {
+			log.Errorf(ctx, "error federating reject of %s: %v", i.uri, err)
+		}
+	}
+
+	return nil
+}
+
+// recordRejection records a rejection of the interaction
+// in given (unstored) approval, if it was given a reason
+// code, for reason code analytics. Rejections without a
+// reason, or reverting a stored approval, aren't recorded.
+//
+// This must be called after the rejected interaction is
+// wiped, as wiping it deletes its rejections.
+func (u *utils) recordRejection(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+) error {
+	if approval.ID != "" || approval.Reason == "" {
+		// Nothing
+		// to record.
+		return nil
+	}
+
+	return u.state.DB.PutInteractionRejection(ctx, &gtsmodel.InteractionRejection{
+		ID:                   id.NewULID(),
+		AccountID:            approval.AccountID,
+		InteractingAccountID: approval.InteractingAccountID,
+		InteractionURI:       approval.InteractionURI,
+		InteractionType:      approval.InteractionType,
+		Reason:               approval.Reason,
+	})
+}
+
+// rejectTooNewReply removes the given pending reply,
+// automatically rejecting it as its account is too
+// new to interact with the replied-to account.
+func (u *utils) rejectTooNewReply(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	deleteAttachments bool,
+) error {
+	if err := u.wipeStatus(ctx, status, "", deleteAttachments); err != nil {
+		return gtserror.Newf("error wiping rejected reply: %w", err)
+	}
+
+	u.rejectTooNew(ctx, &gtsmodel.InteractionApproval{
+		AccountID:            status.InReplyToAccountID,
+		Account:              status.InReplyToAccount,
+		InteractingAccountID: status.AccountID,
+		InteractingAccount:   status.Account,
+		InteractionURI:       status.URI,
+		InteractionType:      gtsmodel.InteractionReply,
+	})
+	return nil
+}
+
+// rejectTooNewFave removes the given pending fave,
+// automatically rejecting it as its account is too
+// new to interact with the faved account.
+func (u *utils) rejectTooNewFave(
+	ctx context.Context,
+	fave *gtsmodel.StatusFave,
+) error {
+	if err := u.state.DB.DeleteStatusFaveByID(ctx, fave.ID); err != nil {
+		return gtserror.Newf("db error deleting rejected fave: %w", err)
+	}
+
+	u.rejectTooNew(ctx, &gtsmodel.InteractionApproval{
+		AccountID:            fave.TargetAccountID,
+		Account:              fave.TargetAccount,
+		InteractingAccountID: fave.AccountID,
+		InteractingAccount:   fave.Account,
+		InteractionURI:       fave.URI,
+		InteractionType:      gtsmodel.InteractionLike,
+	})
+	return nil
+}
+
+// rejectTooNewAnnounce removes the given pending boost,
+// automatically rejecting it as its account is too
+// new to interact with the boosted account.
+func (u *utils) rejectTooNewAnnounce(
+	ctx context.Context,
+	boost *gtsmodel.Status,
+) error {
+	if err := u.state.DB.DeleteStatusByID(ctx, boost.ID); err != nil {
+		return gtserror.Newf("db error deleting rejected boost: %w", err)
+	}
+
+	u.rejectTooNew(ctx, &gtsmodel.InteractionApproval{
+		AccountID:            boost.BoostOfAccountID,
+		Account:              boost.BoostOfAccount,
+		InteractingAccountID: boost.AccountID,
+		InteractingAccount:   boost.Account,
+		InteractionURI:       boost.URI,
+		InteractionType:      gtsmodel.InteractionAnnounce,
+	})
+	return nil
+}
+
+// rejectTooNew sends a Reject of the (already removed)
+// interaction in given unstored approval, and records
+// the rejection with the too new reason code.
+func (u *utils) rejectTooNew(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+) {
+	approval.Reason = gtsmodel.InteractionReasonTooNew
+
+	if err := u.federate.RejectInteractionURI(ctx,
+		approval.Account,
+		approval.InteractingAccount,
+		approval.InteractionURI,
+	); err != nil {
+		log.Errorf(ctx, "error federating interaction reject: %v", err)
+	}
+
+	if err := u.recordRejection(ctx, approval); err != nil {
+		log.Errorf(ctx, "db error recording interaction rejection: %v", err)
+	}
+}
+
+// archivePoll stores the final tallies of
+// the given status' poll as a PollArchive,
+// so they outlive deletion of the poll.
+func (u *utils) archivePoll(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	poll, err := u.state.DB.GetPollByID(
+		gtscontext.SetBarebones(ctx),
+		status.PollID,
+	)
+	if err != nil {
+		return gtserror.Newf("db error getting poll: %w", err)
+	}
+
+	// Ensure counts are set.
+	poll.CheckVotes()
+
+	archive := &gtsmodel.PollArchive{
+		ID:        id.NewULID(),
+		PollID:    poll.ID,
+		StatusID:  status.ID,
+		AccountID: status.AccountID,
+		Multiple:  poll.Multiple,
+		Options:   poll.Options,
+		Votes:     poll.Votes,
+		Voters:    poll.Voters,
+		ExpiresAt: poll.ExpiresAt,
+		ClosedAt:  poll.ClosedAt,
+	}
+
+	if err := u.state.DB.PutPollArchive(ctx, archive); err != nil {
+		return gtserror.Newf("db error putting poll archive: %w", err)
+	}
+
+	return nil
+}
+
+// reparentOrphanedReplies creates a lightweight placeholder
+// status standing in for the given (about to be deleted)
+// status, and repoints all direct replies of it to the
+// placeholder, so that thread views can consistently
+// render a "deleted parent" in its place.
+//
+// The placeholder is authored by the instance account,
+// has no content, and is never federated. It's flagged
+// as a placeholder, keeping it out of timelines and
+// profiles; it only shows up in thread views.
+func (u *utils) reparentOrphanedReplies(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	if status.BoostOfID != "" {
+		// Boost wrappers
+		// can't be replied to.
+		return nil
+	}
+
+	replies, err := u.state.DB.CountStatusReplies(ctx, status.ID)
+	if err != nil {
+		return gtserror.Newf("db error counting replies: %w", err)
+	}
+
+	if replies == 0 {
+		// Nothing
+		// to orphan.
+		return nil
+	}
+
+	instanceAcct, err := u.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	// Generate the placeholder ID from the original's
+	// creation time, so it sorts in the same position.
+	placeholderID, err := id.NewULIDFromTime(status.CreatedAt)
+	if err != nil {
+		return gtserror.Newf("error generating id: %w", err)
+	}
+
+	placeholder := &gtsmodel.Status{
+		ID:                  placeholderID,
+		CreatedAt:           status.CreatedAt,
+		UpdatedAt:           time.Now(),
+		URI:                 status.URI + "#deleted",
+		Local:               util.Ptr(true),
+		AccountID:           instanceAcct.ID,
+		AccountURI:          instanceAcct.URI,
+		InReplyToID:         status.InReplyToID,
+		InReplyToURI:        status.InReplyToURI,
+		InReplyToAccountID:  status.InReplyToAccountID,
+		ThreadID:            status.ThreadID,
+		Visibility:          status.Visibility,
+		Sensitive:           util.Ptr(false),
+		ActivityStreamsType: status.ActivityStreamsType,
+		Federated:           util.Ptr(false),
+		PendingApproval:     util.Ptr(false),
+		Placeholder:         util.Ptr(true),
+	}
+
+	if err := u.state.DB.PutStatus(ctx, placeholder); err != nil {
+		return gtserror.Newf("db error putting placeholder: %w", err)
+	}
+
+	if _, err := u.state.DB.ReparentStatusReplies(ctx,
+		status.ID,
+		placeholder,
+	); err != nil {
+		return gtserror.Newf("db error reparenting replies: %w", err)
+	}
+
+	return nil
+}
+
+// redirectFollowers redirects all local
+// followers of originAcct to targetAcct.
+//
+// Both accounts must be fully dereferenced
+// already, and the Move must be valid.
+//
+// Return bool will be true if all goes OK.
+// If redirecting some followers failed, the
+// others are still redirected; a later retry
+// of the Move will pick up the failed ones.
+// Progress is stored on the Move, so a pass
+// that's interrupted resumes where it was.
+//
+// The time taken to redirect is recorded
+// in metrics, if all goes OK. Progress is
+// recorded in the event log of the Move.
+func (u *utils) redirectFollowers(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	originAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+) bool {
+	start := time.Now()
+	u.recordMoveEvent(ctx, move, gtsmodel.MoveEventRedirectStarted, "")
+
+	result, err := u.account.RedirectFollowers(
+		ctx,
+		move,
+		originAcct,
+		targetAcct,
+		func(follow *gtsmodel.Follow, err error) {
+			if err != nil {
+				// Note which follower failed, and why.
+				details := follow.AccountID + ": " + err.Error()
+				if follow.Account != nil {
+					details = follow.Account.URI + ": " + err.Error()
+				}
+				u.recordMoveEvent(ctx, move,
+					gtsmodel.MoveEventFollowerFailed,
+					details,
+				)
+				return
+			}
+
+			u.recordMoveEvent(ctx, move,
+				gtsmodel.MoveEventFollowerMigrated,
+				follow.Account.URI,
+			)
+
+			// Re-send the new follow later if
+			// target's instance doesn't confirm.
+			u.scheduleMoveFollowRetry(ctx,
+				follow.AccountID,
+				targetAcct,
+				1,
+			)
+		},
+	)
+	if err != nil {
+		log.Errorf(ctx,
+			"error(s) redirecting followers (%d migrated, %d failed): %v",
+			result.Migrated, result.Failed, err,
+		)
+		return false
+	}
+
+	metrics.RecordMove(ctx, result.Migrated, time.Since(start))
+	return true
+}
+
+// moveCancelled returns whether the given Move of
+// originAcct has been cancelled by the account.
+// Errors checking this are logged, and treated
+// as the Move not having been cancelled.
+func (u *utils) moveCancelled(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	originAcct *gtsmodel.Account,
+) bool {
+	cancelled, err := u.account.MoveCancelled(ctx, originAcct, move)
+	if err != nil {
+		log.Errorf(ctx, "error checking if move %s cancelled: %v", move.ID, err)
+		return false
+	}
+
+	if cancelled {
+		log.Infof(ctx, "move %s cancelled, stopping", move.ID)
+	}
+
+	return cancelled
+}
+
+// MoveFollowRetryID returns the scheduler task ID
+// used for retrying the follow of the Move target
+// with given ID, by the migrated follower with ID.
+func MoveFollowRetryID(followerID string, targetID string) string {
+	return "move_follow_retry:" + followerID + ":" + targetID
+}
+
+// scheduleMoveFollowRetry schedules a re-send of the follow
+// of the given remote Move target by the migrated follower
+// with given ID, after the configured delay, in case the
+// target's instance never confirmed the follow. The given
+// attempt is the number of this retry, starting from 1.
+func (u *utils) scheduleMoveFollowRetry(
+	ctx context.Context,
+	followerID string,
+	targetAcct *gtsmodel.Account,
+	attempt int,
+) {
+	if followerID == targetAcct.ID ||
+		targetAcct.IsLocal() {
+		// Follows of local targets
+		// are confirmed immediately.
+		return
+	}
+
+	if attempt > config.GetAccountsMoveFollowRetryAttempts() {
+		// Retries used up
+		// (or disabled).
+		return
+	}
+
+	taskID := MoveFollowRetryID(followerID, targetAcct.ID)
+	at := time.Now().Add(config.GetAccountsMoveFollowRetryDelay())
+
+	if !u.state.Workers.Scheduler.AddOnce(
+		taskID,
+		at,
+		func(ctx context.Context, _ time.Time) {
+			// Fired tasks stay registered, drop
+			// this one so the ID can be reused.
+			_ = u.state.Workers.Scheduler.Cancel(taskID)
+			u.retryMoveFollow(ctx, followerID, targetAcct, attempt)
+		},
+	) {
+		log.Warnf(ctx, "failed to schedule %s", taskID)
+	}
+}
+
+// retryMoveFollow re-sends the follow of the given Move target
+// by the migrated follower with given ID, if it's still awaiting
+// confirmation (ie., is still a follow request), then schedules
+// the next retry. See scheduleMoveFollowRetry.
+func (u *utils) retryMoveFollow(
+	ctx context.Context,
+	followerID string,
+	targetAcct *gtsmodel.Account,
+	attempt int,
+) {
+	followReq, err := u.state.DB.GetFollowRequest(ctx,
+		followerID,
+		targetAcct.ID,
+	)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf(ctx, "db error getting follow request: %v", err)
+		}
+
+		// Either confirmed,
+		// or no longer wanted.
+		return
 	}
 
-	for _, boost := range boosts {
-		if err := u.surface.deleteStatusFromTimelines(ctx, boost.ID); err != nil {
-			errs.Appendf("error deleting boost from timelines: %w", err)
-		}
-		if err := u.state.DB.DeleteStatusByID(ctx, boost.ID); err != nil {
-			errs.Appendf("error deleting boost: %w", err)
-		}
+	log.Infof(ctx, "resending unconfirmed follow of %s by %s (attempt %d)",
+		targetAcct.URI, followerID, attempt)
+
+	// Follow requests are sent as follows.
+	follow := u.federate.converter.FollowRequestToFollow(ctx, followReq)
+	if err := u.federate.Follow(ctx, follow); err != nil {
+		log.Errorf(ctx, "error federating follow: %v", err)
 	}
 
-	// delete this status from any and all timelines
-	if err := u.surface.deleteStatusFromTimelines(ctx, statusToDelete.ID); err != nil {
-		errs.Appendf("error deleting status from timelines: %w", err)
+	// Schedule next retry, if any.
+	u.scheduleMoveFollowRetry(ctx,
+		followerID,
+		targetAcct,
+		attempt+1,
+	)
+}
+
+// recordMoveEvent stores an event with the given
+// phase and details in the event log of the Move.
+// Errors are logged, but otherwise ignored, as the
+// event log should never get in the way of a Move.
+func (u *utils) recordMoveEvent(
+	ctx context.Context,
+	move *gtsmodel.Move,
+	phase gtsmodel.MoveEventPhase,
+	details string,
+) {
+	if err := u.state.DB.PutMoveEvent(ctx, &gtsmodel.MoveEvent{
+		ID:        id.NewULID(),
+		CreatedAt: time.Now(),
+		MoveID:    move.ID,
+		Phase:     phase,
+		Details:   details,
+	}); err != nil {
+		log.Errorf(ctx, "db error storing %s event for move %s: %v", phase, move.ID, err)
 	}
+}
 
-	// delete this status from any conversations that it's part of
-	if err := u.state.DB.DeleteStatusFromConversations(ctx, statusToDelete.ID); err != nil {
-		errs.Appendf("error deleting status from conversations: %w", err)
+// removeBlockedFaves removes any faves made by the
+// block target on the blocking account's statuses,
+// if this is enabled in the instance configuration.
+//
+// Fave counts are derived from the faves themselves,
+// so removing the faves also decrements the counts.
+func (u *utils) removeBlockedFaves(
+	ctx context.Context,
+	block *gtsmodel.Block,
+) error {
+	if !config.GetInstanceBlockRemoveFaves() {
+		// Not enabled.
+		return nil
 	}
 
-	// finally, delete the status itself
-	if err := u.state.DB.DeleteStatusByID(ctx, statusToDelete.ID); err != nil {
-		errs.Appendf("error deleting status: %w", err)
+	if err := u.state.DB.DeleteStatusFaves(ctx,
+		block.AccountID,
+		block.TargetAccountID,
+	); err != nil {
+		return gtserror.Newf("db error deleting faves: %w", err)
 	}
 
-	return errs.Combine()
+	return nil
 }
 
-// redirectFollowers redirects all local
-// followers of originAcct to targetAcct.
-//
-// Both accounts must be fully dereferenced
-// already, and the Move must be valid.
+// statOpKey returns a key identifying one stats update
+// (ie., increment or decrement) of the given column of
+// account's stats, made on behalf of the item with the
+// given target ID (eg., the status or follow counted),
+// as part of the operation whose ID is stored in ctx.
+// Returns empty string if no operation ID is set.
 //
-// Return bool will be true if all goes OK.
-func (u *utils) redirectFollowers(
+// Including the target ID means that several updates
+// of the same column within one operation, eg., when
+// one message wipes several statuses of an account,
+// are each applied, while still only being applied
+// once each should the operation be retried.
+func statOpKey(
 	ctx context.Context,
-	originAcct *gtsmodel.Account,
-	targetAcct *gtsmodel.Account,
-) bool {
-	// Any local followers of originAcct should
-	// send follow requests to targetAcct instead,
-	// and have followers of originAcct removed.
-	//
-	// Select local followers with barebones, since
-	// we only need follow.Account and we can get
-	// that ourselves.
-	followers, err := u.state.DB.GetAccountLocalFollowers(
-		gtscontext.SetBarebones(ctx),
-		originAcct.ID,
-	)
-	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		log.Errorf(ctx,
-			"db error getting follows targeting originAcct: %v",
-			err,
-		)
-		return false
+	update string,
+	account *gtsmodel.Account,
+	column string,
+	targetID string,
+) string {
+	opID := gtscontext.StatsOpID(ctx)
+	if opID == "" {
+		return ""
 	}
+	return opID + ":" + update + ":" + account.ID + ":" + column + ":" + targetID
+}
 
-	for _, follow := range followers {
-		// Fetch the local account that
-		// owns the follow targeting originAcct.
-		if follow.Account, err = u.state.DB.GetAccountByID(
-			gtscontext.SetBarebones(ctx),
-			follow.AccountID,
-		); err != nil {
-			log.Errorf(ctx,
-				"db error getting follow account %s: %v",
-				follow.AccountID, err,
-			)
-			return false
-		}
-
-		// Use the account processor FollowCreate
-		// function to send off the new follow,
-		// carrying over the Reblogs and Notify
-		// values from the old follow to the new.
-		//
-		// This will also handle cases where our
-		// account has already followed the target
-		// account, by just updating the existing
-		// follow of target account.
-		//
-		// Also, ensure new follow wouldn't be a
-		// self follow, since that will error.
-		if follow.AccountID != targetAcct.ID {
-			if _, err := u.account.FollowCreate(
-				ctx,
-				follow.Account,
-				&apimodel.AccountFollowRequest{
-					ID:      targetAcct.ID,
-					Reblogs: follow.ShowReblogs,
-					Notify:  follow.Notify,
-				},
-			); err != nil {
-				log.Errorf(ctx,
-					"error creating new follow for account %s: %v",
-					follow.AccountID, err,
-				)
-				return false
-			}
-		}
+// statOpApplied returns whether the stats update
+// identified by opKey was recently applied already.
+func (u *utils) statOpApplied(opKey string) bool {
+	return opKey != "" && u.statOps.Has(opKey)
+}
 
-		// New follow is in the process of
-		// sending, remove the existing follow.
-		// This will send out an Undo Activity for each Follow.
-		if _, err := u.account.FollowRemove(
-			ctx,
-			follow.Account,
-			follow.TargetAccountID,
-		); err != nil {
-			log.Errorf(ctx,
-				"error removing old follow for account %s: %v",
-				follow.AccountID, err,
-			)
-			return false
-		}
+// markStatOpApplied marks the stats update
+// identified by opKey as having been applied.
+func (u *utils) markStatOpApplied(opKey string) {
+	if opKey != "" {
+		u.statOps.Set(opKey, struct{}{})
 	}
-
-	return true
 }
 
 func (u *utils) incrementStatusesCount(
@@ -256,6 +1286,12 @@ func (u *utils) incrementStatusesCount(
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "increment", account, "statuses_count", status.ID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
@@ -263,8 +1299,13 @@ func (u *utils) incrementStatusesCount(
 
 	// Update stats by incrementing status
 	// count by one and setting last posted.
+	//
+	// Statuses may be processed out of order,
+	// so only ever move last posted forward.
 	*account.Stats.StatusesCount++
-	account.Stats.LastStatusAt = status.CreatedAt
+	if status.CreatedAt.After(account.Stats.LastStatusAt) {
+		account.Stats.LastStatusAt = status.CreatedAt
+	}
 	if err := u.state.DB.UpdateAccountStats(
 		ctx,
 		account.Stats,
@@ -274,27 +1315,110 @@ func (u *utils) incrementStatusesCount(
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
+	return nil
+}
+
+// recalculateAccountStats repairs stats of the given
+// account that have drifted from reality, eg., due to
+// increments or decrements being missed or clamped,
+// by overwriting them with freshly COUNTed values.
+func (u *utils) recalculateAccountStats(
+	ctx context.Context,
+	account *gtsmodel.Account,
+) error {
+	// Lock on this account since we're changing stats.
+	unlock := u.state.ProcessingLocks.Lock(account.URI)
+	defer unlock()
+
+	// Populate stats.
+	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
+		return gtserror.Newf("db error getting account stats: %w", err)
+	}
+
+	// Count stats fresh from the database,
+	// now no other stats updates can race.
+	counted, err := u.state.DB.CountAccountStats(ctx, account.ID)
+	if err != nil {
+		return gtserror.Newf("db error counting account stats: %w", err)
+	}
+
+	// Overwrite all counted stats.
+	account.Stats.FollowersCount = counted.FollowersCount
+	account.Stats.LocalFollowersCount = counted.LocalFollowersCount
+	account.Stats.RemoteFollowersCount = counted.RemoteFollowersCount
+	account.Stats.FollowingCount = counted.FollowingCount
+	account.Stats.FollowRequestsCount = counted.FollowRequestsCount
+	account.Stats.StatusesCount = counted.StatusesCount
+	account.Stats.StatusesPinnedCount = counted.StatusesPinnedCount
+	account.Stats.LastStatusAt = counted.LastStatusAt
+	account.Stats.RegeneratedAt = time.Now()
+
+	if err := u.state.DB.UpdateAccountStats(
+		ctx,
+		account.Stats,
+		"followers_count",
+		"local_followers_count",
+		"remote_followers_count",
+		"following_count",
+		"follow_requests_count",
+		"statuses_count",
+		"statuses_pinned_count",
+		"last_status_at",
+		"regenerated_at",
+	); err != nil {
+		return gtserror.Newf("db error updating account stats: %w", err)
+	}
+
 	return nil
 }
 
 func (u *utils) decrementStatusesCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	status *gtsmodel.Status,
+) error {
+	return u.decrementStatusesCountBy(ctx, account, 1, status.ID)
+}
+
+// decrementStatusesCountBy decrements the statuses count
+// of account by n in one go, taking the account's lock and
+// populating its stats only once. Use this instead of calling
+// decrementStatusesCount per status when wiping many at once.
+// The targetID identifies the statuses being counted, see
+// statOpKey.
+func (u *utils) decrementStatusesCountBy(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	n int,
+	targetID string,
 ) error {
+	if n <= 0 {
+		// Nothing
+		// to do.
+		return nil
+	}
+
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "decrement", account, "statuses_count", targetID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
 	}
 
 	// Update stats by decrementing
-	// status count by one.
+	// status count by n.
 	//
 	// Clamp to 0 to avoid funny business.
-	*account.Stats.StatusesCount--
+	*account.Stats.StatusesCount -= n
 	if *account.Stats.StatusesCount < 0 {
 		*account.Stats.StatusesCount = 0
 	}
@@ -306,44 +1430,72 @@ func (u *utils) decrementStatusesCount(
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
 	return nil
 }
 
+// incrementFollowersCount increments the followers count
+// of account by one, along with its local or remote
+// followers count depending on the locality of follower.
 func (u *utils) incrementFollowersCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	follower *gtsmodel.Account,
+	followID string,
 ) error {
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "increment", account, "followers_count", followID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
 	}
 
 	// Update stats by incrementing followers
-	// count by one and setting last posted.
+	// count by one, and the count matching
+	// the locality of the follower with it.
 	*account.Stats.FollowersCount++
+	localityCount, localityColumn := followersLocalityCount(account.Stats, follower)
+	*localityCount++
 	if err := u.state.DB.UpdateAccountStats(
 		ctx,
 		account.Stats,
 		"followers_count",
+		localityColumn,
 	); err != nil {
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
 	return nil
 }
 
+// decrementFollowersCount decrements the followers count
+// of account by one, along with its local or remote
+// followers count depending on the locality of follower.
 func (u *utils) decrementFollowersCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	follower *gtsmodel.Account,
+	followID string,
 ) error {
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "decrement", account, "followers_count", followID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
@@ -357,25 +1509,59 @@ func (u *utils) decrementFollowersCount(
 	if *account.Stats.FollowersCount < 0 {
 		*account.Stats.FollowersCount = 0
 	}
+	localityCount, localityColumn := followersLocalityCount(account.Stats, follower)
+	*localityCount--
+	if *localityCount < 0 {
+		*localityCount = 0
+	}
 	if err := u.state.DB.UpdateAccountStats(
 		ctx,
 		account.Stats,
 		"followers_count",
+		localityColumn,
 	); err != nil {
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
 	return nil
 }
 
+// followersLocalityCount returns a pointer to the
+// local or remote followers count of stats, as per
+// the locality of follower, along with its column.
+func followersLocalityCount(
+	stats *gtsmodel.AccountStats,
+	follower *gtsmodel.Account,
+) (*int, string) {
+	if follower.IsLocal() {
+		if stats.LocalFollowersCount == nil {
+			stats.LocalFollowersCount = util.Ptr(0)
+		}
+		return stats.LocalFollowersCount, "local_followers_count"
+	}
+
+	if stats.RemoteFollowersCount == nil {
+		stats.RemoteFollowersCount = util.Ptr(0)
+	}
+	return stats.RemoteFollowersCount, "remote_followers_count"
+}
+
 func (u *utils) incrementFollowingCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	followID string,
 ) error {
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "increment", account, "following_count", followID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
@@ -392,17 +1578,47 @@ func (u *utils) incrementFollowingCount(
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
 	return nil
 }
 
+// decrementFollowingCounts decrements the following
+// count of the (local) account owning each of the given
+// follows, eg., once follows targeting a deleted account
+// have been removed. Errors are logged, not returned, so
+// that one failure doesn't stop the remaining updates.
+func (u *utils) decrementFollowingCounts(
+	ctx context.Context,
+	follows []*gtsmodel.Follow,
+) {
+	for _, follow := range follows {
+		if follow.Account == nil {
+			// Follower account
+			// seems to have gone.
+			continue
+		}
+
+		if err := u.decrementFollowingCount(ctx, follow.Account, follow.ID); err != nil {
+			log.Errorf(ctx, "error decrementing following count of %s: %v", follow.AccountID, err)
+		}
+	}
+}
+
 func (u *utils) decrementFollowingCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	followID string,
 ) error {
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "decrement", account, "following_count", followID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
@@ -424,17 +1640,25 @@ func (u *utils) decrementFollowingCount(
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
 	return nil
 }
 
 func (u *utils) incrementFollowRequestsCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	followReqID string,
 ) error {
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "increment", account, "follow_requests_count", followReqID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
@@ -451,17 +1675,25 @@ func (u *utils) incrementFollowRequestsCount(
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
 	return nil
 }
 
 func (u *utils) decrementFollowRequestsCount(
 	ctx context.Context,
 	account *gtsmodel.Account,
+	followReqID string,
 ) error {
 	// Lock on this account since we're changing stats.
 	unlock := u.state.ProcessingLocks.Lock(account.URI)
 	defer unlock()
 
+	// Skip if this update was already applied.
+	opKey := statOpKey(ctx, "decrement", account, "follow_requests_count", followReqID)
+	if u.statOpApplied(opKey) {
+		return nil
+	}
+
 	// Populate stats.
 	if err := u.state.DB.PopulateAccountStats(ctx, account); err != nil {
 		return gtserror.Newf("db error getting account stats: %w", err)
@@ -483,15 +1715,127 @@ func (u *utils) decrementFollowRequestsCount(
 		return gtserror.Newf("db error updating account stats: %w", err)
 	}
 
+	u.markStatOpApplied(opKey)
+	return nil
+}
+
+// tooNewToInteract returns true if the given interacting
+// account is younger than the minimum account age for
+// interactions requiring approval, as set by the given
+// (local) interacted-with account.
+func (u *utils) tooNewToInteract(
+	ctx context.Context,
+	interacting *gtsmodel.Account,
+	interacted *gtsmodel.Account,
+) bool {
+	if interacting == nil || interacted == nil ||
+		interacted.IsRemote() {
+		// Can't / needn't check.
+		return false
+	}
+
+	if interacted.Settings == nil {
+		// Ensure settings are populated.
+		var err error
+		interacted.Settings, err = u.state.DB.GetAccountSettings(ctx, interacted.ID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting account settings: %v", err)
+			return false
+		}
+	}
+
+	days := interacted.Settings.InteractionMinAccountAgeDays
+	if days <= 0 {
+		// Not set.
+		return false
+	}
+
+	minAge := time.Duration(days) * 24 * time.Hour
+	return time.Since(interacting.CreatedAt) < minAge
+}
+
+// incApprovedInteractions increments the relevant
+// metrics counter for an approved interaction, based
+// on whether or not the interaction was approved
+// automatically, eg., by being pre-approved.
+func incApprovedInteractions(automatic bool) {
+	if automatic {
+		metrics.IncAutoApprovedInteractions()
+	} else {
+		metrics.IncManualApprovedInteractions()
+	}
+}
+
+// validateInteractionURI checks that the given URI of an
+// interaction to approve is a non-empty, absolute http(s)
+// URI with a host, returning an error of type
+// ApproveErrInvalidURI if not.
+func validateInteractionURI(uriStr string) error {
+	if uriStr == "" {
+		err := gtserror.New("interaction uri was empty")
+		return gtserror.WithType(err, ApproveErrInvalidURI)
+	}
+
+	uri, err := url.Parse(uriStr)
+	if err != nil {
+		err := gtserror.Newf("error parsing interaction uri %s: %w", uriStr, err)
+		return gtserror.WithType(err, ApproveErrInvalidURI)
+	}
+
+	if (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+		err := gtserror.Newf("interaction uri %s was not an absolute http(s) uri", uriStr)
+		return gtserror.WithType(err, ApproveErrInvalidURI)
+	}
+
 	return nil
 }
 
-// approveFave stores + returns an
-// interactionApproval for a fave.
+// putApproval stores the given interaction approval,
+// unless the interaction was pre-approved. Pre-approvals
+// are the common case (eg., replies by followed accounts),
+// and storing them would only clutter the table: should
+// their Accept be dereferenced, the approval is instead
+// synthesized from the interaction approved by it.
+//
+// Stored approvals are given an expiry, and scheduled
+// to expire, if the approving account has one set.
+func (u *utils) putApproval(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+	preApproved bool,
+) error {
+	if preApproved {
+		return nil
+	}
+
+	if err := u.setApprovalExpiry(ctx, approval); err != nil {
+		return err
+	}
+
+	if err := u.state.DB.PutInteractionApproval(ctx, approval); err != nil {
+		return gtserror.Newf("db error inserting interaction approval: %w", err)
+	}
+
+	if approval.Expires() {
+		if err := u.scheduleApprovalExpiry(ctx, approval); err != nil {
+			log.Errorf(ctx, "error scheduling approval expiry: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// approveFave approves the given fave, returning
+// its interactionApproval. See putApproval for
+// when the approval is (not) stored.
 func (u *utils) approveFave(
 	ctx context.Context,
 	fave *gtsmodel.StatusFave,
 ) (*gtsmodel.InteractionApproval, error) {
+	if err := validateInteractionURI(fave.URI); err != nil {
+		return nil, err
+	}
+
 	id := id.NewULID()
 
 	approval := &gtsmodel.InteractionApproval{
@@ -502,14 +1846,18 @@ func (u *utils) approveFave(
 		InteractingAccount:   fave.Account,
 		InteractionURI:       fave.URI,
 		InteractionType:      gtsmodel.InteractionLike,
-		URI:                  uris.GenerateURIForAccept(fave.TargetAccount.Username, id),
+		URI:                  uris.GenerateURIForAcceptByAccountID(fave.TargetAccountID, id),
 	}
 
-	if err := u.state.DB.PutInteractionApproval(ctx, approval); err != nil {
-		err := gtserror.Newf("db error inserting interaction approval: %w", err)
+	preApproved := fave.PreApproved
+	if err := u.putApproval(ctx, approval, preApproved); err != nil {
 		return nil, err
 	}
 
+	// Count the approval as either
+	// automatic or manual for metrics.
+	incApprovedInteractions(preApproved)
+
 	// Mark the fave itself as now approved.
 	fave.PendingApproval = util.Ptr(false)
 	fave.PreApproved = false
@@ -525,15 +1873,46 @@ func (u *utils) approveFave(
 		return nil, err
 	}
 
+	// Update approving account's pending count.
+	if err := u.surface.pendingInteractionsChanged(ctx, fave.TargetAccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
+	if !preApproved {
+		// Let the interacting account know
+		// their pending fave was approved.
+		if err := u.surface.notifyInteractionApproved(ctx, approval, fave.StatusID); err != nil {
+			log.Errorf(ctx, "error notifying interaction approved: %v", err)
+		}
+	}
+
 	return approval, nil
 }
 
-// approveReply stores + returns an
-// interactionApproval for a reply.
+// approveReply approves the given reply, returning
+// its interactionApproval. See putApproval for
+// when the approval is (not) stored.
 func (u *utils) approveReply(
 	ctx context.Context,
 	status *gtsmodel.Status,
 ) (*gtsmodel.InteractionApproval, error) {
+	return u.approveReplyWith(ctx, status, status.PreApproved)
+}
+
+// approveReplyWith approves the given reply as for
+// approveReply, counting the approval as automatic
+// rather than manual if automatic is true. Replies
+// approved automatically without being pre-approved,
+// eg., by cascading, still have their approval stored.
+func (u *utils) approveReplyWith(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	automatic bool,
+) (*gtsmodel.InteractionApproval, error) {
+	if err := validateInteractionURI(status.URI); err != nil {
+		return nil, err
+	}
+
 	id := id.NewULID()
 
 	approval := &gtsmodel.InteractionApproval{
@@ -544,19 +1923,21 @@ func (u *utils) approveReply(
 		InteractingAccount:   status.Account,
 		InteractionURI:       status.URI,
 		InteractionType:      gtsmodel.InteractionReply,
-		URI:                  uris.GenerateURIForAccept(status.InReplyToAccount.Username, id),
+		URI:                  uris.GenerateURIForAcceptByAccountID(status.InReplyToAccountID, id),
 	}
 
-	if err := u.state.DB.PutInteractionApproval(ctx, approval); err != nil {
-		err := gtserror.Newf("db error inserting interaction approval: %w", err)
+	if err := u.putApproval(ctx, approval, status.PreApproved); err != nil {
 		return nil, err
 	}
 
+	// Count the approval as either
+	// automatic or manual for metrics.
+	incApprovedInteractions(automatic)
+
 	// Mark the status itself as now approved.
 	status.PendingApproval = util.Ptr(false)
 	status.PreApproved = false
 	status.ApprovedByURI = approval.URI
-
 	if err := u.state.DB.UpdateStatus(
 		ctx,
 		status,
@@ -567,15 +1948,154 @@ func (u *utils) approveReply(
 		return nil, err
 	}
 
+	// Update approving account's pending count.
+	if err := u.surface.pendingInteractionsChanged(ctx, status.InReplyToAccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
+	if !automatic {
+		// Let the interacting account know
+		// their pending reply was approved.
+		if err := u.surface.notifyInteractionApproved(ctx, approval, status.ID); err != nil {
+			log.Errorf(ctx, "error notifying interaction approved: %v", err)
+		}
+
+		// Refresh the (local) author's
+		// own view of the approved reply.
+		if err := u.surface.timelineStatusForAuthor(ctx, status); err != nil {
+			log.Errorf(ctx, "error timelining status for author: %v", err)
+		}
+
+		// Reply was manually approved; approve
+		// any nested pending replies by same account.
+		u.cascadeApproveReplies(ctx, status)
+	}
+
 	return approval, nil
 }
 
-// approveAnnounce stores + returns an
-// interactionApproval for an announce.
+// cascadeApproveReplies approves pending replies nested
+// beneath the given (just approved) reply in its thread,
+// which are by the same account, and await approval from
+// the same account. The thread is descended no further
+// than the configured cascade depth, 0 disabling this.
+//
+// Each cascaded approval is processed as for a pre-approved
+// reply: Accepted, counted, timelined and federated. Unlike
+// a pre-approval though, the approval itself is stored.
+//
+// Only approvals by local accounts are cascaded, as we
+// can't approve replies on a remote account's behalf.
+func (u *utils) cascadeApproveReplies(
+	ctx context.Context,
+	reply *gtsmodel.Status,
+) {
+	maxDepth := config.GetInstanceInteractionApprovalCascadeDepth()
+	if maxDepth <= 0 {
+		// Disabled.
+		return
+	}
+
+	if reply.InReplyToAccount == nil {
+		// Approving account not set, fetch from the db.
+		var err error
+		reply.InReplyToAccount, err = u.state.DB.GetAccountByID(ctx, reply.InReplyToAccountID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting approving account: %v", err)
+			return
+		}
+	}
+
+	if !reply.InReplyToAccount.IsLocal() {
+		// Not ours
+		// to cascade.
+		return
+	}
+
+	parents := []*gtsmodel.Status{reply}
+
+	for depth := 0; depth < maxDepth && len(parents) > 0; depth++ {
+		var children []*gtsmodel.Status
+
+		for _, parent := range parents {
+			replies, err := u.state.DB.GetStatusReplies(ctx, parent.ID)
+			if err != nil {
+				log.Errorf(ctx, "db error getting replies to %s: %v", parent.ID, err)
+				continue
+			}
+			children = append(children, replies...)
+		}
+
+		for _, child := range children {
+			if child.AccountID != reply.AccountID ||
+				child.InReplyToAccountID != reply.InReplyToAccountID ||
+				!util.PtrOrValue(child.PendingApproval, false) {
+				// Not a pending reply by the same
+				// account, awaiting same approver.
+				continue
+			}
+
+			if err := u.cascadeApproveReply(ctx, child); err != nil {
+				log.Errorf(ctx, "error cascading approval to %s: %v", child.URI, err)
+			}
+		}
+
+		// Descend a level.
+		parents = children
+	}
+}
+
+// cascadeApproveReply approves the given pending
+// reply, and handles the same side effects as for
+// a pre-approved reply. See cascadeApproveReplies.
+func (u *utils) cascadeApproveReply(
+	ctx context.Context,
+	reply *gtsmodel.Status,
+) error {
+	// Cascaded approvals are automatic.
+	approval, err := u.approveReplyWith(ctx, reply, true)
+	if err != nil {
+		return err
+	}
+
+	// Send out the approval as Accept.
+	if err := u.federate.AcceptInteraction(ctx, approval); err != nil {
+		log.Errorf(ctx, "error federating approval of reply: %v", err)
+	}
+
+	// Update stats for the replying account.
+	if err := u.incrementStatusesCount(ctx, reply.Account, reply); err != nil {
+		log.Errorf(ctx, "error updating account stats: %v", err)
+	}
+
+	if err := u.surface.timelineAndNotifyStatus(ctx, reply); err != nil {
+		log.Errorf(ctx, "error timelining and notifying status: %v", err)
+	}
+
+	// Interaction counts changed on the replied status;
+	// uncache the prepared version from all timelines.
+	u.surface.invalidateStatusFromTimelines(ctx, reply.InReplyToID)
+
+	// Send out the reply fully,
+	// if it's one of ours.
+	if err := u.federate.CreateStatus(ctx, reply); err != nil {
+		log.Errorf(ctx, "error federating status: %v", err)
+	}
+
+	return nil
+}
+
+// approveAnnounce approves the given announce,
+// returning its interactionApproval. See putApproval
+// for when the approval is (not) stored.
 func (u *utils) approveAnnounce(
 	ctx context.Context,
 	boost *gtsmodel.Status,
 ) (*gtsmodel.InteractionApproval, error) {
+	if err := validateInteractionURI(boost.URI); err != nil {
+		return nil, err
+	}
+
 	id := id.NewULID()
 
 	approval := &gtsmodel.InteractionApproval{
@@ -585,15 +2105,19 @@ func (u *utils) approveAnnounce(
 		InteractingAccountID: boost.AccountID,
 		InteractingAccount:   boost.Account,
 		InteractionURI:       boost.URI,
-		InteractionType:      gtsmodel.InteractionReply,
-		URI:                  uris.GenerateURIForAccept(boost.BoostOfAccount.Username, id),
+		InteractionType:      gtsmodel.InteractionAnnounce,
+		URI:                  uris.GenerateURIForAcceptByAccountID(boost.BoostOfAccountID, id),
 	}
 
-	if err := u.state.DB.PutInteractionApproval(ctx, approval); err != nil {
-		err := gtserror.Newf("db error inserting interaction approval: %w", err)
+	preApproved := boost.PreApproved
+	if err := u.putApproval(ctx, approval, preApproved); err != nil {
 		return nil, err
 	}
 
+	// Count the approval as either
+	// automatic or manual for metrics.
+	incApprovedInteractions(preApproved)
+
 	// Mark the status itself as now approved.
 	boost.PendingApproval = util.Ptr(false)
 	boost.PreApproved = false
@@ -609,5 +2133,18 @@ func (u *utils) approveAnnounce(
 		return nil, err
 	}
 
+	// Update approving account's pending count.
+	if err := u.surface.pendingInteractionsChanged(ctx, boost.BoostOfAccountID); err != nil {
+		log.Errorf(ctx, "error updating pending interactions count: %v", err)
+	}
+
+	if !preApproved {
+		// Let the interacting account know
+		// their pending boost was approved.
+		if err := u.surface.notifyInteractionApproved(ctx, approval, boost.BoostOfID); err != nil {
+			log.Errorf(ctx, "error notifying interaction approved: %v", err)
+		}
+	}
+
 	return approval, nil
 }
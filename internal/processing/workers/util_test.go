@@ -0,0 +1,1044 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/workers"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"github.com/uptrace/bun"
+)
+
+type WipeStatusTestSuite struct {
+	WorkersTestSuite
+}
+
+// statusRelation describes rows in one database
+// table which relate to a status, and which must
+// all be gone once that status has been wiped.
+type statusRelation struct {
+	// name of the relation, for
+	// more helpful test failures.
+	name string
+
+	// rows returns a pointer to an
+	// empty slice of the table's model.
+	rows func() any
+
+	// key and value select
+	// the related rows.
+	key   string
+	value any
+}
+
+// seedStatusRelations puts a row in the database for every
+// kind of relation that the given (local, polled) status can
+// have, and returns a statusRelation describing each of them.
+//
+// When adding a new table that relates to statuses, add it
+// here, so that TestWipeStatusLeavesNoRelations ensures
+// that wipeStatus cleans it up.
+//
+// Note: pins are stored on the status row itself.
+func (suite *WipeStatusTestSuite) seedStatusRelations(
+	ctx context.Context,
+	state *state.State,
+	status *gtsmodel.Status,
+) []statusRelation {
+	var (
+		interacter = suite.testAccounts["admin_account"]
+		attachment = new(gtsmodel.MediaAttachment)
+	)
+
+	// Attach a previously unattached
+	// attachment, and add emojis, tags,
+	// a thread, and pin the status.
+	*attachment = *suite.testAttachments["local_account_1_unattached_1"]
+	thread := &gtsmodel.Thread{ID: id.NewULID()}
+	if err := state.DB.PutThread(ctx, thread); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status.AttachmentIDs = []string{attachment.ID}
+	status.Attachments = []*gtsmodel.MediaAttachment{attachment}
+	status.EmojiIDs = []string{suite.testEmojis["rainbow"].ID}
+	status.TagIDs = []string{suite.testTags["welcome"].ID}
+	status.ThreadID = thread.ID
+	status.PinnedAt = time.Now()
+
+	// Mention another account.
+	mention := &gtsmodel.Mention{
+		ID:               id.NewULID(),
+		StatusID:         status.ID,
+		OriginAccountID:  status.AccountID,
+		OriginAccountURI: status.AccountURI,
+		TargetAccountID:  interacter.ID,
+	}
+	if err := state.DB.PutMention(ctx, mention); err != nil {
+		suite.FailNow(err.Error())
+	}
+	status.MentionIDs = []string{mention.ID}
+
+	if err := state.DB.UpdateStatus(ctx, status,
+		"attachment_ids",
+		"emoji_ids",
+		"tag_ids",
+		"thread_id",
+		"pinned_at",
+		"mention_ids",
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Fave the status.
+	faveID := id.NewULID()
+	fave := &gtsmodel.StatusFave{
+		ID:              faveID,
+		AccountID:       interacter.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		URI:             interacter.URI + "/liked/" + faveID,
+	}
+	if err := state.DB.PutStatusFave(ctx, fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Boost the status.
+	boost := new(gtsmodel.Status)
+	*boost = *suite.testStatuses["admin_account_status_4"]
+	boost.ID = id.NewULID()
+	boost.URI = interacter.URI + "/statuses/" + boost.ID
+	boost.URL = ""
+	boost.BoostOfID = status.ID
+	boost.BoostOfURI = status.URI
+	boost.BoostOfAccountID = status.AccountID
+	if err := state.DB.PutStatus(ctx, boost); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Bookmark the status.
+	if err := state.DB.PutStatusBookmark(ctx, &gtsmodel.StatusBookmark{
+		ID:              id.NewULID(),
+		AccountID:       interacter.ID,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Notify of the fave.
+	if err := state.DB.PutNotification(ctx, &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationFave,
+		TargetAccountID:  status.AccountID,
+		OriginAccountID:  interacter.ID,
+		StatusID:         status.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Put the status in a conversation.
+	conversation := &gtsmodel.Conversation{
+		ID:               id.NewULID(),
+		AccountID:        interacter.ID,
+		OtherAccountIDs:  []string{status.AccountID},
+		OtherAccountsKey: gtsmodel.ConversationOtherAccountsKey([]string{status.AccountID}),
+		ThreadID:         thread.ID,
+		LastStatusID:     status.ID,
+	}
+	if err := state.DB.UpsertConversation(ctx, conversation); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := state.DB.LinkConversationToStatus(ctx, status.ID, conversation.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Approve the status itself, fave and
+	// boost, and record rejections of them.
+	var approvalURIs []string
+	for _, interaction := range []struct {
+		accountID string
+		uri       string
+		t         gtsmodel.InteractionType
+	}{
+		{status.AccountID, status.URI, gtsmodel.InteractionReply},
+		{fave.AccountID, fave.URI, gtsmodel.InteractionLike},
+		{boost.AccountID, boost.URI, gtsmodel.InteractionAnnounce},
+	} {
+		approvalID := id.NewULID()
+		if err := state.DB.PutInteractionApproval(ctx, &gtsmodel.InteractionApproval{
+			ID:                   approvalID,
+			AccountID:            status.AccountID,
+			InteractingAccountID: interaction.accountID,
+			InteractionURI:       interaction.uri,
+			InteractionType:      interaction.t,
+			URI:                  uris.GenerateURIForAccept(status.Account.Username, approvalID),
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+		if err := state.DB.PutInteractionRejection(ctx, &gtsmodel.InteractionRejection{
+			ID:                   id.NewULID(),
+			AccountID:            status.AccountID,
+			InteractingAccountID: interaction.accountID,
+			InteractionURI:       interaction.uri,
+			InteractionType:      interaction.t,
+			Reason:               gtsmodel.InteractionReasonSpam,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+		approvalURIs = append(approvalURIs, interaction.uri)
+	}
+
+	relations := []statusRelation{
+		{"status", func() any { return &[]*gtsmodel.Status{} }, "id", status.ID},
+		{"attachments", func() any { return &[]*gtsmodel.MediaAttachment{} }, "status_id", status.ID},
+		{"mentions", func() any { return &[]*gtsmodel.Mention{} }, "status_id", status.ID},
+		{"emojis", func() any { return &[]*gtsmodel.StatusToEmoji{} }, "status_id", status.ID},
+		{"tags", func() any { return &[]*gtsmodel.StatusToTag{} }, "status_id", status.ID},
+		{"threads", func() any { return &[]*gtsmodel.ThreadToStatus{} }, "status_id", status.ID},
+		{"poll", func() any { return &[]*gtsmodel.Poll{} }, "status_id", status.ID},
+		{"poll votes", func() any { return &[]*gtsmodel.PollVote{} }, "poll_id", status.PollID},
+		{"faves", func() any { return &[]*gtsmodel.StatusFave{} }, "status_id", status.ID},
+		{"boosts", func() any { return &[]*gtsmodel.Status{} }, "boost_of_id", status.ID},
+		{"bookmarks", func() any { return &[]*gtsmodel.StatusBookmark{} }, "status_id", status.ID},
+		{"notifications", func() any { return &[]*gtsmodel.Notification{} }, "status_id", status.ID},
+		{"conversations", func() any { return &[]*gtsmodel.Conversation{} }, "last_status_id", status.ID},
+		{"conversation statuses", func() any { return &[]*gtsmodel.ConversationToStatus{} }, "status_id", status.ID},
+	}
+
+	for _, uri := range approvalURIs {
+		relations = append(relations, statusRelation{
+			"approvals of " + uri,
+			func() any { return &[]*gtsmodel.InteractionApproval{} },
+			"interaction_uri", uri,
+		}, statusRelation{
+			"rejections of " + uri,
+			func() any { return &[]*gtsmodel.InteractionRejection{} },
+			"interaction_uri", uri,
+		})
+	}
+
+	return relations
+}
+
+// countRelation returns the number of
+// rows currently present for relation.
+func (suite *WipeStatusTestSuite) countRelation(
+	ctx context.Context,
+	state *state.State,
+	relation statusRelation,
+) int {
+	rows := relation.rows()
+	if err := state.DB.GetWhere(ctx,
+		[]db.Where{{Key: relation.key, Value: relation.value}},
+		rows,
+	); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	return reflect.ValueOf(rows).Elem().Len()
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusLeavesNoRelations() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	relations := suite.seedStatusRelations(ctx,
+		testStructs.State,
+		deletedStatus,
+	)
+
+	// Every relation should be seeded,
+	// else this test proves nothing.
+	for _, relation := range relations {
+		if suite.countRelation(ctx, testStructs.State, relation) == 0 {
+			suite.FailNow("relation not seeded: " + relation.name)
+		}
+	}
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Nothing relating to the
+	// status should remain.
+	for _, relation := range relations {
+		suite.Zero(
+			suite.countRelation(ctx, testStructs.State, relation),
+			"relation not wiped: "+relation.name,
+		)
+	}
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusOrderFKSafe() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+		order           = new(workers.WipeOrder)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	suite.seedStatusRelations(ctx,
+		testStructs.State,
+		deletedStatus,
+	)
+
+	// Process the status delete,
+	// recording the wipe order.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		workers.WithWipeOrder(ctx, order),
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Each seeded relation should
+	// have been wiped, status last.
+	steps := order.Steps()
+	for _, step := range []string{
+		workers.WipeStepAttachments,
+		workers.WipeStepMentions,
+		workers.WipeStepNotifications,
+		workers.WipeStepBookmarks,
+		workers.WipeStepApprovals,
+		workers.WipeStepRejections,
+		workers.WipeStepFaves,
+		workers.WipeStepPollVotes,
+		workers.WipeStepPoll,
+		workers.WipeStepBoosts,
+		workers.WipeStepBoostTimelines,
+		workers.WipeStepTimelines,
+		workers.WipeStepConversationStatuses,
+	} {
+		suite.Contains(steps, step)
+	}
+	suite.Equal(workers.WipeStepStatus, steps[len(steps)-1])
+
+	// And nothing should have been wiped
+	// while something still pointed to it.
+	suite.NoError(order.Verify())
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusBoostTimelinesFirst() {
+	for _, threshold := range []int{
+		0, // Never defer.
+		1, // Zork has 2 followers, so defer.
+	} {
+		suite.Run(fmt.Sprintf("threshold %d", threshold), func() {
+			testStructs := suite.SetupTestStructs()
+			defer suite.TearDownTestStructs(testStructs)
+
+			var (
+				ctx             = context.Background()
+				deletingAccount = suite.testAccounts["local_account_1"]
+				deletedStatus   = new(gtsmodel.Status)
+				order           = new(workers.WipeOrder)
+			)
+
+			config.SetStatusesTimelineRemovalDeferThreshold(threshold)
+
+			*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+			deletedStatus.Account = deletingAccount
+
+			// Seeds a boost of the status.
+			suite.seedStatusRelations(ctx,
+				testStructs.State,
+				deletedStatus,
+			)
+
+			if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+				workers.WithWipeOrder(ctx, order),
+				&messages.FromClientAPI{
+					APObjectType:   ap.ObjectNote,
+					APActivityType: ap.ActivityDelete,
+					GTSModel:       deletedStatus,
+					Origin:         deletingAccount,
+				},
+			); err != nil {
+				suite.FailNow(err.Error())
+			}
+
+			// All boosts should be removed from
+			// timelines before the original is.
+			steps := order.Steps()
+			boostTimelines := slices.Index(steps, workers.WipeStepBoostTimelines)
+			timelines := slices.Index(steps, workers.WipeStepTimelines)
+			suite.NotEqual(-1, boostTimelines)
+			suite.Less(boostTimelines, timelines)
+			suite.NoError(order.Verify())
+		})
+	}
+}
+
+func (suite *WipeStatusTestSuite) TestWipePollStatusClearsPollNotifications() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		voter           = suite.testAccounts["local_account_2"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	// Poll notifications are
+	// keyed on the poll's status,
+	// both for the poll author
+	// and for everyone who voted.
+	var notifIDs []string
+	for _, targetID := range []string{
+		deletingAccount.ID,
+		voter.ID,
+	} {
+		notif := &gtsmodel.Notification{
+			ID:               id.NewULID(),
+			NotificationType: gtsmodel.NotificationPoll,
+			TargetAccountID:  targetID,
+			OriginAccountID:  deletingAccount.ID,
+			StatusID:         deletedStatus.ID,
+		}
+		if err := testStructs.State.DB.PutNotification(ctx, notif); err != nil {
+			suite.FailNow(err.Error())
+		}
+		notifIDs = append(notifIDs, notif.ID)
+	}
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// All poll notifications
+	// should have been removed.
+	for _, notifID := range notifIDs {
+		_, err := testStructs.State.DB.GetNotificationByID(ctx, notifID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	// And so should the votes.
+	votes, err := testStructs.State.DB.GetPollVotes(ctx, deletedStatus.PollID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(votes)
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusOwnerMismatch() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		owningAccount   = suite.testAccounts["local_account_1"]
+		deletingAccount = suite.testAccounts["local_account_2"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = owningAccount
+
+	relations := suite.seedStatusRelations(ctx,
+		testStructs.State,
+		deletedStatus,
+	)
+
+	// Process a mis-routed status delete,
+	// claiming the status is owned by
+	// a different account than its owner.
+	err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	)
+	suite.True(gtserror.HasType(err, workers.WipeErrOwnerMismatch))
+
+	// The wipe should have been aborted,
+	// leaving everything relating to the
+	// status in place.
+	for _, relation := range relations {
+		suite.NotZero(
+			suite.countRelation(ctx, testStructs.State, relation),
+			"relation wiped: "+relation.name,
+		)
+	}
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusVetoed() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+		errVeto         = errors.New("status is on legal hold")
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	relations := suite.seedStatusRelations(ctx,
+		testStructs.State,
+		deletedStatus,
+	)
+
+	// Register a hook vetoing
+	// the wipe of this status.
+	var called int
+	unregister := testStructs.Processor.Workers().RegisterBeforeWipeHook(
+		func(_ context.Context, status *gtsmodel.Status) error {
+			called++
+			if status.ID == deletedStatus.ID {
+				return errVeto
+			}
+			return nil
+		},
+	)
+	defer unregister()
+
+	// Process the status delete.
+	err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	)
+	suite.Equal(1, called)
+	suite.ErrorIs(err, errVeto)
+	suite.True(gtserror.HasType(err, workers.WipeErrVetoed))
+
+	// The status and everything relating
+	// to it should have been left in place.
+	_, err = testStructs.State.DB.GetStatusByID(ctx, deletedStatus.ID)
+	suite.NoError(err)
+
+	for _, relation := range relations {
+		suite.NotZero(
+			suite.countRelation(ctx, testStructs.State, relation),
+			"relation wiped: "+relation.name,
+		)
+	}
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusHookUnregistered() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	// Register a veto hook, then
+	// unregister it straight away.
+	unregister := testStructs.Processor.Workers().RegisterBeforeWipeHook(
+		func(context.Context, *gtsmodel.Status) error {
+			return errors.New("vetoed")
+		},
+	)
+	unregister()
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The status should be gone.
+	_, err := testStructs.State.DB.GetStatusByID(ctx, deletedStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusRollsBackMarkers() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		markerAccount   = suite.testAccounts["local_account_2"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_6"]
+	deletedStatus.Account = deletingAccount
+
+	// Get the status preceding the deleted
+	// status in the marker owner's home timeline,
+	// which the marker should be rolled back to.
+	prev, err := testStructs.State.DB.GetHomeTimeline(ctx,
+		markerAccount.ID,
+		deletedStatus.ID, "", "", 1, false,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if len(prev) == 0 {
+		suite.FailNow("no status before deleted status in home timeline")
+	}
+
+	// Mark the deleted status as last read.
+	if err := testStructs.State.DB.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  markerAccount.ID,
+		Name:       gtsmodel.MarkerNameHome,
+		LastReadID: deletedStatus.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Marker should now point at
+	// the preceding status instead.
+	marker, err := testStructs.State.DB.GetMarker(ctx,
+		markerAccount.ID,
+		gtsmodel.MarkerNameHome,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(prev[0].ID, marker.LastReadID)
+}
+
+func (suite *WipeStatusTestSuite) TestWipeReplyFederatesParentUpdate() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	config.SetStatusesReplyDeleteParentUpdate(true)
+	config.SetStatusesReplyDeleteUpdateDelay(500 * time.Millisecond)
+
+	var (
+		ctx            = context.Background()
+		parent         = suite.testStatuses["local_account_1_status_1"]
+		replyingAcct   = suite.testAccounts["admin_account"]
+		remoteFollower = suite.testAccounts["remote_account_1"]
+		reply1         = new(gtsmodel.Status)
+		reply2         = new(gtsmodel.Status)
+	)
+
+	// Give the parent author a remote
+	// follower to deliver the Update to.
+	if err := testStructs.State.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01JBR0T8Y2GW5R8B8E0W3PZ9TQ",
+		URI:             remoteFollower.URI + "/follows/01JBR0T8Y2GW5R8B8E0W3PZ9TQ",
+		AccountID:       remoteFollower.ID,
+		TargetAccountID: parent.AccountID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// First reply is an existing
+	// reply to the parent status.
+	*reply1 = *suite.testStatuses["admin_account_status_3"]
+	reply1.Account = replyingAcct
+	suite.Equal(parent.ID, reply1.InReplyToID)
+
+	// Second reply is a copy of the first.
+	*reply2 = *reply1
+	reply2.ID = "01JBR0V4J3Q5SXK5QH6C0E2N4M"
+	reply2.URI = replyingAcct.URI + "/statuses/" + reply2.ID
+	reply2.URL = reply2.URI
+	reply2.AttachmentIDs = nil
+	reply2.Attachments = nil
+	reply2.MentionIDs = nil
+	reply2.Mentions = nil
+	if err := testStructs.State.DB.PutStatus(ctx, reply2); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Delete both replies in quick succession.
+	for _, reply := range []*gtsmodel.Status{reply1, reply2} {
+		if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+			ctx,
+			&messages.FromClientAPI{
+				APObjectType:   ap.ObjectNote,
+				APActivityType: ap.ActivityDelete,
+				GTSModel:       reply,
+				Origin:         replyingAcct,
+			},
+		); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Collect Updates of the parent queued
+	// for delivery until past the delay.
+	var updates int
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		delivery, ok := testStructs.State.Workers.Delivery.Queue.Pop()
+		if !ok {
+			time.Sleep(50 * time.Millisecond)
+			continue
+		}
+
+		b, err := io.ReadAll(delivery.Request.Body)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		if strings.Contains(string(b), `"type":"Update"`) &&
+			strings.Contains(string(b), `"id":"`+parent.URI+`"`) {
+			updates++
+		}
+	}
+
+	// Both deletes should be coalesced into one Update.
+	suite.Equal(1, updates)
+}
+
+func (suite *WipeStatusTestSuite) TestWipeReplyNoParentUpdateByDefault() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx          = context.Background()
+		replyingAcct = suite.testAccounts["admin_account"]
+		reply        = new(gtsmodel.Status)
+	)
+
+	*reply = *suite.testStatuses["admin_account_status_3"]
+	reply.Account = replyingAcct
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       reply,
+			Origin:         replyingAcct,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// No parent Update should have been scheduled.
+	suite.False(testStructs.State.Workers.Scheduler.Cancel(
+		workers.ParentUpdateID(reply.InReplyToID),
+	))
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusCancelsScheduledTasks() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		deletedStatus   = new(gtsmodel.Status)
+		otherStatus     = suite.testStatuses["local_account_1_status_2"]
+		scheduler       = &testStructs.State.Workers.Scheduler
+		later           = time.Now().Add(time.Hour)
+		noop            = func(context.Context, time.Time) {}
+	)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	// Schedule a few tasks tied to the
+	// status, and one tied to another.
+	suite.True(scheduler.AddOnce(workers.StatusExpiryID(deletedStatus.ID), later, noop))
+	suite.True(scheduler.AddOnce(workers.ParentUpdateID(deletedStatus.ID), later, noop))
+	suite.True(scheduler.AddOnce(workers.StatusExpiryID(otherStatus.ID), later, noop))
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The deleted status' tasks should be
+	// gone, the other status' task kept.
+	suite.False(scheduler.Cancel(workers.StatusExpiryID(deletedStatus.ID)))
+	suite.False(scheduler.Cancel(workers.ParentUpdateID(deletedStatus.ID)))
+	suite.True(scheduler.Cancel(workers.StatusExpiryID(otherStatus.ID)))
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusRemovesFromTagTimeline() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["admin_account"]
+		deletedStatus   = new(gtsmodel.Status)
+		tag             = suite.testTags["welcome"]
+	)
+
+	*deletedStatus = *suite.testStatuses["admin_account_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	// tagTimelineIDs returns IDs of
+	// statuses in the tag's timeline.
+	tagTimelineIDs := func() []string {
+		statuses, err := testStructs.State.DB.GetTagTimeline(ctx, tag.ID, "", "", "", 20)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		ids := make([]string, 0, len(statuses))
+		for _, status := range statuses {
+			ids = append(ids, status.ID)
+		}
+		return ids
+	}
+
+	// The status is tagged #welcome,
+	// so it's in the tag's timeline.
+	suite.Contains(tagTimelineIDs(), deletedStatus.ID)
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// It should have left the tag's timeline.
+	suite.NotContains(tagTimelineIDs(), deletedStatus.ID)
+
+	// And its tag association should be gone with it,
+	// not just hidden by the missing status row.
+	var links int
+	if err := testStructs.State.DB.(*bundb.DBService).DB().
+		NewSelect().
+		Model((*gtsmodel.StatusToTag)(nil)).
+		ColumnExpr("COUNT(*)").
+		Where("? = ?", bun.Ident("status_id"), deletedStatus.ID).
+		Scan(ctx, &links); // nocollapse
+	err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(links)
+
+	// The tag itself should be kept.
+	if _, err := testStructs.State.DB.GetTag(ctx, tag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *WipeStatusTestSuite) TestWipePopularStatusDefersTimelines() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		follower        = suite.testAccounts["admin_account"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	// Zork has 2 followers,
+	// so this is "popular".
+	config.SetStatusesTimelineRemovalDeferThreshold(1)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	// Put the status in a follower's home timeline.
+	timelined, err := testStructs.State.Timelines.Home.IngestOne(ctx,
+		follower.ID,
+		deletedStatus,
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(timelined)
+
+	indexed := func() int {
+		return testStructs.State.Timelines.Home.GetIndexedLength(ctx, follower.ID)
+	}
+	suite.Equal(1, indexed())
+
+	// Hold background processing,
+	// so removal can't happen yet.
+	testStructs.State.Workers.Processing.Stop()
+
+	// Process the status delete.
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Status should be gone,
+	// but still timelined.
+	_, err = testStructs.State.DB.GetStatusByID(ctx, deletedStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Equal(1, indexed())
+
+	// Resume background processing, timeline
+	// removal should now eventually happen.
+	testStructs.State.Workers.Processing.Start(1)
+	if !testrig.WaitFor(func() bool {
+		return indexed() == 0
+	}) {
+		suite.FailNow("timed out waiting for timeline removal")
+	}
+}
+
+func (suite *WipeStatusTestSuite) TestWipeStatusTimelinesNotDeferredBelowThreshold() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx             = context.Background()
+		deletingAccount = suite.testAccounts["local_account_1"]
+		follower        = suite.testAccounts["admin_account"]
+		deletedStatus   = new(gtsmodel.Status)
+	)
+
+	// Zork has just 2 followers.
+	config.SetStatusesTimelineRemovalDeferThreshold(10)
+
+	*deletedStatus = *suite.testStatuses["local_account_1_status_1"]
+	deletedStatus.Account = deletingAccount
+
+	if _, err := testStructs.State.Timelines.Home.IngestOne(ctx,
+		follower.ID,
+		deletedStatus,
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Without background processing, removal
+	// must happen as part of the delete.
+	testStructs.State.Workers.Processing.Stop()
+
+	if err := testStructs.Processor.Workers().ProcessFromClientAPI(
+		ctx,
+		&messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityDelete,
+			GTSModel:       deletedStatus,
+			Origin:         deletingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Zero(testStructs.State.Timelines.Home.GetIndexedLength(ctx, follower.ID))
+}
+
+func TestWipeStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(WipeStatusTestSuite))
+}
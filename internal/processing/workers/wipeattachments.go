@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// WipeStatusAttachmentsBySize reclaims storage from the given status,
+// without deleting it, by removing the original files of remote attachments
+// larger than size bytes. Their thumbnails are kept, and they stay
+// attached to the status, but are marked as no longer cached, so they're
+// recached from the remote if needed again. Smaller attachments, and local
+// attachments, which have no remote copy to recache from, are left intact.
+// Returns the number of attachments whose files were removed.
+//
+// This gives moderators a middle ground between wiping the status
+// with all its media, and keeping everything.
+func (p *Processor) WipeStatusAttachmentsBySize(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	size int,
+) (int, error) {
+	return p.clientAPI.utils.wipeStatusAttachmentsBySize(ctx, status, size)
+}
+
+// wipeStatusAttachmentsBySize: see WipeStatusAttachmentsBySize.
+func (u *utils) wipeStatusAttachmentsBySize(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	size int,
+) (int, error) {
+	if size < 0 {
+		return 0, gtserror.Newf("invalid size threshold %d", size)
+	}
+
+	attachments, err := u.state.DB.GetAttachmentsByIDs(ctx, status.AttachmentIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return 0, gtserror.Newf("db error getting attachments of status %s: %w", status.ID, err)
+	}
+
+	var (
+		wiped int
+		errs  gtserror.MultiError
+	)
+
+	for _, attachment := range attachments {
+		if attachment.IsLocal() {
+			// We're the only copy
+			// of local media, keep.
+			continue
+		}
+
+		if !util.PtrOrZero(attachment.Cached) ||
+			attachment.File.FileSize <= size {
+			// Nothing to reclaim, or
+			// small enough to keep.
+			continue
+		}
+
+		// Remove only the original file, keeping
+		// the thumbnail for clients to show instead.
+		if err := u.state.Storage.Delete(ctx, attachment.File.Path); // nocollapse
+		err != nil && !storage.IsNotFound(err) {
+			errs.Appendf("error removing file of attachment %s: %w", attachment.ID, err)
+			continue
+		}
+
+		// Mark as uncached, as the file is gone.
+		attachment.Cached = util.Ptr(false)
+		if err := u.state.DB.UpdateAttachment(ctx, attachment, "cached"); err != nil {
+			errs.Appendf("db error updating attachment %s: %w", attachment.ID, err)
+			continue
+		}
+
+		wiped++
+	}
+
+	return wiped, errs.Combine()
+}
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WipeAttachmentsTestSuite struct {
+	WorkersTestSuite
+}
+
+func (suite *WipeAttachmentsTestSuite) TestWipeStatusAttachmentsBySize() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx      = context.Background()
+		status   = suite.testStatuses["remote_account_2_status_1"]
+		large    = suite.testAttachments["remote_account_2_status_1_attachment_1"] // ~5.4MB jpeg
+		uncached = suite.testAttachments["remote_account_2_status_1_attachment_2"] // not cached
+	)
+
+	wiped, err := testStructs.Processor.Workers().WipeStatusAttachmentsBySize(ctx, status, 1500000)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, wiped)
+
+	// The large attachment's original should be
+	// gone, but its thumbnail and row are kept,
+	// still attached, and marked as uncached.
+	suite.False(suite.has(testStructs, large.File.Path))
+	suite.True(suite.has(testStructs, large.Thumbnail.Path))
+
+	dbLarge, err := testStructs.State.DB.GetAttachmentByID(ctx, large.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*dbLarge.Cached)
+	suite.Equal(status.ID, dbLarge.StatusID)
+
+	// The already uncached attachment
+	// should still be attached.
+	dbUncached, err := testStructs.State.DB.GetAttachmentByID(ctx, uncached.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(status.ID, dbUncached.StatusID)
+
+	// The status itself should remain,
+	// with all attachments still on it.
+	dbStatus, err := testStructs.State.DB.GetStatusByID(ctx, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(status.AttachmentIDs, dbStatus.AttachmentIDs)
+
+	// Running again should find nothing
+	// left to reclaim above the threshold.
+	wiped, err = testStructs.Processor.Workers().WipeStatusAttachmentsBySize(ctx, status, 1500000)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(wiped)
+}
+
+func (suite *WipeAttachmentsTestSuite) TestWipeStatusAttachmentsBySizeSmall() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx    = context.Background()
+		status = suite.testStatuses["remote_account_1_status_1"]
+		small  = suite.testAttachments["remote_account_1_status_1_attachment_1"] // ~19KB jpeg
+	)
+
+	wiped, err := testStructs.Processor.Workers().WipeStatusAttachmentsBySize(ctx, status, 1500000)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(wiped)
+
+	// The small attachment should be untouched.
+	suite.True(suite.has(testStructs, small.File.Path))
+	suite.True(suite.has(testStructs, small.Thumbnail.Path))
+
+	dbSmall, err := testStructs.State.DB.GetAttachmentByID(ctx, small.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbSmall.Cached)
+}
+
+func (suite *WipeAttachmentsTestSuite) TestWipeStatusAttachmentsBySizeLocal() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	var (
+		ctx    = context.Background()
+		status = suite.testStatuses["local_account_1_status_4"]
+		large  = suite.testAttachments["local_account_1_status_4_attachment_2"] // ~2.3MB jpeg
+	)
+
+	// Local media has no remote copy
+	// to recache from, so is kept.
+	wiped, err := testStructs.Processor.Workers().WipeStatusAttachmentsBySize(ctx, status, 1500000)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Zero(wiped)
+
+	suite.True(suite.has(testStructs, large.File.Path))
+
+	dbLarge, err := testStructs.State.DB.GetAttachmentByID(ctx, large.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*dbLarge.Cached)
+}
+
+func (suite *WipeAttachmentsTestSuite) TestWipeStatusAttachmentsBySizeInvalid() {
+	testStructs := suite.SetupTestStructs()
+	defer suite.TearDownTestStructs(testStructs)
+
+	_, err := testStructs.Processor.Workers().WipeStatusAttachmentsBySize(
+		context.Background(),
+		suite.testStatuses["local_account_1_status_4"],
+		-1,
+	)
+	suite.ErrorContains(err, "invalid size threshold -1")
+}
+
+func (suite *WipeAttachmentsTestSuite) has(testStructs *TestStructs, path string) bool {
+	ok, err := testStructs.State.Storage.Has(context.Background(), path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return ok
+}
+
+func TestWipeAttachmentsTestSuite(t *testing.T) {
+	suite.Run(t, new(WipeAttachmentsTestSuite))
+}
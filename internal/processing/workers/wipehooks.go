@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// BeforeWipeHook is called with a status that is
+// about to be wiped, before anything relating to
+// it has been deleted. Returning an error vetoes
+// the wipe, which is then aborted with that error.
+type BeforeWipeHook func(ctx context.Context, status *gtsmodel.Status) error
+
+// wipeHooks is a registry of BeforeWipeHooks,
+// safe for concurrent use. The zero value
+// holds no hooks, and so vetoes nothing.
+type wipeHooks struct {
+	mu    sync.RWMutex
+	hooks map[uint64]BeforeWipeHook
+	next  uint64
+}
+
+// register adds hook to the registry, returning
+// a function that removes it again when called.
+func (w *wipeHooks) register(hook BeforeWipeHook) func() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.hooks == nil {
+		w.hooks = make(map[uint64]BeforeWipeHook)
+	}
+
+	id := w.next
+	w.next++
+	w.hooks[id] = hook
+
+	return func() {
+		w.mu.Lock()
+		delete(w.hooks, id)
+		w.mu.Unlock()
+	}
+}
+
+// run calls each registered hook with the given
+// status, returning the first error returned by
+// a hook with type WipeErrVetoed stored on it.
+func (w *wipeHooks) run(ctx context.Context, status *gtsmodel.Status) error {
+	w.mu.RLock()
+	hooks := make([]BeforeWipeHook, 0, len(w.hooks))
+	for _, hook := range w.hooks {
+		hooks = append(hooks, hook)
+	}
+	w.mu.RUnlock()
+
+	for _, hook := range hooks {
+		if err := hook(ctx, status); err != nil {
+			err = gtserror.Newf("wipe of status %s vetoed: %w", status.ID, err)
+			return gtserror.WithType(err, WipeErrVetoed)
+		}
+	}
+
+	return nil
+}
+
+// RegisterBeforeWipeHook registers a hook to be called
+// before any status is wiped, allowing for custom checks
+// to veto the wipe. Call the returned function to
+// unregister the hook again.
+func (p *Processor) RegisterBeforeWipeHook(hook BeforeWipeHook) func() {
+	return p.clientAPI.utils.beforeWipe.register(hook)
+}
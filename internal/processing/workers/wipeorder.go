@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Relations of a status wiped by wipeStatus,
+// in the names they are recorded under in a
+// WipeOrder.
+const (
+	WipeStepAttachments          = "attachments"
+	WipeStepMentions             = "mentions"
+	WipeStepNotifications        = "notifications"
+	WipeStepBookmarks            = "bookmarks"
+	WipeStepApprovals            = "approvals"
+	WipeStepRejections           = "rejections"
+	WipeStepPendingInteractions  = "pending interactions"
+	WipeStepFaves                = "faves"
+	WipeStepPollVotes            = "poll votes"
+	WipeStepPoll                 = "poll"
+	WipeStepBoosts               = "boosts"
+	WipeStepBoostTimelines       = "boost timeline entries"
+	WipeStepTimelines            = "timeline entries"
+	WipeStepReplies              = "replies"
+	WipeStepConversationStatuses = "conversation statuses"
+	WipeStepStatus               = "status"
+)
+
+// wipeReferences maps each wiped relation to the
+// relations its rows point to. The database schema
+// doesn't (yet) enforce these as foreign keys, but
+// wiping must not leave a row pointing to an
+// already-wiped row, or it would once it does.
+//
+// Timeline entries aren't database rows, but
+// boost entries are indexed under the boosted
+// status, so they must all be removed before
+// the original's entries are.
+var wipeReferences = map[string][]string{
+	WipeStepAttachments:          {WipeStepStatus},
+	WipeStepMentions:             {WipeStepStatus},
+	WipeStepNotifications:        {WipeStepStatus},
+	WipeStepBookmarks:            {WipeStepStatus},
+	WipeStepApprovals:            {WipeStepStatus, WipeStepFaves, WipeStepBoosts},
+	WipeStepRejections:           {WipeStepStatus, WipeStepFaves, WipeStepBoosts},
+	WipeStepPendingInteractions:  {WipeStepStatus},
+	WipeStepFaves:                {WipeStepStatus},
+	WipeStepPollVotes:            {WipeStepPoll},
+	WipeStepPoll:                 {WipeStepStatus},
+	WipeStepBoosts:               {WipeStepStatus},
+	WipeStepBoostTimelines:       {WipeStepTimelines},
+	WipeStepReplies:              {WipeStepStatus},
+	WipeStepConversationStatuses: {WipeStepStatus},
+}
+
+// WipeOrder records the order in which wipeStatus
+// wipes the relations of a status, so it can be
+// checked against wipeReferences. This is done for
+// every wipe in debug builds, or for wipes given a
+// context from WithWipeOrder.
+//
+// A nil WipeOrder records nothing.
+type WipeOrder struct {
+	steps []string
+}
+
+type wipeOrderKey struct{}
+
+// WithWipeOrder returns a copy of ctx that
+// has wipeStatus record its steps into order.
+func WithWipeOrder(ctx context.Context, order *WipeOrder) context.Context {
+	return context.WithValue(ctx, wipeOrderKey{}, order)
+}
+
+// getWipeOrder returns the WipeOrder
+// stored in ctx, if any, else nil.
+func getWipeOrder(ctx context.Context) *WipeOrder {
+	order, _ := ctx.Value(wipeOrderKey{}).(*WipeOrder)
+	return order
+}
+
+// add records step as the next wiped relation.
+func (o *WipeOrder) add(step string) {
+	if o == nil {
+		return
+	}
+	o.steps = append(o.steps, step)
+}
+
+// Steps returns the wiped relations
+// in the order they were wiped.
+func (o *WipeOrder) Steps() []string {
+	if o == nil {
+		return nil
+	}
+	return slices.Clone(o.steps)
+}
+
+// Verify checks that no relation was wiped while rows
+// of a relation pointing to it still remained, i.e.
+// that each relation was wiped before any it points
+// to. An error is returned describing each violation.
+func (o *WipeOrder) Verify() error {
+	if o == nil {
+		return nil
+	}
+
+	var errs []error
+	for i, step := range o.steps {
+		for _, ref := range wipeReferences[step] {
+			if j := slices.Index(o.steps, ref); j >= 0 && j < i {
+				errs = append(errs, fmt.Errorf(
+					"%s wiped after %s they point to",
+					step, ref,
+				))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWipeOrderVerify(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		steps []string
+		safe  bool
+	}{
+		{
+			name:  "empty",
+			steps: nil,
+			safe:  true,
+		},
+		{
+			name: "children first",
+			steps: []string{
+				WipeStepMentions,
+				WipeStepPollVotes,
+				WipeStepPoll,
+				WipeStepStatus,
+			},
+			safe: true,
+		},
+		{
+			name: "poll before votes",
+			steps: []string{
+				WipeStepPoll,
+				WipeStepPollVotes,
+				WipeStepStatus,
+			},
+			safe: false,
+		},
+		{
+			name: "status before mentions",
+			steps: []string{
+				WipeStepStatus,
+				WipeStepMentions,
+			},
+			safe: false,
+		},
+		{
+			name: "original timelines before boost timelines",
+			steps: []string{
+				WipeStepTimelines,
+				WipeStepBoostTimelines,
+				WipeStepStatus,
+			},
+			safe: false,
+		},
+		{
+			name: "boosts before approvals",
+			steps: []string{
+				WipeStepBoosts,
+				WipeStepApprovals,
+				WipeStepStatus,
+			},
+			safe: false,
+		},
+	} {
+		order := new(WipeOrder)
+		for _, step := range test.steps {
+			order.add(step)
+		}
+
+		err := order.Verify()
+		if test.safe && err != nil {
+			t.Errorf("%s: expected safe order, got %v", test.name, err)
+		} else if !test.safe && err == nil {
+			t.Errorf("%s: expected unsafe order", test.name)
+		}
+	}
+}
+
+func TestWipeOrderContext(t *testing.T) {
+	ctx := context.Background()
+	if order := getWipeOrder(ctx); order != nil {
+		t.Fatal("expected no wipe order")
+	}
+
+	// A nil order should
+	// safely record nothing.
+	getWipeOrder(ctx).add(WipeStepStatus)
+
+	order := new(WipeOrder)
+	ctx = WithWipeOrder(ctx, order)
+	getWipeOrder(ctx).add(WipeStepStatus)
+
+	if steps := order.Steps(); len(steps) != 1 || steps[0] != WipeStepStatus {
+		t.Fatalf("unexpected steps %v", steps)
+	}
+}
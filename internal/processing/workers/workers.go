@@ -18,9 +18,14 @@
 package workers
 
 import (
+	"context"
+
+	"codeberg.org/gruf/go-cache/v3/simple"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/filter/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/media"
@@ -31,8 +36,10 @@ import (
 )
 
 type Processor struct {
+	state     *state.State
 	clientAPI clientAPI
 	fediAPI   fediAPI
+	surface   *Surface
 	workers   *workers.Workers
 }
 
@@ -68,13 +75,18 @@ func New(
 
 	// Init shared util funcs.
 	utils := &utils{
-		state:   state,
-		media:   media,
-		account: account,
-		surface: surface,
+		state:    state,
+		media:    media,
+		account:  account,
+		surface:  surface,
+		federate: federate,
+		statOps:  simple.New[string, struct{}](0, statOpsCap),
+		moves:    newMoveLimiter(config.GetAccountsMoveConcurrency()),
 	}
 
 	return Processor{
+		state:   state,
+		surface: surface,
 		workers: &state.Workers,
 		clientAPI: clientAPI{
 			state:     state,
@@ -93,3 +105,12 @@ func New(
 		},
 	}
 }
+
+// RecalculateAccountStats repairs the stats of the given
+// account by overwriting them with freshly counted values,
+// taking the account's lock so as not to race other stats
+// updates. Use this when an account's counters have visibly
+// diverged from reality, eg., from an admin action.
+func (p *Processor) RecalculateAccountStats(ctx context.Context, account *gtsmodel.Account) error {
+	return p.clientAPI.utils.recalculateAccountStats(ctx, account)
+}
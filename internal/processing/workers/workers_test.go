@@ -49,6 +49,8 @@ type WorkersTestSuite struct {
 	testStatuses     map[string]*gtsmodel.Status
 	testTags         map[string]*gtsmodel.Tag
 	testMentions     map[string]*gtsmodel.Mention
+	testFaves        map[string]*gtsmodel.StatusFave
+	testEmojis       map[string]*gtsmodel.Emoji
 	testAutheds      map[string]*oauth.Auth
 	testBlocks       map[string]*gtsmodel.Block
 	testActivities   map[string]testrig.ActivityWithSignature
@@ -84,6 +86,8 @@ func (suite *WorkersTestSuite) SetupSuite() {
 	suite.testStatuses = testrig.NewTestStatuses()
 	suite.testTags = testrig.NewTestTags()
 	suite.testMentions = testrig.NewTestMentions()
+	suite.testFaves = testrig.NewTestFaves()
+	suite.testEmojis = testrig.NewTestEmojis()
 	suite.testAutheds = map[string]*oauth.Auth{
 		"local_account_1": {
 			Application: suite.testApplications["local_account_1"],
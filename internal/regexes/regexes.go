@@ -73,6 +73,7 @@ const (
 	likePath          = userPathPrefix + `/` + liked + `/(` + ulid + `)$`
 	statusesPath      = userPathPrefix + `/` + statuses + `/(` + ulid + `)$`
 	acceptsPath       = userPathPrefix + `/` + accepts + `/(` + ulid + `)$`
+	acceptsByIDPath   = `^/?` + users + `/(` + ulid + `)/` + accepts + `/(` + ulid + `)$`
 	blockPath         = userPathPrefix + `/` + blocks + `/(` + ulid + `)$`
 	reportPath        = `^/?` + reports + `/(` + ulid + `)$`
 	filePath          = `^/?(` + ulid + `)/([a-z]+)/([a-z]+)/(` + ulid + `)\.([a-z0-9]+)$`
@@ -164,6 +165,10 @@ var (
 	// from eg /users/example_username/accepts/01GP3AWY4CRDVRNZKW0TEAMB5R
 	AcceptsPath = regexp.MustCompile(acceptsPath)
 
+	// AcceptsByIDPath parses a path that validates and captures the account id part and the ulid part
+	// from eg /users/01F8MH1H7YV1Z7D2C8K2730QBF/accepts/01GP3AWY4CRDVRNZKW0TEAMB5R
+	AcceptsByIDPath = regexp.MustCompile(acceptsByIDPath)
+
 	// FilePath parses a file storage path of the form [ACCOUNT_ID]/[MEDIA_TYPE]/[MEDIA_SIZE]/[FILE_NAME]
 	// eg 01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH8RMYQ6MSNY3JM2XT1CQ5.jpeg
 	// It captures the account id, media type, media size, file name, and file extension, eg
@@ -19,6 +19,7 @@ package scheduler
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -84,6 +85,47 @@ func (sch *Scheduler) Cancel(id string) bool {
 	return true
 }
 
+// CancelPrefix cancels all scheduled tasks with the
+// given ID prefix, returning the number cancelled.
+func (sch *Scheduler) CancelPrefix(prefix string) int {
+	// Acquire and delete
+	// all matching tasks.
+	var cncls []func()
+	sch.mu.Lock()
+	for id, task := range sch.ts {
+		if strings.HasPrefix(id, prefix) {
+			cncls = append(cncls, task.cncl)
+			delete(sch.ts, id)
+		}
+	}
+	sch.mu.Unlock()
+
+	// Cancel the queued
+	// jobs from Scheduler.
+	for _, cncl := range cncls {
+		cncl()
+	}
+
+	return len(cncls)
+}
+
+// IDsAddedBefore returns the IDs of all tasks with the
+// given ID prefix which were added before the given time.
+func (sch *Scheduler) IDsAddedBefore(prefix string, before time.Time) []string {
+	sch.mu.Lock()
+	defer sch.mu.Unlock()
+
+	var ids []string
+	for id, task := range sch.ts {
+		if strings.HasPrefix(id, prefix) &&
+			task.added.Before(before) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
 func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t sched.Timing) bool {
 	if fn == nil {
 		panic("nil function")
@@ -114,16 +156,18 @@ func (sch *Scheduler) schedule(id string, fn func(context.Context, time.Time), t
 	// and store a new encompassing task.
 	cncl := sch.sch.Schedule(job)
 	sch.ts[id] = &task{
-		job:  job,
-		cncl: cncl,
+		job:   job,
+		cncl:  cncl,
+		added: time.Now(),
 	}
 
 	return true
 }
 
-// task simply wraps together a scheduled
-// job, and the matching cancel function.
+// task simply wraps together a scheduled job,
+// the matching cancel function, and add time.
 type task struct {
-	job  *sched.Job
-	cncl func()
+	job   *sched.Job
+	cncl  func()
+	added time.Time
 }
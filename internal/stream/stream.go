@@ -50,6 +50,10 @@ const (
 	// EventTypeConversation -- a user
 	// should be shown an updated conversation.
 	EventTypeConversation = "conversation"
+
+	// EventTypePendingInteractionsCount -- the count
+	// of interactions pending a user's approval has changed.
+	EventTypePendingInteractionsCount = "pending_interactions.count"
 )
 
 const (
@@ -20,6 +20,7 @@ package typeutils
 import (
 	"context"
 	"strconv"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -339,6 +340,7 @@ func (c *Converter) MutesToCSV(
 	records[0] = []string{
 		"Account address",
 		"Hide notifications",
+		"Expires at",
 	}
 
 	// We need to know our own domain for this.
@@ -348,8 +350,16 @@ func (c *Converter) MutesToCSV(
 		thisDomain = config.GetHost()
 	}
 
+	now := time.Now()
+
 	// For each item, add a record.
 	for _, mute := range mutes {
+		if mute.Expired(now) {
+			// Don't export
+			// expired mutes.
+			continue
+		}
+
 		if mute.TargetAccount == nil {
 			// Retrieve target account.
 			var err error
@@ -379,12 +389,25 @@ func (c *Converter) MutesToCSV(
 			mute.TargetAccount.Username + "@" + domain,
 			// Hide notifications: eg., true
 			strconv.FormatBool(*mute.Notifications),
+			// Expires at: eg., 2024-01-01T00:00:00Z
+			// -- NOTE: empty if mute never expires!
+			formatMuteExpiry(mute.ExpiresAt),
 		})
 	}
 
 	return records, nil
 }
 
+// formatMuteExpiry formats the expiry time
+// of a mute for CSV export, or returns an
+// empty string if the mute never expires.
+func formatMuteExpiry(expiresAt time.Time) string {
+	if expiresAt.IsZero() {
+		return ""
+	}
+	return expiresAt.UTC().Format(time.RFC3339)
+}
+
 // CSVToFollowing converts a slice of CSV records
 // to a slice of barebones *gtsmodel.Follow's,
 // ready for further processing.
@@ -518,3 +541,156 @@ func (c *Converter) CSVToBlocks(
 
 	return blocks, nil
 }
+
+// CSVToMutes converts a slice of CSV records
+// to a slice of barebones *gtsmodel.UserMute's,
+// ready for further processing.
+//
+// Only TargetAccount.Username, TargetAccount.Domain,
+// Notifications, and ExpiresAt will be set on each
+// UserMute. The expiry column is optional, and mutes
+// that have already expired are skipped.
+func (c *Converter) CSVToMutes(
+	ctx context.Context,
+	records [][]string,
+) ([]*gtsmodel.UserMute, error) {
+	// We need to know our own domain for this.
+	// Try account domain, fall back to host.
+	var (
+		thisHost          = config.GetHost()
+		thisAccountDomain = config.GetAccountDomain()
+		mutes             = make([]*gtsmodel.UserMute, 0, len(records))
+		now               = time.Now()
+	)
+
+	for _, record := range records {
+		if len(record) != 2 && len(record) != 3 {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		namestring := record[0]
+		if namestring == "" {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		// Prepend with "@"
+		// if not included.
+		if namestring[0] != '@' {
+			namestring = "@" + namestring
+		}
+
+		username, domain, err := util.ExtractNamestringParts(namestring)
+		if err != nil {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		if domain == thisHost || domain == thisAccountDomain {
+			// Clear the domain,
+			// since it's ours.
+			domain = ""
+		}
+
+		notifications, err := strconv.ParseBool(record[1])
+		if err != nil {
+			// Badly formatted,
+			// skip this one.
+			continue
+		}
+
+		var expiresAt time.Time
+		if len(record) == 3 && record[2] != "" {
+			expiresAt, err = time.Parse(time.RFC3339, record[2])
+			if err != nil {
+				// Badly formatted,
+				// skip this one.
+				continue
+			}
+		}
+
+		mute := &gtsmodel.UserMute{
+			TargetAccount: &gtsmodel.Account{
+				Username: username,
+				Domain:   domain,
+			},
+			Notifications: &notifications,
+			ExpiresAt:     expiresAt,
+		}
+
+		if mute.Expired(now) {
+			// Nothing
+			// to import.
+			continue
+		}
+
+		// Looks good, whack it in the slice.
+		mutes = append(mutes, mute)
+	}
+
+	return mutes, nil
+}
+
+// InteractionApprovalsToExport converts a slice of
+// interaction approvals into exportable models,
+// containing only URIs of the interacting accounts.
+func (c *Converter) InteractionApprovalsToExport(
+	ctx context.Context,
+	approvals []*gtsmodel.InteractionApproval,
+) ([]*apimodel.InteractionApprovalExport, error) {
+	exports := make([]*apimodel.InteractionApprovalExport, 0, len(approvals))
+
+	// For each item, add an export model.
+	for _, approval := range approvals {
+		if approval.InteractingAccount == nil {
+			// Retrieve interacting account.
+			var err error
+			approval.InteractingAccount, err = c.state.DB.GetAccountByID(
+				// Barebones is fine here.
+				gtscontext.SetBarebones(ctx),
+				approval.InteractingAccountID,
+			)
+			if err != nil {
+				return nil, gtserror.Newf(
+					"db error getting interacting account for approval %s: %w",
+					approval.ID, err,
+				)
+			}
+		}
+
+		var interactionType string
+		switch approval.InteractionType {
+		case gtsmodel.InteractionLike:
+			interactionType = "like"
+		case gtsmodel.InteractionReply:
+			interactionType = "reply"
+		case gtsmodel.InteractionAnnounce:
+			interactionType = "announce"
+		default:
+			return nil, gtserror.Newf(
+				"unknown interaction type %d for approval %s",
+				approval.InteractionType, approval.ID,
+			)
+		}
+
+		export := &apimodel.InteractionApprovalExport{
+			URI:                   approval.URI,
+			CreatedAt:             util.FormatISO8601(approval.CreatedAt),
+			InteractionType:       interactionType,
+			InteractionURI:        approval.InteractionURI,
+			InteractingAccountURI: approval.InteractingAccount.URI,
+		}
+
+		if approval.Expires() {
+			export.ExpiresAt = util.FormatISO8601(approval.ExpiresAt)
+		}
+
+		exports = append(exports, export)
+	}
+
+	return exports, nil
+}
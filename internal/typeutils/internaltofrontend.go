@@ -133,14 +133,19 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 	}
 
 	apiAccount.Source = &apimodel.Source{
-		Privacy:             c.VisToAPIVis(ctx, a.Settings.Privacy),
-		Sensitive:           *a.Settings.Sensitive,
-		Language:            a.Settings.Language,
-		StatusContentType:   statusContentType,
-		Note:                a.NoteRaw,
-		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
-		FollowRequestsCount: *a.Stats.FollowRequestsCount,
-		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
+		Privacy:                       c.VisToAPIVis(ctx, a.Settings.Privacy),
+		Sensitive:                     *a.Settings.Sensitive,
+		Language:                      a.Settings.Language,
+		StatusContentType:             statusContentType,
+		Note:                          a.NoteRaw,
+		Fields:                        c.fieldsToAPIFields(a.FieldsRaw),
+		FollowRequestsCount:           *a.Stats.FollowRequestsCount,
+		AlsoKnownAsURIs:               a.AlsoKnownAsURIs,
+		InteractionMinAccountAgeDays:  a.Settings.InteractionMinAccountAgeDays,
+		InteractionTrustedListID:      a.Settings.InteractionTrustedListID,
+		InteractionApprovalTag:        a.Settings.InteractionApprovalTag,
+		InteractionApprovalExpiryDays: a.Settings.InteractionApprovalExpiryDays,
+		InteractionPendingReminders:   util.PtrOrZero(a.Settings.InteractionPendingReminders),
 	}
 
 	return apiAccount, nil
@@ -261,6 +266,15 @@ func (c *Converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		}()
 	)
 
+	if c.statsHidden(ctx, a) {
+		// Stats of accounts on blocked
+		// domains are served as zeroed.
+		followersCount = 0
+		followingCount = 0
+		statusesCount = 0
+		lastStatusAt = nil
+	}
+
 	// Profile media + nice extras:
 	//   - Avatar
 	//   - Header
@@ -1776,15 +1790,140 @@ func (c *Converter) NotificationToAPINotification(
 		apiStatus = apiStatus.Reblog.Status
 	}
 
+	var pendingCount int
+	if n.NotificationType == gtsmodel.NotificationPendingRemind {
+		// Include current count of interactions
+		// awaiting approval by the target account.
+		pendingCount, err = c.state.DB.CountPendingInteractionsForAccount(ctx, n.TargetAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("NotificationToapi: error counting pending interactions: %s", err)
+		}
+	}
+
 	return &apimodel.Notification{
-		ID:        n.ID,
-		Type:      string(n.NotificationType),
-		CreatedAt: util.FormatISO8601(n.CreatedAt),
-		Account:   apiAccount,
-		Status:    apiStatus,
+		ID:           n.ID,
+		Type:         string(n.NotificationType),
+		CreatedAt:    util.FormatISO8601(n.CreatedAt),
+		Account:      apiAccount,
+		Status:       apiStatus,
+		PendingCount: pendingCount,
+		ApprovalURI:  n.ApprovalURI,
 	}, nil
 }
 
+// InteractionApprovalToAPIInteractionApproval converts the given
+// approval into its API representation, including the interacting
+// account and a summary of the interaction: the status that was
+// interacted with, and for replies the approved reply itself.
+func (c *Converter) InteractionApprovalToAPIInteractionApproval(
+	ctx context.Context,
+	approval *gtsmodel.InteractionApproval,
+	requestingAccount *gtsmodel.Account,
+) (*apimodel.InteractionApproval, error) {
+	if approval.InteractingAccount == nil {
+		// Retrieve interacting account.
+		var err error
+		approval.InteractingAccount, err = c.state.DB.GetAccountByID(ctx,
+			approval.InteractingAccountID,
+		)
+		if err != nil {
+			return nil, gtserror.Newf(
+				"db error getting interacting account for approval %s: %w",
+				approval.ID, err,
+			)
+		}
+	}
+
+	apiAccount, err := c.AccountToAPIAccountPublic(ctx, approval.InteractingAccount)
+	if err != nil {
+		return nil, gtserror.Newf("error converting interacting account: %w", err)
+	}
+
+	var (
+		typ    string
+		status *gtsmodel.Status
+		reply  *gtsmodel.Status
+	)
+
+	switch approval.InteractionType {
+	case gtsmodel.InteractionLike:
+		typ = "favourite"
+		fave, err := c.state.DB.GetStatusFaveByURI(ctx, approval.InteractionURI)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting fave %s: %w", approval.InteractionURI, err)
+		}
+		status = fave.Status
+
+	case gtsmodel.InteractionReply:
+		typ = "reply"
+		reply, err = c.state.DB.GetStatusByURI(ctx, approval.InteractionURI)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting reply %s: %w", approval.InteractionURI, err)
+		}
+		status = reply.InReplyTo
+
+	case gtsmodel.InteractionAnnounce:
+		typ = "reblog"
+		boost, err := c.state.DB.GetStatusByURI(ctx, approval.InteractionURI)
+		if err != nil {
+			return nil, gtserror.Newf("db error getting boost %s: %w", approval.InteractionURI, err)
+		}
+		status = boost.BoostOf
+
+	default:
+		return nil, gtserror.Newf(
+			"unknown interaction type %d for approval %s",
+			approval.InteractionType, approval.ID,
+		)
+	}
+
+	apiApproval := &apimodel.InteractionApproval{
+		ID:        approval.ID,
+		Type:      typ,
+		CreatedAt: util.FormatISO8601(approval.CreatedAt),
+		Account:   apiAccount,
+		URI:       approval.URI,
+	}
+
+	if approval.Expires() {
+		expiresAt := util.FormatISO8601(approval.ExpiresAt)
+		apiApproval.ExpiresAt = &expiresAt
+	}
+
+	if approval.Reason != "" {
+		reason := string(approval.Reason)
+		apiApproval.Reason = &reason
+	}
+
+	if status != nil {
+		apiApproval.Status, err = c.StatusToAPIStatus(ctx,
+			status,
+			requestingAccount,
+			statusfilter.FilterContextNone,
+			nil, // No filters.
+			nil, // No mutes.
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error converting interacted status: %w", err)
+		}
+	}
+
+	if reply != nil {
+		apiApproval.Reply, err = c.StatusToAPIStatus(ctx,
+			reply,
+			requestingAccount,
+			statusfilter.FilterContextNone,
+			nil, // No filters.
+			nil, // No mutes.
+		)
+		if err != nil {
+			return nil, gtserror.Newf("error converting reply: %w", err)
+		}
+	}
+
+	return apiApproval, nil
+}
+
 // ConversationToAPIConversation converts a conversation into its API representation.
 // The conversation status will be filtered using the notification filter context,
 // and may be nil if the status was hidden.
@@ -2021,9 +2160,56 @@ func (c *Converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 		ActionTakenComment:   actionTakenComment,
 		Statuses:             statuses,
 		Rules:                rules,
+		DeletedStatuses:      reportSnapshotsToAPI(r.StatusSnapshots),
 	}, nil
 }
 
+// reportSnapshotsToAPI converts the given deleted status snapshots
+// of a report to their API representation, nil if there are none.
+func reportSnapshotsToAPI(snapshots []*gtsmodel.ReportStatusSnapshot) []*apimodel.AdminReportDeletedStatus {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	apiSnapshots := make([]*apimodel.AdminReportDeletedStatus, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		attachments := make([]*apimodel.AdminReportDeletedAttachment, 0, len(snapshot.Attachments))
+		for _, attachment := range snapshot.Attachments {
+			attachments = append(attachments, &apimodel.AdminReportDeletedAttachment{
+				ID:          attachment.ID,
+				Type:        attachment.Type,
+				URL:         attachment.URL,
+				RemoteURL:   attachment.RemoteURL,
+				Description: attachment.Description,
+			})
+		}
+
+		apiSnapshots = append(apiSnapshots, &apimodel.AdminReportDeletedStatus{
+			ID:               snapshot.StatusID,
+			URI:              snapshot.URI,
+			CreatedAt:        util.FormatISO8601(snapshot.CreatedAt),
+			DeletedAt:        util.FormatISO8601(snapshot.DeletedAt),
+			SpoilerText:      snapshot.ContentWarning,
+			Content:          snapshot.Content,
+			MediaAttachments: attachments,
+		})
+	}
+
+	return apiSnapshots
+}
+
+// MoveEventToAdminAPIMoveEvent converts a
+// gtsmodel Move event to its admin API model.
+func (c *Converter) MoveEventToAdminAPIMoveEvent(e *gtsmodel.MoveEvent) *apimodel.AdminMoveEvent {
+	return &apimodel.AdminMoveEvent{
+		ID:        e.ID,
+		MoveID:    e.MoveID,
+		CreatedAt: util.FormatISO8601(e.CreatedAt),
+		Phase:     string(e.Phase),
+		Details:   e.Details,
+	}
+}
+
 // ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
 func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
 	return &apimodel.List{
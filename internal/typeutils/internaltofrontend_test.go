@@ -72,6 +72,47 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestAccountToFrontendBlockedDomain() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	// Hide stats of blocked domains.
+	config.SetInstanceHideBlockedDomainStats(true)
+	defer config.SetInstanceHideBlockedDomainStats(false)
+
+	// Block the account's domain.
+	if err := suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01J4ZKQ0WY6T0M8XQH4Y1H2N5E",
+		Domain:             testAccount.Domain,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiAccount, err := suite.typeconverter.AccountToAPIAccountPublic(ctx, testAccount)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Stats should be zeroed.
+	suite.Zero(apiAccount.FollowersCount)
+	suite.Zero(apiAccount.FollowingCount)
+	suite.Zero(apiAccount.StatusesCount)
+	suite.Nil(apiAccount.LastStatusAt)
+
+	// Unless configured otherwise.
+	config.SetInstanceHideBlockedDomainStats(false)
+
+	apiAccount, err = suite.typeconverter.AccountToAPIAccountPublic(ctx, testAccount)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(3, apiAccount.StatusesCount)
+	suite.NotNil(apiAccount.LastStatusAt)
+}
+
 func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved() {
 	// Take zork for this test.
 	var testAccount = new(gtsmodel.Account)
@@ -126,6 +167,9 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendAliasedAndMoved()
     "note": "hey yo this is my profile!",
     "fields": [],
     "follow_requests_count": 0,
+    "interaction_min_account_age_days": 0,
+    "interaction_approval_expiry_days": 0,
+    "interaction_pending_reminders": false,
     "also_known_as_uris": [
       "http://localhost:8080/users/1happyturtle"
     ]
@@ -309,7 +353,10 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
     "status_content_type": "text/plain",
     "note": "hey yo this is my profile!",
     "fields": [],
-    "follow_requests_count": 0
+    "follow_requests_count": 0,
+    "interaction_min_account_age_days": 0,
+    "interaction_approval_expiry_days": 0,
+    "interaction_pending_reminders": false
   },
   "enable_rss": true,
   "role": {
@@ -2985,6 +3032,94 @@ func (suite *InternalToFrontendTestSuite) TestRelationshipFollowRequested() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestInteractionApprovalToAPIReply() {
+	var (
+		ctx       = context.Background()
+		approver  = suite.testAccounts["local_account_1"]
+		replier   = suite.testAccounts["local_account_2"]
+		reply     = suite.testStatuses["local_account_2_status_5"]
+		expiresAt = testrig.TimeMustParse("2030-01-01T00:00:00Z")
+	)
+
+	// Store an (expiring) approval of turtle's reply to zork.
+	approvalID := "01J5QVKB5NXM69MJ7QT3JKB8P6"
+	if err := suite.db.PutInteractionApproval(ctx, &gtsmodel.InteractionApproval{
+		ID:                   approvalID,
+		AccountID:            approver.ID,
+		InteractingAccountID: replier.ID,
+		InteractionURI:       reply.URI,
+		InteractionType:      gtsmodel.InteractionReply,
+		URI:                  "http://localhost:8080/users/the_mighty_zork/accepts/" + approvalID,
+		ExpiresAt:            expiresAt,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Get it back out of the db, unpopulated.
+	approval, err := suite.db.GetInteractionApprovalByID(ctx, approvalID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	approval.InteractingAccount = nil
+
+	apiApproval, err := suite.typeconverter.InteractionApprovalToAPIInteractionApproval(ctx, approval, approver)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(approvalID, apiApproval.ID)
+	suite.Equal("reply", apiApproval.Type)
+	suite.Equal(approval.URI, apiApproval.URI)
+	suite.Equal(util.FormatISO8601(approval.CreatedAt), apiApproval.CreatedAt)
+	suite.Equal(util.Ptr(util.FormatISO8601(expiresAt)), apiApproval.ExpiresAt)
+	suite.Equal(replier.ID, apiApproval.Account.ID)
+	suite.Equal(reply.InReplyToID, apiApproval.Status.ID)
+	suite.Equal(reply.ID, apiApproval.Reply.ID)
+}
+
+func (suite *InternalToFrontendTestSuite) TestInteractionApprovalToAPIFave() {
+	var (
+		ctx      = context.Background()
+		approver = suite.testAccounts["admin_account"]
+		faver    = suite.testAccounts["local_account_1"]
+		status   = suite.testStatuses["admin_account_status_1"]
+	)
+
+	fave, err := suite.db.GetStatusFave(ctx, faver.ID, status.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Store a (permanent) approval of zork's fave of admin's status.
+	approvalID := "01J5QVXCCEATJYSXM9H6MZT4JR"
+	if err := suite.db.PutInteractionApproval(ctx, &gtsmodel.InteractionApproval{
+		ID:                   approvalID,
+		AccountID:            approver.ID,
+		InteractingAccountID: faver.ID,
+		InteractionURI:       fave.URI,
+		InteractionType:      gtsmodel.InteractionLike,
+		URI:                  "http://localhost:8080/users/admin/accepts/" + approvalID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	approval, err := suite.db.GetInteractionApprovalByID(ctx, approvalID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiApproval, err := suite.typeconverter.InteractionApprovalToAPIInteractionApproval(ctx, approval, approver)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("favourite", apiApproval.Type)
+	suite.Nil(apiApproval.ExpiresAt)
+	suite.Equal(faver.ID, apiApproval.Account.ID)
+	suite.Equal(status.ID, apiApproval.Status.ID)
+	suite.Nil(apiApproval.Reply)
+}
+
 func TestInternalToFrontendTestSuite(t *testing.T) {
 	suite.Run(t, new(InternalToFrontendTestSuite))
 }
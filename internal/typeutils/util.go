@@ -101,6 +101,24 @@ func (c *Converter) interactionsWithStatusForAccount(ctx context.Context, s *gts
 	return si, nil
 }
 
+// statsHidden returns whether stats of the given
+// account should be served zeroed, which is the case
+// for accounts on blocked domains if the instance
+// is configured to hide their stats.
+func (c *Converter) statsHidden(ctx context.Context, a *gtsmodel.Account) bool {
+	if !config.GetInstanceHideBlockedDomainStats() || a.IsLocal() {
+		return false
+	}
+
+	blocked, err := c.state.DB.IsDomainBlocked(ctx, a.Domain)
+	if err != nil {
+		log.Errorf(ctx, "error checking domain block for %s: %v", a.Domain, err)
+		return false
+	}
+
+	return blocked
+}
+
 func misskeyReportInlineURLs(content string) []*url.URL {
 	m := regexes.MisskeyReportNotes.FindAllStringSubmatch(content, -1)
 	urls := make([]*url.URL, 0, len(m))
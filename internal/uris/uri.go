@@ -145,6 +145,15 @@ func GenerateURIForAccept(username string, thisAcceptID string) string {
 	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, AcceptsPath, thisAcceptID)
 }
 
+// GenerateURIForAcceptByAccountID returns the AP URI for a new accept activity,
+// using the ID of the accepting account rather than its username -- something like:
+// https://example.org/users/01F8MH1H7YV1Z7D2C8K2730QBF/accepts/01F7XTH1QGBAPMGF49WJZ91XGC
+func GenerateURIForAcceptByAccountID(accountID string, thisAcceptID string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, accountID, AcceptsPath, thisAcceptID)
+}
+
 // GenerateURIsForAccount throws together a bunch of URIs for the given username, with the given protocol and host.
 func GenerateURIsForAccount(username string) *UserURIs {
 	protocol := config.GetProtocol()
@@ -326,9 +335,11 @@ func IsReportPath(id *url.URL) bool {
 	return regexes.ReportPath.MatchString(id.Path)
 }
 
-// IsAcceptsPath returns true if the given URL path corresponds to eg /users/example_username/accepts/SOME_ULID_OF_AN_ACCEPT
+// IsAcceptsPath returns true if the given URL path corresponds to eg /users/example_username/accepts/SOME_ULID_OF_AN_ACCEPT,
+// or /users/SOME_ULID_OF_AN_ACCOUNT/accepts/SOME_ULID_OF_AN_ACCEPT
 func IsAcceptsPath(id *url.URL) bool {
-	return regexes.AcceptsPath.MatchString(id.Path)
+	return regexes.AcceptsPath.MatchString(id.Path) ||
+		regexes.AcceptsByIDPath.MatchString(id.Path)
 }
 
 // ParseStatusesPath returns the username and ulid from a path such as /users/example_username/statuses/SOME_ULID_OF_A_STATUS
@@ -443,3 +454,26 @@ func ParseReportPath(id *url.URL) (ulid string, err error) {
 	ulid = matches[1]
 	return
 }
+
+// ParseAcceptsPath returns the account username or account id, and the ulid, from a path such as
+// /users/example_username/accepts/SOME_ULID_OF_AN_ACCEPT or /users/SOME_ULID_OF_AN_ACCOUNT/accepts/SOME_ULID_OF_AN_ACCEPT.
+//
+// The returned byID bool indicates whether the account was identified by its id rather than username.
+func ParseAcceptsPath(id *url.URL) (account string, ulid string, byID bool, err error) {
+	matches := regexes.AcceptsByIDPath.FindStringSubmatch(id.Path)
+	if len(matches) == 3 {
+		account = matches[1]
+		ulid = matches[2]
+		byID = true
+		return
+	}
+
+	matches = regexes.AcceptsPath.FindStringSubmatch(id.Path)
+	if len(matches) != 3 {
+		err = fmt.Errorf("expected 3 matches but matches length was %d", len(matches))
+		return
+	}
+	account = matches[1]
+	ulid = matches[2]
+	return
+}
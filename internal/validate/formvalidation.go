@@ -304,6 +304,23 @@ func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
 	}
 }
 
+// InteractionReason validates the optional reason code
+// given when approving or rejecting an interaction.
+func InteractionReason(reason gtsmodel.InteractionReason) error {
+	switch reason {
+	case "",
+		gtsmodel.InteractionReasonTrusted,
+		gtsmodel.InteractionReasonOnTopic,
+		gtsmodel.InteractionReasonOffTopic,
+		gtsmodel.InteractionReasonSpam,
+		gtsmodel.InteractionReasonAbusive,
+		gtsmodel.InteractionReasonOther:
+		return nil
+	default:
+		return fmt.Errorf("interaction reason must be either empty or one of 'trusted', 'on-topic', 'off-topic', 'spam', 'abusive', 'other'")
+	}
+}
+
 // MarkerName checks that the desired marker timeline name is valid.
 func MarkerName(name string) error {
 	if name == "" {
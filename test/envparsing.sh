@@ -7,6 +7,9 @@ EXPECT=$(cat << "EOF"
     "account-domain": "peepee",
     "accounts-allow-custom-css": true,
     "accounts-custom-css-length": 5000,
+    "accounts-move-concurrency": 4,
+    "accounts-move-follow-retry-attempts": 3,
+    "accounts-move-follow-retry-delay": 3600000000000,
     "accounts-reason-required": false,
     "accounts-registration-open": true,
     "advanced-cookies-samesite": "strict",
@@ -55,6 +58,7 @@ EXPECT=$(cat << "EOF"
         "mention-mem-ratio": 2,
         "move-mem-ratio": 0.1,
         "notification-mem-ratio": 2,
+        "pending-interaction-count-mem-ratio": 0.1,
         "poll-mem-ratio": 1,
         "poll-vote-ids-mem-ratio": 2,
         "poll-vote-mem-ratio": 2,
@@ -62,6 +66,7 @@ EXPECT=$(cat << "EOF"
         "status-bookmark-ids-mem-ratio": 2,
         "status-bookmark-mem-ratio": 0.5,
         "status-fave-ids-mem-ratio": 3,
+        "status-interaction-counts-mem-ratio": 0.5,
         "status-fave-mem-ratio": 2,
         "status-mem-ratio": 5,
         "tag-ids-followed-by-account-mem-ratio": 1,
@@ -91,6 +96,7 @@ EXPECT=$(cat << "EOF"
     "db-user": "sex-haver",
     "dry-run": true,
     "email": "",
+    "fix": false,
     "host": "example.com",
     "http-client": {
         "allow-ips": [],
@@ -98,6 +104,7 @@ EXPECT=$(cat << "EOF"
         "timeout": 10000000000,
         "tls-insecure-skip-verify": false
     },
+    "instance-block-remove-faves": false,
     "instance-deliver-to-shared-inboxes": false,
     "instance-expose-peers": true,
     "instance-expose-public-timeline": true,
@@ -105,7 +112,13 @@ EXPECT=$(cat << "EOF"
     "instance-expose-suspended-web": true,
     "instance-federation-mode": "allowlist",
     "instance-federation-spam-filter": true,
+    "instance-hide-blocked-domain-stats": false,
     "instance-inject-mastodon-version": true,
+    "instance-interaction-approval-cascade-depth": 0,
+    "instance-interaction-approved-boostable": true,
+    "instance-interaction-pending-limit": 0,
+    "instance-interaction-pending-reminder-every": 86400000000000,
+    "instance-reports-preserve-statuses": true,
     "instance-languages": [
         "nl",
         "en-GB"
@@ -129,11 +142,14 @@ EXPECT=$(cat << "EOF"
     "media-ffmpeg-pool-size": 8,
     "media-local-max-size": 420,
     "media-remote-cache-days": 30,
+    "media-remote-delete-retain": false,
     "media-remote-max-size": 420,
+    "merge": false,
     "metrics-auth-enabled": false,
     "metrics-auth-password": "",
     "metrics-auth-username": "",
     "metrics-enabled": false,
+    "node-name": "node-1",
     "oidc-admin-groups": [
         "steamy"
     ],
@@ -166,8 +182,14 @@ EXPECT=$(cat << "EOF"
     "software-version": "",
     "statuses-max-chars": 69,
     "statuses-media-max-files": 1,
+    "statuses-orphaned-reply-placeholder": false,
+    "statuses-poll-archive-on-delete": false,
+    "statuses-poll-expiry-sweep-age": 86400000000000,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-reply-delete-parent-update": false,
+    "statuses-reply-delete-update-delay": 60000000000,
+    "statuses-timeline-removal-defer-threshold": 0,
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
     "storage-s3-access-key": "minio",
@@ -235,8 +257,11 @@ GTS_INSTANCE_FEDERATION_SPAM_FILTER=true \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
 GTS_INSTANCE_LANGUAGES="nl,en-gb" \
+GTS_INSTANCE_REPORTS_PRESERVE_STATUSES=true \
+GTS_INSTANCE_HIDE_BLOCKED_DOMAIN_STATS=false \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
+GTS_ACCOUNTS_MOVE_CONCURRENCY=4 \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_REASON_REQUIRED=false \
 GTS_MEDIA_DESCRIPTION_MIN_CHARS=69 \
@@ -249,6 +274,7 @@ GTS_MEDIA_EMOJI_REMOTE_MAX_SIZE=420 \
 GTS_MEDIA_FFMPEG_POOL_SIZE=8 \
 GTS_METRICS_AUTH_ENABLED=false \
 GTS_METRICS_ENABLED=false \
+GTS_NODE_NAME='node-1' \
 GTS_STORAGE_BACKEND='local' \
 GTS_STORAGE_LOCAL_BASE_PATH='/root/store' \
 GTS_STORAGE_S3_ACCESS_KEY='minio' \
@@ -65,6 +65,7 @@ func testDefaults() config.Configuration {
 		BindAddress:              "127.0.0.1",
 		Port:                     8080,
 		TrustedProxies:           []string{"127.0.0.1/32", "::1"},
+		NodeName:                 "",
 		DbType:                   envStr("GTS_DB_TYPE", "sqlite"),
 		DbAddress:                envStr("GTS_DB_ADDRESS", ":memory:"),
 		DbPort:                   envInt("GTS_DB_PORT", 0),
@@ -96,11 +97,21 @@ func testDefaults() config.Configuration {
 				TagStr: "en-gb",
 			},
 		},
-
-		AccountsRegistrationOpen: true,
-		AccountsReasonRequired:   true,
-		AccountsAllowCustomCSS:   true,
-		AccountsCustomCSSLength:  10000,
+		InstanceInteractionApprovalCascadeDepth: 0,
+		InstanceInteractionApprovedBoostable:    true,
+		InstanceBlockRemoveFaves:                false,
+		InstanceInteractionPendingLimit:         0,
+		InstanceInteractionPendingReminderEvery: 24 * time.Hour,
+		InstanceReportsPreserveStatuses:         false,
+		InstanceHideBlockedDomainStats:          true,
+
+		AccountsRegistrationOpen:        true,
+		AccountsReasonRequired:          true,
+		AccountsAllowCustomCSS:          true,
+		AccountsCustomCSSLength:         10000,
+		AccountsMoveConcurrency:         2,
+		AccountsMoveFollowRetryAttempts: 3,
+		AccountsMoveFollowRetryDelay:    time.Hour,
 
 		MediaDescriptionMinChars: 0,
 		MediaDescriptionMaxChars: 500,
@@ -111,6 +122,7 @@ func testDefaults() config.Configuration {
 		MediaEmojiRemoteMaxSize:  102400,         // 100KiB
 		MediaCleanupFrom:         "00:00",        // midnight.
 		MediaCleanupEvery:        24 * time.Hour, // 1/day.
+		MediaRemoteDeleteRetain:  false,
 
 		// the testrig only uses in-memory storage, so we can
 		// safely set this value to 'test' to avoid running storage
@@ -118,10 +130,16 @@ func testDefaults() config.Configuration {
 		StorageBackend:       "test",
 		StorageLocalBasePath: "",
 
-		StatusesMaxChars:           5000,
-		StatusesPollMaxOptions:     6,
-		StatusesPollOptionMaxChars: 50,
-		StatusesMediaMaxFiles:      6,
+		StatusesMaxChars:                      5000,
+		StatusesPollMaxOptions:                6,
+		StatusesPollOptionMaxChars:            50,
+		StatusesPollArchiveOnDelete:           false,
+		StatusesPollExpirySweepAge:            24 * time.Hour,
+		StatusesMediaMaxFiles:                 6,
+		StatusesOrphanedReplyPlaceholder:      false,
+		StatusesReplyDeleteParentUpdate:       false,
+		StatusesReplyDeleteUpdateDelay:        time.Minute,
+		StatusesTimelineRemovalDeferThreshold: 0,
 
 		LetsEncryptEnabled:      false,
 		LetsEncryptPort:         0,
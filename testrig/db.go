@@ -44,9 +44,11 @@ var testModels = []interface{}{
 	&gtsmodel.List{},
 	&gtsmodel.ListEntry{},
 	&gtsmodel.Marker{},
+	&gtsmodel.MoveEvent{},
 	&gtsmodel.MediaAttachment{},
 	&gtsmodel.Mention{},
 	&gtsmodel.Poll{},
+	&gtsmodel.PollArchive{},
 	&gtsmodel.PollVote{},
 	&gtsmodel.Status{},
 	&gtsmodel.StatusToEmoji{},